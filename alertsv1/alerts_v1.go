@@ -171,6 +171,12 @@ func (alerts *AlertsV1) DisableRetries() {
 	alerts.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (alerts *AlertsV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(alerts.Service, policy)
+}
+
 // GetAlertPolicies : List alert policies
 // List configured alert policies for the CIS instance.
 func (alerts *AlertsV1) GetAlertPolicies(getAlertPoliciesOptions *GetAlertPoliciesOptions) (result *ListAlertPoliciesResp, response *core.DetailedResponse, err error) {
@@ -214,6 +220,7 @@ func (alerts *AlertsV1) GetAlertPoliciesWithContext(ctx context.Context, getAler
 	var rawResponse map[string]json.RawMessage
 	response, err = alerts.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -298,6 +305,7 @@ func (alerts *AlertsV1) CreateAlertPolicyWithContext(ctx context.Context, create
 	var rawResponse map[string]json.RawMessage
 	response, err = alerts.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -359,6 +367,7 @@ func (alerts *AlertsV1) GetAlertPolicyWithContext(ctx context.Context, getAlertP
 	var rawResponse map[string]json.RawMessage
 	response, err = alerts.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -448,6 +457,7 @@ func (alerts *AlertsV1) UpdateAlertPolicyWithContext(ctx context.Context, update
 	var rawResponse map[string]json.RawMessage
 	response, err = alerts.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -509,6 +519,7 @@ func (alerts *AlertsV1) DeleteAlertPolicyWithContext(ctx context.Context, delete
 	var rawResponse map[string]json.RawMessage
 	response, err = alerts.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -179,6 +179,12 @@ func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) DisableRetries()
 	authenticatedOriginPullApi.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(authenticatedOriginPullApi.Service, policy)
+}
+
 // GetZoneOriginPullSettings : Get Zone level Authenticated Origin Pull Settings
 // Get whether zone-level authenticated origin pulls is enabled or not. It is false by default.
 func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) GetZoneOriginPullSettings(getZoneOriginPullSettingsOptions *GetZoneOriginPullSettingsOptions) (result *GetZoneOriginPullSettingsResp, response *core.DetailedResponse, err error) {
@@ -226,6 +232,7 @@ func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) GetZoneOriginPul
 	var rawResponse map[string]json.RawMessage
 	response, err = authenticatedOriginPullApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -297,6 +304,7 @@ func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) SetZoneOriginPul
 	var rawResponse map[string]json.RawMessage
 	response, err = authenticatedOriginPullApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -357,6 +365,7 @@ func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) ListZoneOriginPu
 	var rawResponse map[string]json.RawMessage
 	response, err = authenticatedOriginPullApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -433,6 +442,7 @@ func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) UploadZoneOrigin
 	var rawResponse map[string]json.RawMessage
 	response, err = authenticatedOriginPullApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -498,6 +508,7 @@ func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) GetZoneOriginPul
 	var rawResponse map[string]json.RawMessage
 	response, err = authenticatedOriginPullApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -563,6 +574,7 @@ func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) DeleteZoneOrigin
 	var rawResponse map[string]json.RawMessage
 	response, err = authenticatedOriginPullApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -635,6 +647,7 @@ func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) SetHostnameOrigi
 	var rawResponse map[string]json.RawMessage
 	response, err = authenticatedOriginPullApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -700,6 +713,7 @@ func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) GetHostnameOrigi
 	var rawResponse map[string]json.RawMessage
 	response, err = authenticatedOriginPullApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -774,6 +788,7 @@ func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) UploadHostnameOr
 	var rawResponse map[string]json.RawMessage
 	response, err = authenticatedOriginPullApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -839,6 +854,7 @@ func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) GetHostnameOrigi
 	var rawResponse map[string]json.RawMessage
 	response, err = authenticatedOriginPullApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -904,6 +920,7 @@ func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) DeleteHostnameOr
 	var rawResponse map[string]json.RawMessage
 	response, err = authenticatedOriginPullApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
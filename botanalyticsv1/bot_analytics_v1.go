@@ -180,6 +180,12 @@ func (botAnalytics *BotAnalyticsV1) DisableRetries() {
 	botAnalytics.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (botAnalytics *BotAnalyticsV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(botAnalytics.Service, policy)
+}
+
 // GetBotScore : Get Bot Analytics score source
 // Get Bot Analytics score source for a given zone. Use this to identify the most common detection engines used to score
 // your traffic.
@@ -235,6 +241,7 @@ func (botAnalytics *BotAnalyticsV1) GetBotScoreWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = botAnalytics.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -302,6 +309,7 @@ func (botAnalytics *BotAnalyticsV1) GetBotTimeseriesWithContext(ctx context.Cont
 	var rawResponse map[string]json.RawMessage
 	response, err = botAnalytics.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -370,6 +378,7 @@ func (botAnalytics *BotAnalyticsV1) GetBotTopnsWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = botAnalytics.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
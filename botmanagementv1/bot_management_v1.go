@@ -188,6 +188,12 @@ func (botManagement *BotManagementV1) DisableRetries() {
 	botManagement.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (botManagement *BotManagementV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(botManagement.Service, policy)
+}
+
 // GetBotManagement : Get Bot management setting
 // Get Bot management setting for a given zone.
 func (botManagement *BotManagementV1) GetBotManagement(getBotManagementOptions *GetBotManagementOptions) (result *BotMgtResp, response *core.DetailedResponse, err error) {
@@ -237,6 +243,7 @@ func (botManagement *BotManagementV1) GetBotManagementWithContext(ctx context.Co
 	var rawResponse map[string]json.RawMessage
 	response, err = botManagement.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_bot_management", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -325,6 +332,7 @@ func (botManagement *BotManagementV1) UpdateBotManagementWithContext(ctx context
 	var rawResponse map[string]json.RawMessage
 	response, err = botManagement.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_bot_management", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
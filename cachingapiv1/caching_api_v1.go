@@ -177,6 +177,12 @@ func (cachingApi *CachingApiV1) DisableRetries() {
 	cachingApi.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (cachingApi *CachingApiV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(cachingApi.Service, policy)
+}
+
 // PurgeAll : Purge all
 // All resources in CDN edge servers' cache should be removed. This may have dramatic affects on your origin server load
 // after performing this action.
@@ -222,6 +228,7 @@ func (cachingApi *CachingApiV1) PurgeAllWithContext(ctx context.Context, purgeAl
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPurgeAllResponse)
@@ -287,6 +294,7 @@ func (cachingApi *CachingApiV1) PurgeByUrlsWithContext(ctx context.Context, purg
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPurgeAllResponse)
@@ -352,6 +360,7 @@ func (cachingApi *CachingApiV1) PurgeByCacheTagsWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPurgeAllResponse)
@@ -417,6 +426,7 @@ func (cachingApi *CachingApiV1) PurgeByHostsWithContext(ctx context.Context, pur
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPurgeAllResponse)
@@ -473,6 +483,7 @@ func (cachingApi *CachingApiV1) GetBrowserCacheTTLWithContext(ctx context.Contex
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalBrowserTTLResponse)
@@ -539,6 +550,7 @@ func (cachingApi *CachingApiV1) UpdateBrowserCacheTTLWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalBrowserTTLResponse)
@@ -594,6 +606,7 @@ func (cachingApi *CachingApiV1) GetServeStaleContentWithContext(ctx context.Cont
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalServeStaleContentResponse)
@@ -659,6 +672,7 @@ func (cachingApi *CachingApiV1) UpdateServeStaleContentWithContext(ctx context.C
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalServeStaleContentResponse)
@@ -714,6 +728,7 @@ func (cachingApi *CachingApiV1) GetDevelopmentModeWithContext(ctx context.Contex
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDeveopmentModeResponse)
@@ -779,6 +794,7 @@ func (cachingApi *CachingApiV1) UpdateDevelopmentModeWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDeveopmentModeResponse)
@@ -834,6 +850,7 @@ func (cachingApi *CachingApiV1) GetQueryStringSortWithContext(ctx context.Contex
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalEnableQueryStringSortResponse)
@@ -899,6 +916,7 @@ func (cachingApi *CachingApiV1) UpdateQueryStringSortWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalEnableQueryStringSortResponse)
@@ -954,6 +972,7 @@ func (cachingApi *CachingApiV1) GetCacheLevelWithContext(ctx context.Context, ge
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalCacheLevelResponse)
@@ -1021,6 +1040,7 @@ func (cachingApi *CachingApiV1) UpdateCacheLevelWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalCacheLevelResponse)
@@ -158,6 +158,12 @@ func (cisIpApi *CisIpApiV1) DisableRetries() {
 	cisIpApi.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (cisIpApi *CisIpApiV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(cisIpApi.Service, policy)
+}
+
 // ListIps : List of all IP addresses used by the CIS proxy
 // List of all IP addresses used by the CIS proxy.
 func (cisIpApi *CisIpApiV1) ListIps(listIpsOptions *ListIpsOptions) (result *IpResponse, response *core.DetailedResponse, err error) {
@@ -197,6 +203,7 @@ func (cisIpApi *CisIpApiV1) ListIpsWithContext(ctx context.Context, listIpsOptio
 	var rawResponse map[string]json.RawMessage
 	response, err = cisIpApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalIpResponse)
@@ -0,0 +1,265 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// APIError is the error returned by service methods when the server answers
+// with an error status. It parses both error envelopes used by the networking
+// APIs:
+//
+//	CIS: {"success": false, "errors": [{"code": 1003, "message": "..."}], "messages": [], "result": null}
+//	VPC: {"errors": [{"code": "not_found", "message": "...", "target": {"name": "id", "type": "parameter"}}], "trace": "..."}
+//
+// APIError embeds the core HTTPProblem describing the same response, so
+// errors.As works for either type.
+type APIError struct {
+	*core.HTTPProblem
+
+	// The HTTP status code of the response.
+	StatusCode int
+
+	// The code, message, target and more_info of the first reported error.
+	Code     string
+	Message  string
+	Target   string
+	MoreInfo string
+
+	// The VPC trace ID or request ID, and the CIS ray ID.
+	Trace string
+	RayID string
+
+	// Every error reported in the response.
+	Errors []APIErrorItem
+}
+
+// APIErrorItem is a single entry of an error envelope's "errors" list.
+type APIErrorItem struct {
+	Code     string
+	Message  string
+	Target   string
+	MoreInfo string
+}
+
+// NewAPIError returns an APIError for the failed request described by "err"
+// and "response". It returns "err" unchanged when there is no HTTP response
+// to describe, e.g. for network or validation errors.
+//
+// This function is invoked by generated service methods.
+func NewAPIError(err error, response *core.DetailedResponse) error {
+	if err == nil || response == nil || response.StatusCode < 400 {
+		return err
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return err
+	}
+
+	// Keep the problem built by the core, with its component, operation ID
+	// and discriminator. Plain errors get a problem that EnrichHTTPProblem
+	// can still fill in.
+	var httpProblem *core.HTTPProblem
+	if !errors.As(err, &httpProblem) {
+		httpProblem = &core.HTTPProblem{
+			IBMProblem: core.IBMErrorf(nil, core.NewProblemComponent("", ""), err.Error(), ""),
+			Response:   response,
+		}
+	}
+	apiErr = &APIError{
+		HTTPProblem: httpProblem,
+		StatusCode:  response.StatusCode,
+	}
+	apiErr.parseBody(response)
+	apiErr.parseHeaders(response.Headers)
+	if len(apiErr.Errors) > 0 {
+		apiErr.Code = apiErr.Errors[0].Code
+		apiErr.Message = apiErr.Errors[0].Message
+		apiErr.Target = apiErr.Errors[0].Target
+		apiErr.MoreInfo = apiErr.Errors[0].MoreInfo
+	}
+	return apiErr
+}
+
+// Unwrap returns the embedded HTTPProblem.
+func (apiErr *APIError) Unwrap() error {
+	return apiErr.HTTPProblem
+}
+
+func (apiErr *APIError) parseBody(response *core.DetailedResponse) {
+	body, ok := response.Result.(map[string]interface{})
+	if !ok {
+		var raw []byte
+		if response.RawResult != nil {
+			raw = response.RawResult
+		} else if s, isString := response.Result.(string); isString {
+			raw = []byte(s)
+		}
+		if raw == nil || json.Unmarshal(raw, &body) != nil {
+			return
+		}
+	}
+
+	if trace, ok := body["trace"].(string); ok {
+		apiErr.Trace = trace
+	}
+
+	if items, ok := body["errors"].([]interface{}); ok {
+		for _, item := range items {
+			if errorItem, ok := parseErrorItem(item); ok {
+				apiErr.Errors = append(apiErr.Errors, errorItem)
+			}
+		}
+	}
+
+	// Some endpoints return a single top-level error object instead.
+	if len(apiErr.Errors) == 0 {
+		if errorItem, ok := parseErrorItem(body); ok {
+			apiErr.Errors = append(apiErr.Errors, errorItem)
+		} else if message, ok := body["error"].(string); ok {
+			apiErr.Errors = append(apiErr.Errors, APIErrorItem{Message: message})
+		}
+	}
+}
+
+func parseErrorItem(item interface{}) (errorItem APIErrorItem, ok bool) {
+	switch value := item.(type) {
+	case map[string]interface{}:
+		errorItem.Code = stringValue(value["code"])
+		errorItem.Message = stringValue(value["message"])
+		errorItem.MoreInfo = stringValue(value["more_info"])
+		switch target := value["target"].(type) {
+		case map[string]interface{}:
+			errorItem.Target = stringValue(target["name"])
+		default:
+			errorItem.Target = stringValue(target)
+		}
+		if errorItem.Message == "" {
+			errorItem.Message = stringValue(value["error"])
+		}
+		ok = errorItem.Code != "" || errorItem.Message != ""
+	case []interface{}:
+		var parts []string
+		for _, part := range value {
+			if s := stringValue(part); s != "" {
+				parts = append(parts, s)
+			}
+		}
+		errorItem.Message = strings.Join(parts, " ")
+		ok = errorItem.Message != ""
+	case string:
+		errorItem.Message = value
+		ok = value != ""
+	}
+	return
+}
+
+func stringValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case json.Number:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func (apiErr *APIError) parseHeaders(headers http.Header) {
+	if headers == nil {
+		return
+	}
+	apiErr.RayID = headers.Get("Cf-Ray")
+	if apiErr.Trace == "" {
+		for _, name := range []string{"X-Request-Id", "X-Correlation-Id", "Transaction-Id"} {
+			if value := headers.Get(name); value != "" {
+				apiErr.Trace = value
+				break
+			}
+		}
+	}
+}
+
+// AsAPIError returns the APIError carried by "err", if any.
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+// HasStatusCode returns true if "err" describes an HTTP response with the
+// given status code.
+func HasStatusCode(err error, statusCode int) bool {
+	if apiErr, ok := AsAPIError(err); ok {
+		return apiErr.StatusCode == statusCode
+	}
+	var httpProblem *core.HTTPProblem
+	if errors.As(err, &httpProblem) && httpProblem.Response != nil {
+		return httpProblem.Response.StatusCode == statusCode
+	}
+	return false
+}
+
+// HasErrorCode returns true if any error reported in the response described
+// by "err" carries the given code.
+func HasErrorCode(err error, code string) bool {
+	if apiErr, ok := AsAPIError(err); ok {
+		for _, item := range apiErr.Errors {
+			if item.Code == code {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsNotFound returns true if "err" reports that the resource does not exist.
+func IsNotFound(err error) bool {
+	return HasStatusCode(err, http.StatusNotFound)
+}
+
+// IsConflict returns true if "err" reports a conflict with the current state
+// of the resource.
+func IsConflict(err error) bool {
+	return HasStatusCode(err, http.StatusConflict)
+}
+
+// IsPreconditionFailed returns true if "err" reports that an If-Match
+// precondition did not hold because the resource was changed concurrently.
+func IsPreconditionFailed(err error) bool {
+	return HasStatusCode(err, http.StatusPreconditionFailed)
+}
+
+// IsRateLimited returns true if "err" reports that the request was throttled.
+func IsRateLimited(err error) bool {
+	return HasStatusCode(err, http.StatusTooManyRequests)
+}
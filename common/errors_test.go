@@ -0,0 +1,132 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func newErrorResponse(statusCode int, body string, headers http.Header) *core.DetailedResponse {
+	response := &core.DetailedResponse{
+		StatusCode: statusCode,
+		Headers:    headers,
+	}
+	var result map[string]interface{}
+	if json.Unmarshal([]byte(body), &result) == nil {
+		response.Result = result
+	} else {
+		response.RawResult = []byte(body)
+	}
+	return response
+}
+
+func TestNewAPIErrorParsesVPCEnvelope(t *testing.T) {
+	response := newErrorResponse(404, `{"errors": [{"code": "not_found", "message": "Gateway not found", "more_info": "https://cloud.ibm.com/docs", "target": {"name": "id", "type": "parameter"}}], "trace": "3a5e8f0d"}`, nil)
+
+	err := NewAPIError(fmt.Errorf("Gateway not found"), response)
+	apiErr, ok := AsAPIError(err)
+	assert.True(t, ok)
+	assert.Equal(t, 404, apiErr.StatusCode)
+	assert.Equal(t, "not_found", apiErr.Code)
+	assert.Equal(t, "Gateway not found", apiErr.Message)
+	assert.Equal(t, "id", apiErr.Target)
+	assert.Equal(t, "https://cloud.ibm.com/docs", apiErr.MoreInfo)
+	assert.Equal(t, "3a5e8f0d", apiErr.Trace)
+	assert.Equal(t, "Gateway not found", err.Error())
+
+	assert.True(t, IsNotFound(err))
+	assert.False(t, IsConflict(err))
+	assert.True(t, HasErrorCode(err, "not_found"))
+
+	var httpProblem *core.HTTPProblem
+	assert.True(t, errors.As(err, &httpProblem))
+	assert.Equal(t, response, httpProblem.Response)
+}
+
+func TestNewAPIErrorParsesCISEnvelope(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("Cf-Ray", "7d1a2b3c4d5e6f70-DFW")
+	response := newErrorResponse(409, `{"success": false, "errors": [{"code": 81057, "message": "Record already exists."}], "messages": [], "result": null}`, headers)
+
+	err := NewAPIError(fmt.Errorf("Record already exists."), response)
+	apiErr, ok := AsAPIError(err)
+	assert.True(t, ok)
+	assert.Equal(t, "81057", apiErr.Code)
+	assert.Equal(t, "Record already exists.", apiErr.Message)
+	assert.Equal(t, "7d1a2b3c4d5e6f70-DFW", apiErr.RayID)
+	assert.True(t, IsConflict(err))
+	assert.True(t, HasErrorCode(err, "81057"))
+}
+
+func TestNewAPIErrorSurvivesSDKProblemWrapping(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("X-Request-Id", "req-1234")
+	response := newErrorResponse(429, `{"errors": [["rate limited"]]}`, headers)
+
+	err := NewAPIError(fmt.Errorf("Too Many Requests"), response)
+	core.EnrichHTTPProblem(err, "list_all_dns_records", core.NewProblemComponent("dns_records", "1.0.1"))
+	err = core.RepurposeSDKProblem(core.SDKErrorf(err, "", "http-request-err", GetComponentInfo()), "")
+
+	apiErr, ok := AsAPIError(err)
+	assert.True(t, ok)
+	assert.Equal(t, "rate limited", apiErr.Message)
+	assert.Equal(t, "req-1234", apiErr.Trace)
+	assert.Equal(t, "list_all_dns_records", apiErr.OperationID)
+	assert.True(t, IsRateLimited(err))
+}
+
+func TestNewAPIErrorKeepsCoreHTTPProblem(t *testing.T) {
+	response := newErrorResponse(404, `{"errors": [{"code": "not_found", "message": "Record not found"}]}`, nil)
+	original := &core.HTTPProblem{
+		IBMProblem:  core.IBMErrorf(nil, core.NewProblemComponent("dns_records", "1.0.1"), "Not Found", "not-found"),
+		OperationID: "get_dns_record",
+		Response:    response,
+	}
+	debugMessage := original.GetDebugMessage()
+
+	err := NewAPIError(original, response)
+	apiErr, ok := AsAPIError(err)
+	assert.True(t, ok)
+	assert.Equal(t, "not_found", apiErr.Code)
+	assert.Same(t, original, apiErr.HTTPProblem)
+	assert.Equal(t, "dns_records", apiErr.Component.Name)
+	assert.Equal(t, "get_dns_record", apiErr.OperationID)
+	assert.Equal(t, debugMessage, apiErr.GetDebugMessage())
+
+	// Enriching the error doesn't override the component set by the core.
+	core.EnrichHTTPProblem(err, "list_all_dns_records", core.NewProblemComponent("other", "2.0.0"))
+	assert.Equal(t, "dns_records", original.Component.Name)
+	assert.Equal(t, "get_dns_record", original.OperationID)
+}
+
+func TestNewAPIErrorWithoutResponse(t *testing.T) {
+	assert.Nil(t, NewAPIError(nil, nil))
+
+	original := fmt.Errorf("connection refused")
+	assert.Equal(t, original, NewAPIError(original, nil))
+	assert.False(t, IsNotFound(original))
+
+	_, ok := AsAPIError(NewAPIError(original, newErrorResponse(502, "Bad Gateway", nil)))
+	assert.True(t, ok)
+}
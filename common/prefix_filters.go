@@ -0,0 +1,90 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"net/netip"
+)
+
+// OrderPrefixFilters returns the positions of prefix filters, such as transit
+// gateway prefix filters or direct link route filters, in the order they are
+// applied. "ids" and "befores" hold the ID and the before field of each
+// filter, "" when unset. A filter goes right before the filter its before
+// field names, after any filter placed there earlier, and a filter without
+// before goes last. Filters whose before names a filter that is never placed
+// are left out.
+func OrderPrefixFilters(ids []string, befores []string) []int {
+	var ordered []int
+	pending := make([]int, len(befores))
+	for i := range pending {
+		pending[i] = i
+	}
+	for len(pending) > 0 {
+		var deferred []int
+		for _, filter := range pending {
+			if befores[filter] == "" {
+				ordered = append(ordered, filter)
+				continue
+			}
+			placed := false
+			for i, other := range ordered {
+				if ids[other] != "" && ids[other] == befores[filter] {
+					ordered = append(ordered[:i], append([]int{filter}, ordered[i:]...)...)
+					placed = true
+					break
+				}
+			}
+			if !placed {
+				deferred = append(deferred, filter)
+			}
+		}
+		if len(deferred) == len(pending) {
+			break
+		}
+		pending = deferred
+	}
+	return ordered
+}
+
+// PrefixFilterMatches returns true if a prefix filter on "filter" with the
+// optional ge and le bounds matches a route to "route". Without bounds only
+// the exact prefix matches.
+func PrefixFilterMatches(route netip.Prefix, filter netip.Prefix, ge *int64, le *int64) bool {
+	if !filter.IsValid() || route.Addr().Is4() != filter.Addr().Is4() || route.Bits() < filter.Bits() || !filter.Contains(route.Addr()) {
+		return false
+	}
+	minBits, maxBits := PrefixFilterBound(ge), PrefixFilterBound(le)
+	if minBits == 0 && maxBits == 0 {
+		return route.Bits() == filter.Bits()
+	}
+	if minBits == 0 {
+		minBits = int64(filter.Bits())
+	}
+	if maxBits == 0 {
+		maxBits = int64(route.Addr().BitLen())
+	}
+	return int64(route.Bits()) >= minBits && int64(route.Bits()) <= maxBits
+}
+
+// PrefixFilterBound returns the value of a ge or le field of a prefix filter,
+// where 0 means unset.
+func PrefixFilterBound(bound *int64) int64 {
+	if bound == nil || *bound < 0 {
+		return 0
+	}
+	return *bound
+}
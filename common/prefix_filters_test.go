@@ -0,0 +1,59 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderPrefixFilters(t *testing.T) {
+	// c goes before a, b goes before c after it was placed there, d has no before and e names a missing filter.
+	ids := []string{"a", "b", "c", "d", "e"}
+	befores := []string{"", "a", "a", "", "x"}
+	assert.Equal(t, []int{1, 2, 0, 3}, OrderPrefixFilters(ids, befores))
+
+	// Filters whose before fields form a cycle are left out.
+	assert.Equal(t, []int{2}, OrderPrefixFilters([]string{"a", "b", "c"}, []string{"b", "a", ""}))
+	assert.Empty(t, OrderPrefixFilters(nil, nil))
+}
+
+func TestPrefixFilterMatches(t *testing.T) {
+	filter := netip.MustParsePrefix("10.0.0.0/8")
+	for _, test := range []struct {
+		route   string
+		ge      *int64
+		le      *int64
+		matches bool
+	}{
+		{"10.0.0.0/8", nil, nil, true},
+		{"10.1.0.0/16", nil, nil, false},
+		{"10.1.0.0/16", core.Int64Ptr(16), nil, true},
+		{"10.1.1.0/24", nil, core.Int64Ptr(16), false},
+		{"10.1.0.0/16", nil, core.Int64Ptr(16), true},
+		{"10.1.0.0/16", core.Int64Ptr(0), core.Int64Ptr(0), false},
+		{"11.0.0.0/8", nil, core.Int64Ptr(32), false},
+		{"10.0.0.0/7", nil, core.Int64Ptr(32), false},
+		{"fd00::/8", nil, core.Int64Ptr(32), false},
+	} {
+		assert.Equal(t, test.matches, PrefixFilterMatches(netip.MustParsePrefix(test.route), filter, test.ge, test.le), test.route)
+	}
+	assert.False(t, PrefixFilterMatches(netip.MustParsePrefix("10.0.0.0/8"), netip.Prefix{}, nil, nil))
+}
@@ -0,0 +1,339 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const (
+	// CISRequestLimit and CISRequestInterval describe the documented CIS API
+	// ceiling of 1200 requests per five minutes for a single user.
+	CISRequestLimit    = 1200
+	CISRequestInterval = 5 * time.Minute
+
+	defaultMaxRetries       = 4
+	defaultMinRetryInterval = 1 * time.Second
+	defaultMaxRetryInterval = 30 * time.Second
+)
+
+// RetryPolicy describes how a service client retries failed requests and
+// paces outgoing traffic.
+//
+// Requests are retried when the transport fails or the server answers with
+// 429 (Too Many Requests) or a 5xx status other than 501. Transport failures
+// and 5xx responses are only retried for idempotent methods (GET, HEAD,
+// OPTIONS, PUT and DELETE) since the server may already have acted on them;
+// a 429 means the request was rejected before processing and is retried for
+// every method. The wait before each retry honours a Retry-After header in
+// full, even beyond MaxRetryInterval, and otherwise uses jittered exponential
+// backoff between MinRetryInterval and MaxRetryInterval. A retry that would
+// come after the deadline of the request context is not attempted; the last
+// response is returned instead.
+type RetryPolicy struct {
+	// The maximum number of retries after the initial attempt.
+	MaxRetries int
+
+	// The lower and upper bounds of the backoff between attempts.
+	MinRetryInterval time.Duration
+	MaxRetryInterval time.Duration
+
+	// An optional limiter that every attempt must acquire a token from.
+	// Share one RateLimiter between clients that count against the same
+	// account-wide limit.
+	RateLimiter *RateLimiter
+}
+
+// NewRetryPolicy returns a RetryPolicy with the default retry settings and
+// no rate limiting.
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries:       defaultMaxRetries,
+		MinRetryInterval: defaultMinRetryInterval,
+		MaxRetryInterval: defaultMaxRetryInterval,
+	}
+}
+
+// NewCISRetryPolicy returns a RetryPolicy with the default retry settings and
+// a rate limiter sized to the CIS API request ceiling.
+func NewCISRetryPolicy() *RetryPolicy {
+	policy := NewRetryPolicy()
+	policy.RateLimiter = NewRateLimiter(CISRequestLimit, CISRequestInterval, 0)
+	return policy
+}
+
+// SetMaxRetries : Allow user to set MaxRetries
+func (policy *RetryPolicy) SetMaxRetries(maxRetries int) *RetryPolicy {
+	policy.MaxRetries = maxRetries
+	return policy
+}
+
+// SetRetryInterval : Allow user to set MinRetryInterval and MaxRetryInterval
+func (policy *RetryPolicy) SetRetryInterval(minRetryInterval time.Duration, maxRetryInterval time.Duration) *RetryPolicy {
+	policy.MinRetryInterval = minRetryInterval
+	policy.MaxRetryInterval = maxRetryInterval
+	return policy
+}
+
+// SetRateLimiter : Allow user to set RateLimiter
+func (policy *RetryPolicy) SetRateLimiter(rateLimiter *RateLimiter) *RetryPolicy {
+	policy.RateLimiter = rateLimiter
+	return policy
+}
+
+// WithRetryPolicy installs "policy" on the HTTP client used by "service".
+// The core retry support is disabled first so that requests are not retried
+// twice. Passing a nil policy removes a previously installed policy.
+//
+// Service clients expose this as their SetRetryPolicy method.
+func WithRetryPolicy(service *core.BaseService, policy *RetryPolicy) {
+	service.DisableRetries()
+
+	client := service.GetHTTPClient()
+	if client == nil {
+		client = core.DefaultHTTPClient()
+	}
+	clientCopy := *client
+
+	transport := clientCopy.Transport
+	if rt, ok := transport.(*retryTransport); ok {
+		transport = rt.next
+	}
+	if policy != nil {
+		transport = &retryTransport{
+			next:   transport,
+			policy: *policy,
+		}
+	}
+	clientCopy.Transport = transport
+
+	service.SetHTTPClient(&clientCopy)
+}
+
+// retryTransport is the http.RoundTripper that applies a RetryPolicy.
+type retryTransport struct {
+	next   http.RoundTripper
+	policy RetryPolicy
+}
+
+func (transport *retryTransport) RoundTrip(req *http.Request) (resp *http.Response, err error) {
+	next := transport.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			if req, err = rewindRequest(req); err != nil {
+				return
+			}
+		}
+		if transport.policy.RateLimiter != nil {
+			if err = transport.policy.RateLimiter.Wait(ctx); err != nil {
+				return
+			}
+		}
+
+		resp, err = next.RoundTrip(req)
+		if attempt >= transport.policy.MaxRetries || !transport.shouldRetry(req, resp, err) {
+			return
+		}
+		if req.Body != nil && req.GetBody == nil {
+			// The body has been consumed and cannot be replayed.
+			return
+		}
+
+		wait := transport.backoff(attempt, resp)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			return
+		}
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		core.GetLogger().Debug("Retrying %s %s in %s (attempt %d)\n", req.Method, req.URL.Redacted(), wait, attempt+1)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (transport *retryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if err != nil {
+		return IsIdempotentMethod(req.Method)
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented {
+		return IsIdempotentMethod(req.Method)
+	}
+	return false
+}
+
+// backoff returns the wait before the retry following "attempt".
+func (transport *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	minWait := transport.policy.MinRetryInterval
+	maxWait := transport.policy.MaxRetryInterval
+	if maxWait < minWait {
+		maxWait = minWait
+	}
+
+	if resp != nil {
+		if wait, ok := RetryAfter(resp); ok {
+			// Retrying before the server allows it would only be throttled again.
+			return wait
+		}
+	}
+
+	wait := minWait << uint(attempt)
+	if wait <= 0 || wait > maxWait {
+		wait = maxWait
+	}
+	// Jitter over the upper half of the interval keeps concurrent
+	// clients from retrying in lockstep without collapsing the wait to zero,
+	// and never goes below the minimum interval.
+	if half := int64(wait / 2); half > 0 {
+		wait = max(time.Duration(half+rand.Int63n(half+1)), minWait)
+	}
+	return wait
+}
+
+// rewindRequest returns a copy of "req" with a fresh body for another attempt.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.GetBody == nil {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	clone := req.Clone(req.Context())
+	clone.Body = body
+	return clone, nil
+}
+
+// IsIdempotentMethod returns true if requests using "method" may safely be
+// repeated.
+func IsIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// RetryAfter returns the wait requested by the Retry-After header of "resp",
+// given either as a number of seconds or as an HTTP date.
+func RetryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if seconds < 0 {
+			seconds = 0
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if retryTime, err := http.ParseTime(value); err == nil {
+		wait := time.Until(retryTime)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// RateLimiter is a token bucket that allows "limit" requests per "interval"
+// with bursts of up to "burst" requests. It is safe for concurrent use.
+type RateLimiter struct {
+	mutex    sync.Mutex
+	rate     float64
+	burst    float64
+	tokens   float64
+	lastFill time.Time
+}
+
+// NewRateLimiter returns a RateLimiter allowing "limit" requests per
+// "interval". A "burst" of 0 allows the whole limit to be used at once.
+func NewRateLimiter(limit int, interval time.Duration, burst int) *RateLimiter {
+	if burst <= 0 {
+		burst = limit
+	}
+	return &RateLimiter{
+		rate:     float64(limit) / interval.Seconds(),
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		lastFill: time.Now(),
+	}
+}
+
+// Wait blocks until a token is available or "ctx" is done.
+func (limiter *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		wait := limiter.reserve()
+		if wait == 0 {
+			return nil
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a token if one is available and otherwise returns how long
+// to wait for the next one.
+func (limiter *RateLimiter) reserve() time.Duration {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	now := time.Now()
+	limiter.tokens += now.Sub(limiter.lastFill).Seconds() * limiter.rate
+	if limiter.tokens > limiter.burst {
+		limiter.tokens = limiter.burst
+	}
+	limiter.lastFill = now
+
+	if limiter.tokens >= 1 {
+		limiter.tokens--
+		return 0
+	}
+	return time.Duration((1 - limiter.tokens) / limiter.rate * float64(time.Second))
+}
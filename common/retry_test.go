@@ -0,0 +1,216 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestService(t *testing.T, url string) *core.BaseService {
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           url,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+	return service
+}
+
+func invoke(t *testing.T, service *core.BaseService, method string, body string) *http.Response {
+	var reader io.Reader
+	if body != "" {
+		reader = bytes.NewBufferString(body)
+	}
+	req, err := http.NewRequest(method, service.GetServiceURL(), reader)
+	assert.Nil(t, err)
+	resp, err := service.Client.Do(req)
+	assert.Nil(t, err)
+	resp.Body.Close()
+	return resp
+}
+
+func TestRetryPolicyRetriesIdempotentRequests(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			res.WriteHeader(503)
+			return
+		}
+		res.WriteHeader(200)
+	}))
+	defer server.Close()
+
+	service := newTestService(t, server.URL)
+	WithRetryPolicy(service, NewRetryPolicy().SetRetryInterval(time.Millisecond, 5*time.Millisecond))
+
+	resp := invoke(t, service, http.MethodGet, "")
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestRetryPolicySkipsNonIdempotentServerErrors(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		res.WriteHeader(502)
+	}))
+	defer server.Close()
+
+	service := newTestService(t, server.URL)
+	WithRetryPolicy(service, NewRetryPolicy().SetRetryInterval(time.Millisecond, 5*time.Millisecond))
+
+	resp := invoke(t, service, http.MethodPost, `{"name":"www"}`)
+	assert.Equal(t, 502, resp.StatusCode)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&attempts))
+}
+
+func TestRetryPolicyRetriesThrottledPostWithBody(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		assert.Equal(t, `{"name":"www"}`, string(body))
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			res.Header().Set("Retry-After", "0")
+			res.WriteHeader(429)
+			return
+		}
+		res.WriteHeader(201)
+	}))
+	defer server.Close()
+
+	service := newTestService(t, server.URL)
+	WithRetryPolicy(service, NewRetryPolicy())
+
+	resp := invoke(t, service, http.MethodPost, `{"name":"www"}`)
+	assert.Equal(t, 201, resp.StatusCode)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&attempts))
+}
+
+func TestRetryPolicyStopsAtMaxRetries(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		res.WriteHeader(500)
+	}))
+	defer server.Close()
+
+	service := newTestService(t, server.URL)
+	WithRetryPolicy(service, NewRetryPolicy().SetMaxRetries(2).SetRetryInterval(time.Millisecond, time.Millisecond))
+
+	resp := invoke(t, service, http.MethodDelete, "")
+	assert.Equal(t, 500, resp.StatusCode)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestWithRetryPolicyReplacesAndRemovesPolicy(t *testing.T) {
+	service := newTestService(t, "https://cis.example.com")
+	service.EnableRetries(3, time.Second)
+
+	WithRetryPolicy(service, NewRetryPolicy())
+	WithRetryPolicy(service, NewRetryPolicy().SetMaxRetries(1))
+	rt, ok := service.GetHTTPClient().Transport.(*retryTransport)
+	assert.True(t, ok)
+	assert.Equal(t, 1, rt.policy.MaxRetries)
+	_, nested := rt.next.(*retryTransport)
+	assert.False(t, nested)
+
+	WithRetryPolicy(service, nil)
+	_, ok = service.GetHTTPClient().Transport.(*retryTransport)
+	assert.False(t, ok)
+}
+
+func TestRetryAfter(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+	_, ok := RetryAfter(resp)
+	assert.False(t, ok)
+
+	resp.Header.Set("Retry-After", "7")
+	wait, ok := RetryAfter(resp)
+	assert.True(t, ok)
+	assert.Equal(t, 7*time.Second, wait)
+
+	resp.Header.Set("Retry-After", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	wait, ok = RetryAfter(resp)
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), wait)
+}
+
+func TestBackoffIsBounded(t *testing.T) {
+	transport := &retryTransport{policy: *NewRetryPolicy().SetRetryInterval(100*time.Millisecond, time.Second)}
+	for attempt := 0; attempt < 10; attempt++ {
+		wait := transport.backoff(attempt, nil)
+		assert.True(t, wait >= 100*time.Millisecond, "attempt %d waited %s", attempt, wait)
+		assert.True(t, wait <= time.Second, "attempt %d waited %s", attempt, wait)
+	}
+}
+
+func TestBackoffHonoursLongRetryAfter(t *testing.T) {
+	transport := &retryTransport{policy: *NewRetryPolicy().SetRetryInterval(100*time.Millisecond, time.Second)}
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"120"}}}
+	assert.Equal(t, 120*time.Second, transport.backoff(0, resp))
+}
+
+func TestRetryPolicyGivesUpWhenRetryAfterExceedsDeadline(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		res.Header().Set("Retry-After", "60")
+		res.WriteHeader(429)
+	}))
+	defer server.Close()
+
+	service := newTestService(t, server.URL)
+	WithRetryPolicy(service, NewRetryPolicy().SetRetryInterval(time.Millisecond, 5*time.Millisecond))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, service.GetServiceURL(), nil)
+	assert.Nil(t, err)
+	start := time.Now()
+	resp, err := service.Client.Do(req)
+	assert.Nil(t, err)
+	resp.Body.Close()
+
+	// The server asks for a minute, which is past the deadline, so the throttled response is returned at once.
+	assert.Equal(t, 429, resp.StatusCode)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&attempts))
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestRateLimiter(t *testing.T) {
+	limiter := NewRateLimiter(10, time.Second, 2)
+	assert.Equal(t, time.Duration(0), limiter.reserve())
+	assert.Equal(t, time.Duration(0), limiter.reserve())
+	assert.True(t, limiter.reserve() > 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, limiter.Wait(ctx))
+
+	start := time.Now()
+	assert.Nil(t, limiter.Wait(context.Background()))
+	assert.True(t, time.Since(start) < time.Second)
+}
@@ -0,0 +1,152 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// ValidationError describes a single invalid field of a request, found before
+// the request was sent.
+type ValidationError struct {
+	// The JSON path of the field, e.g. "content", "data.port" or
+	// "posts[2].priority".
+	Field string
+
+	// What is wrong with the field.
+	Message string
+}
+
+func (validationErr *ValidationError) Error() string {
+	return validationErr.Field + ": " + validationErr.Message
+}
+
+// ValidationErrors is the list of problems found in a request.
+type ValidationErrors []*ValidationError
+
+// Add appends a problem with "field" to the list.
+func (validationErrs *ValidationErrors) Add(field string, format string, args ...interface{}) {
+	*validationErrs = append(*validationErrs, &ValidationError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// Append appends the problems of "other" to the list, prefixing their field
+// names with "prefix".
+func (validationErrs *ValidationErrors) Append(prefix string, other ValidationErrors) {
+	for _, validationErr := range other {
+		field := validationErr.Field
+		if prefix != "" {
+			field = prefix + "." + field
+		}
+		*validationErrs = append(*validationErrs, &ValidationError{Field: field, Message: validationErr.Message})
+	}
+}
+
+// Err returns the list as an error, or nil if it is empty.
+func (validationErrs ValidationErrors) Err() error {
+	if len(validationErrs) == 0 {
+		return nil
+	}
+	return validationErrs
+}
+
+func (validationErrs ValidationErrors) Error() string {
+	messages := make([]string, len(validationErrs))
+	for i, validationErr := range validationErrs {
+		messages[i] = validationErr.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// AsValidationErrors returns the ValidationErrors carried by "err", if any.
+func AsValidationErrors(err error) (ValidationErrors, bool) {
+	var validationErrs ValidationErrors
+	if errors.As(err, &validationErrs) {
+		return validationErrs, true
+	}
+	return nil, false
+}
+
+// IsIPv4Address returns true if "s" is an IPv4 address in dotted-decimal form.
+func IsIPv4Address(s string) bool {
+	ip := net.ParseIP(s)
+	return ip != nil && ip.To4() != nil && !strings.Contains(s, ":")
+}
+
+// IsIPv6Address returns true if "s" is an IPv6 address. IPv4 addresses,
+// including IPv4-mapped IPv6 addresses, are not.
+func IsIPv6Address(s string) bool {
+	ip := net.ParseIP(s)
+	return ip != nil && ip.To4() == nil
+}
+
+// IsHostname returns true if "s" is a valid DNS name: at most 253 characters
+// in labels of 1 to 63 letters, digits, hyphens or underscores, optionally
+// with a leading "*" label and a trailing dot.
+func IsHostname(s string) bool {
+	s = strings.TrimSuffix(s, ".")
+	if s == "" || len(s) > 253 {
+		return false
+	}
+	for i, label := range strings.Split(s, ".") {
+		if label == "*" && i == 0 {
+			continue
+		}
+		if label == "" || len(label) > 63 || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return false
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// TXTStrings splits TXT record content into its character strings. Content
+// made of quoted strings, such as `"v=spf1" " -all"`, yields each string;
+// any other content is a single string.
+func TXTStrings(content string) []string {
+	trimmed := strings.TrimSpace(content)
+	if !strings.HasPrefix(trimmed, `"`) || !strings.HasSuffix(trimmed, `"`) || len(trimmed) < 2 {
+		return []string{content}
+	}
+
+	var texts []string
+	for len(trimmed) > 0 {
+		if trimmed[0] != '"' {
+			return []string{content}
+		}
+		var b strings.Builder
+		i := 1
+		for ; i < len(trimmed) && trimmed[i] != '"'; i++ {
+			if trimmed[i] == '\\' && i+1 < len(trimmed) {
+				i++
+			}
+			b.WriteByte(trimmed[i])
+		}
+		if i >= len(trimmed) {
+			return []string{content}
+		}
+		texts = append(texts, b.String())
+		trimmed = strings.TrimLeft(trimmed[i+1:], " \t")
+	}
+	return texts
+}
@@ -0,0 +1,69 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidationErrors(t *testing.T) {
+	var validationErrs ValidationErrors
+	assert.Nil(t, validationErrs.Err())
+
+	validationErrs.Add("content", "%q is not an IPv4 address", "::1")
+	var nested ValidationErrors
+	nested.Add("port", "is required")
+	validationErrs.Append("data", nested)
+
+	err := fmt.Errorf("invalid record: %w", validationErrs.Err())
+	assert.Equal(t, `invalid record: content: "::1" is not an IPv4 address; data.port: is required`, err.Error())
+
+	found, ok := AsValidationErrors(err)
+	assert.True(t, ok)
+	assert.Len(t, found, 2)
+	assert.Equal(t, "data.port", found[1].Field)
+
+	_, ok = AsValidationErrors(fmt.Errorf("other"))
+	assert.False(t, ok)
+}
+
+func TestAddressAndHostnameChecks(t *testing.T) {
+	assert.True(t, IsIPv4Address("192.0.2.1"))
+	assert.False(t, IsIPv4Address("::ffff:192.0.2.1"))
+	assert.False(t, IsIPv4Address("192.0.2"))
+	assert.True(t, IsIPv6Address("2001:db8::1"))
+	assert.False(t, IsIPv6Address("::ffff:192.0.2.1"))
+	assert.False(t, IsIPv6Address("192.0.2.1"))
+
+	assert.True(t, IsHostname("www.example.com."))
+	assert.True(t, IsHostname("*.example.com"))
+	assert.True(t, IsHostname("_sip._udp.example.com"))
+	assert.False(t, IsHostname("www..example.com"))
+	assert.False(t, IsHostname("-www.example.com"))
+	assert.False(t, IsHostname("www.*.example.com"))
+	assert.False(t, IsHostname(strings.Repeat("a", 64)+".com"))
+}
+
+func TestTXTStrings(t *testing.T) {
+	assert.Equal(t, []string{"v=spf1 -all"}, TXTStrings("v=spf1 -all"))
+	assert.Equal(t, []string{"first", `sec"ond`}, TXTStrings(`"first" "sec\"ond"`))
+	assert.Equal(t, []string{`"unbalanced" x"`}, TXTStrings(`"unbalanced" x"`))
+}
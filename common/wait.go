@@ -0,0 +1,184 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const (
+	defaultMinPollInterval = 5 * time.Second
+	defaultMaxPollInterval = 1 * time.Minute
+)
+
+// WaitPolicy describes how a waiter polls a resource until it reaches one of
+// its target statuses. The wait before each poll doubles from MinInterval up
+// to MaxInterval. A waiter stops when its context ends or, if Timeout is set,
+// after Timeout has passed.
+type WaitPolicy struct {
+	// The lower and upper bounds of the wait between polls.
+	MinInterval time.Duration
+	MaxInterval time.Duration
+
+	// The longest time to wait, or 0 to wait as long as the context allows.
+	Timeout time.Duration
+}
+
+// NewWaitPolicy returns a WaitPolicy with the default poll intervals and no
+// timeout.
+func NewWaitPolicy() *WaitPolicy {
+	return &WaitPolicy{
+		MinInterval: defaultMinPollInterval,
+		MaxInterval: defaultMaxPollInterval,
+	}
+}
+
+// SetInterval : Allow user to set MinInterval and MaxInterval
+func (policy *WaitPolicy) SetInterval(minInterval time.Duration, maxInterval time.Duration) *WaitPolicy {
+	policy.MinInterval = minInterval
+	policy.MaxInterval = maxInterval
+	return policy
+}
+
+// SetTimeout : Allow user to set Timeout
+func (policy *WaitPolicy) SetTimeout(timeout time.Duration) *WaitPolicy {
+	policy.Timeout = timeout
+	return policy
+}
+
+// WaitStatus is the outcome of one poll of a waiter.
+type WaitStatus struct {
+	// The status of the resource, or "" if it is gone.
+	Status string
+
+	// True once the resource has reached a target status.
+	Done bool
+
+	// True if the resource has reached a status it will not leave on its own.
+	Failed bool
+
+	// Explanations of the status, e.g. the status reasons of the resource.
+	Reasons []string
+}
+
+// Wait calls "poll" until it reports a WaitStatus that is done, failed, or
+// returns an error, waiting between polls as the policy describes. It returns
+// a *WaitError if the resource fails or the wait ends first; errors returned
+// by "poll" are returned unchanged. A nil policy uses the defaults.
+//
+// Waiters of the service clients are built on Wait.
+func Wait(ctx context.Context, policy *WaitPolicy, resource string, poll func(ctx context.Context) (WaitStatus, error)) error {
+	if policy == nil {
+		policy = NewWaitPolicy()
+	}
+	if policy.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, policy.Timeout)
+		defer cancel()
+	}
+
+	var last WaitStatus
+	for attempt := 0; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return &WaitError{Resource: resource, Status: last.Status, Reasons: last.Reasons, Err: err}
+		}
+		status, err := poll(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return &WaitError{Resource: resource, Status: last.Status, Reasons: last.Reasons, Err: ctx.Err()}
+			}
+			return err
+		}
+		last = status
+		if status.Done {
+			return nil
+		}
+		if status.Failed {
+			return &WaitError{Resource: resource, Status: status.Status, Reasons: status.Reasons, Failed: true}
+		}
+
+		wait := policy.interval(attempt)
+		core.GetLogger().Debug("Waiting %s for %s, status %q\n", wait, resource, status.Status)
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// interval returns the wait before the poll following "attempt".
+func (policy *WaitPolicy) interval(attempt int) time.Duration {
+	maxWait := policy.MaxInterval
+	if maxWait < policy.MinInterval {
+		maxWait = policy.MinInterval
+	}
+	wait := policy.MinInterval << uint(attempt)
+	if wait <= 0 || wait > maxWait {
+		wait = maxWait
+	}
+	return wait
+}
+
+// WaitError is returned by waiters when the resource reaches a failure status
+// or the wait ends before it reaches a target status.
+type WaitError struct {
+	// A description of the resource, e.g. "gateway 0a06fb9b".
+	Resource string
+
+	// The last status seen, or "" if the resource was never polled or is gone.
+	Status string
+
+	// The reasons reported with the last status.
+	Reasons []string
+
+	// True if the resource reached a failure status.
+	Failed bool
+
+	// The reason the wait ended, e.g. context.DeadlineExceeded, unless the
+	// resource failed.
+	Err error
+}
+
+func (waitErr *WaitError) Error() string {
+	var msg string
+	if waitErr.Failed {
+		msg = fmt.Sprintf("%s reached failure status %q", waitErr.Resource, waitErr.Status)
+	} else {
+		msg = fmt.Sprintf("stopped waiting for %s", waitErr.Resource)
+		if waitErr.Status != "" {
+			msg += fmt.Sprintf(" in status %q", waitErr.Status)
+		}
+		if waitErr.Err != nil {
+			msg += ": " + waitErr.Err.Error()
+		}
+	}
+	if len(waitErr.Reasons) > 0 {
+		msg += " (" + strings.Join(waitErr.Reasons, "; ") + ")"
+	}
+	return msg
+}
+
+func (waitErr *WaitError) Unwrap() error {
+	return waitErr.Err
+}
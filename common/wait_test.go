@@ -0,0 +1,64 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitPolicyInterval(t *testing.T) {
+	policy := NewWaitPolicy()
+	assert.Equal(t, 5*time.Second, policy.interval(0))
+	assert.Equal(t, 40*time.Second, policy.interval(3))
+	assert.Equal(t, time.Minute, policy.interval(4))
+	assert.Equal(t, time.Minute, policy.interval(100))
+}
+
+func TestWaitPollsUntilDone(t *testing.T) {
+	polls := 0
+	err := Wait(context.Background(), NewWaitPolicy().SetInterval(time.Millisecond, time.Millisecond), "gateway g1", func(ctx context.Context) (WaitStatus, error) {
+		polls++
+		return WaitStatus{Status: "pending", Done: polls == 3}, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, polls)
+
+	pollErr := errors.New("poll failed")
+	err = Wait(context.Background(), nil, "gateway g1", func(ctx context.Context) (WaitStatus, error) {
+		return WaitStatus{}, pollErr
+	})
+	assert.Equal(t, pollErr, err)
+}
+
+func TestWaitReportsTheLastStatus(t *testing.T) {
+	err := Wait(context.Background(), NewWaitPolicy().SetInterval(time.Millisecond, time.Millisecond).SetTimeout(10*time.Millisecond), "gateway g1", func(ctx context.Context) (WaitStatus, error) {
+		return WaitStatus{Status: "pending", Reasons: []string{"waiting for approval"}}, nil
+	})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Equal(t, `stopped waiting for gateway g1 in status "pending": context deadline exceeded (waiting for approval)`, err.Error())
+
+	err = Wait(context.Background(), nil, "gateway g1", func(ctx context.Context) (WaitStatus, error) {
+		return WaitStatus{Status: "failed", Failed: true}, nil
+	})
+	assert.Equal(t, &WaitError{Resource: "gateway g1", Status: "failed", Failed: true}, err)
+	assert.Equal(t, `gateway g1 reached failure status "failed"`, err.Error())
+}
@@ -179,6 +179,12 @@ func (customPages *CustomPagesV1) DisableRetries() {
 	customPages.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (customPages *CustomPagesV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(customPages.Service, policy)
+}
+
 // ListInstanceCustomPages : List all custom pages for a given instance
 // List all custom pages for a given instance.
 func (customPages *CustomPagesV1) ListInstanceCustomPages(listInstanceCustomPagesOptions *ListInstanceCustomPagesOptions) (result *ListCustomPagesResp, response *core.DetailedResponse, err error) {
@@ -222,6 +228,7 @@ func (customPages *CustomPagesV1) ListInstanceCustomPagesWithContext(ctx context
 	var rawResponse map[string]json.RawMessage
 	response, err = customPages.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListCustomPagesResp)
@@ -281,6 +288,7 @@ func (customPages *CustomPagesV1) GetInstanceCustomPageWithContext(ctx context.C
 	var rawResponse map[string]json.RawMessage
 	response, err = customPages.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalCustomPageSpecificResp)
@@ -353,6 +361,7 @@ func (customPages *CustomPagesV1) UpdateInstanceCustomPageWithContext(ctx contex
 	var rawResponse map[string]json.RawMessage
 	response, err = customPages.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalCustomPageSpecificResp)
@@ -408,6 +417,7 @@ func (customPages *CustomPagesV1) ListZoneCustomPagesWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = customPages.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListCustomPagesResp)
@@ -468,6 +478,7 @@ func (customPages *CustomPagesV1) GetZoneCustomPageWithContext(ctx context.Conte
 	var rawResponse map[string]json.RawMessage
 	response, err = customPages.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalCustomPageSpecificResp)
@@ -541,6 +552,7 @@ func (customPages *CustomPagesV1) UpdateZoneCustomPageWithContext(ctx context.Co
 	var rawResponse map[string]json.RawMessage
 	response, err = customPages.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalCustomPageSpecificResp)
@@ -0,0 +1,327 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package directlinkmetrics polls the status and statistics of Direct Link
+// gateways and exposes them as Prometheus gauges.
+//
+// A collection lists the gateways with directlinkv1.ListGateways and, for
+// each one, reads its BGP, link and BFD status with GetGatewayStatus and, when
+// BFD or MACsec is configured, its statistics with GetGatewayStatistics. The
+// free-text statistics are parsed into BfdSession, MkaSession, MkaStatistics
+// and MacsecConnection values. A Collector serves the last collection in the
+// Prometheus text exposition format, so it can be mounted on any HTTP server
+// without depending on a Prometheus client library.
+package directlinkmetrics
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+)
+
+// Collector polls the gateways of a Direct Link account.
+type Collector struct {
+	Service *directlinkv1.DirectLinkV1
+	Options *CollectorOptions
+
+	mutex sync.Mutex
+	last  *Snapshot
+}
+
+// CollectorOptions : The options of a Collector.
+type CollectorOptions struct {
+	// The statistic types read from gateways with MACsec configured. By default all MACsec statistic types.
+	MacsecStatisticTypes []string
+
+	// Whether to read the bfd_session statistics of gateways with BFD configured. True by default.
+	BfdStatistics *bool
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewCollectorOptions : Instantiate CollectorOptions
+func NewCollectorOptions() *CollectorOptions {
+	return &CollectorOptions{}
+}
+
+// SetMacsecStatisticTypes : Allow user to set MacsecStatisticTypes
+func (_options *CollectorOptions) SetMacsecStatisticTypes(macsecStatisticTypes []string) *CollectorOptions {
+	_options.MacsecStatisticTypes = macsecStatisticTypes
+	return _options
+}
+
+// SetBfdStatistics : Allow user to set BfdStatistics
+func (_options *CollectorOptions) SetBfdStatistics(bfdStatistics bool) *CollectorOptions {
+	_options.BfdStatistics = core.BoolPtr(bfdStatistics)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (_options *CollectorOptions) SetHeaders(param map[string]string) *CollectorOptions {
+	_options.Headers = param
+	return _options
+}
+
+// NewCollector returns a Collector polling the gateways visible to "service". A nil "options" uses the defaults.
+func NewCollector(service *directlinkv1.DirectLinkV1, options *CollectorOptions) (collector *Collector, err error) {
+	if service == nil {
+		err = core.SDKErrorf(nil, "the Direct Link client is required", "missing-client", common.GetComponentInfo())
+		return
+	}
+	if options == nil {
+		options = NewCollectorOptions()
+	}
+	collector = &Collector{Service: service, Options: options}
+	return
+}
+
+// Snapshot : The status and statistics of the gateways at one point in time.
+type Snapshot struct {
+	// When the collection started.
+	CollectedAt time.Time `json:"collected_at"`
+
+	// The gateways, in the order they were listed.
+	Gateways []GatewayMetrics `json:"gateways"`
+}
+
+// GatewayMetrics : The status and statistics of a gateway.
+type GatewayMetrics struct {
+	ID                string `json:"id"`
+	Name              string `json:"name"`
+	Type              string `json:"type,omitempty"`
+	LocationName      string `json:"location_name,omitempty"`
+	OperationalStatus string `json:"operational_status,omitempty"`
+
+	// The BGP, link and BFD status, or empty if the gateway does not report it.
+	BgpStatus  string `json:"bgp_status,omitempty"`
+	LinkStatus string `json:"link_status,omitempty"`
+	BfdStatus  string `json:"bfd_status,omitempty"`
+
+	BfdSessions       []BfdSession       `json:"bfd_sessions,omitempty"`
+	MkaSessions       []MkaSession       `json:"mka_sessions,omitempty"`
+	MkaStatistics     []MkaStatistics    `json:"mka_statistics,omitempty"`
+	MacsecConnections []MacsecConnection `json:"macsec_connections,omitempty"`
+
+	// The requests for this gateway that failed. The other metrics of the gateway are still reported.
+	Errors []CollectError `json:"errors,omitempty"`
+}
+
+// CollectError : A failed request for the status or statistics of a gateway.
+type CollectError struct {
+	// What was requested: "status" or a statistic type.
+	Source string `json:"source"`
+
+	Err error `json:"-"`
+}
+
+func (collectErr CollectError) Error() string {
+	return collectErr.Source + ": " + collectErr.Err.Error()
+}
+
+// Collect : Poll all gateways
+// List the gateways and read the status and statistics of each one. Failing to list the gateways fails the
+// collection; a failure for a single gateway is recorded in its GatewayMetrics.Errors instead. The result is also kept
+// as the last snapshot of the collector.
+func (collector *Collector) Collect() (snapshot *Snapshot, err error) {
+	snapshot, err = collector.CollectWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CollectWithContext is an alternate form of the Collect method which supports a Context parameter
+func (collector *Collector) CollectWithContext(ctx context.Context) (snapshot *Snapshot, err error) {
+	snapshot = &Snapshot{CollectedAt: time.Now(), Gateways: []GatewayMetrics{}}
+	headers := collector.Options.Headers
+	gateways, _, err := collector.Service.ListGatewaysWithContext(ctx, &directlinkv1.ListGatewaysOptions{Headers: headers})
+	if err != nil {
+		snapshot = nil
+		err = core.RepurposeSDKProblem(err, "list-gateways-error")
+		return
+	}
+	for _, item := range gateways.Gateways {
+		gateway, hasBfd, hasMacsec := describeGateway(item)
+		collector.collectStatus(ctx, &gateway)
+		if hasBfd && (collector.Options.BfdStatistics == nil || *collector.Options.BfdStatistics) {
+			if data, ok := collector.collectStatistics(ctx, &gateway, directlinkv1.GetGatewayStatisticsOptions_Type_BfdSession); ok {
+				gateway.BfdSessions = ParseBfdSessions(data)
+			}
+		}
+		if hasMacsec {
+			for _, statisticType := range collector.macsecStatisticTypes() {
+				data, ok := collector.collectStatistics(ctx, &gateway, statisticType)
+				if !ok {
+					continue
+				}
+				switch statisticType {
+				case directlinkv1.GetGatewayStatisticsOptions_Type_MacsecMkaSession:
+					gateway.MkaSessions = ParseMkaSessions(data)
+				case directlinkv1.GetGatewayStatisticsOptions_Type_MacsecMkaStatistics:
+					gateway.MkaStatistics = ParseMkaStatistics(data)
+				case directlinkv1.GetGatewayStatisticsOptions_Type_MacsecPolicy:
+					gateway.MacsecConnections = ParseMacsecConnections(data)
+				}
+			}
+		}
+		snapshot.Gateways = append(snapshot.Gateways, gateway)
+	}
+
+	collector.mutex.Lock()
+	collector.last = snapshot
+	collector.mutex.Unlock()
+	return
+}
+
+// Run collects every "interval" until the context ends, and returns the context's error. Failed collections are
+// skipped; the last successful snapshot keeps being served.
+func (collector *Collector) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		_, _ = collector.CollectWithContext(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// LastSnapshot returns the result of the last successful collection, or nil if there was none.
+func (collector *Collector) LastSnapshot() *Snapshot {
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+	return collector.last
+}
+
+// ServeHTTP writes the last snapshot in the Prometheus text exposition format. If there is none yet, it collects one
+// first and answers 503 Service Unavailable if that fails.
+func (collector *Collector) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	snapshot := collector.LastSnapshot()
+	if snapshot == nil {
+		var err error
+		snapshot, err = collector.CollectWithContext(req.Context())
+		if err != nil {
+			http.Error(res, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+	}
+	res.Header().Set("Content-Type", ContentType)
+	_, _ = snapshot.WriteTo(res)
+}
+
+func (collector *Collector) collectStatus(ctx context.Context, gateway *GatewayMetrics) {
+	collection, _, err := collector.Service.GetGatewayStatusWithContext(ctx, &directlinkv1.GetGatewayStatusOptions{
+		ID:      core.StringPtr(gateway.ID),
+		Headers: collector.Options.Headers,
+	})
+	if err != nil {
+		gateway.Errors = append(gateway.Errors, CollectError{Source: "status", Err: err})
+		return
+	}
+	for _, status := range collection.Status {
+		var statusType, value *string
+		switch status := status.(type) {
+		case *directlinkv1.GatewayStatus:
+			statusType, value = status.Type, status.Value
+		case *directlinkv1.GatewayStatusGatewayBGPStatus:
+			statusType, value = status.Type, status.Value
+		case *directlinkv1.GatewayStatusGatewayLinkStatus:
+			statusType, value = status.Type, status.Value
+		case *directlinkv1.GatewayStatusGatewayBFDStatus:
+			statusType, value = status.Type, status.Value
+		}
+		if statusType == nil || value == nil {
+			continue
+		}
+		switch *statusType {
+		case directlinkv1.GetGatewayStatusOptions_Type_Bgp:
+			gateway.BgpStatus = *value
+		case directlinkv1.GetGatewayStatusOptions_Type_Link:
+			gateway.LinkStatus = *value
+		case directlinkv1.GetGatewayStatusOptions_Type_Bfd:
+			gateway.BfdStatus = *value
+		}
+	}
+}
+
+func (collector *Collector) collectStatistics(ctx context.Context, gateway *GatewayMetrics, statisticType string) (data string, ok bool) {
+	collection, _, err := collector.Service.GetGatewayStatisticsWithContext(ctx, &directlinkv1.GetGatewayStatisticsOptions{
+		ID:      core.StringPtr(gateway.ID),
+		Type:    core.StringPtr(statisticType),
+		Headers: collector.Options.Headers,
+	})
+	if err != nil {
+		gateway.Errors = append(gateway.Errors, CollectError{Source: statisticType, Err: err})
+		return
+	}
+	for _, statistic := range collection.Statistics {
+		if statistic.Type == nil || *statistic.Type == statisticType {
+			if statistic.Data != nil {
+				data += *statistic.Data + "\n"
+			}
+		}
+	}
+	ok = true
+	return
+}
+
+func (collector *Collector) macsecStatisticTypes() []string {
+	if collector.Options.MacsecStatisticTypes != nil {
+		return collector.Options.MacsecStatisticTypes
+	}
+	return []string{
+		directlinkv1.GetGatewayStatisticsOptions_Type_MacsecMkaSession,
+		directlinkv1.GetGatewayStatisticsOptions_Type_MacsecMkaStatistics,
+		directlinkv1.GetGatewayStatisticsOptions_Type_MacsecPolicy,
+	}
+}
+
+// describeGateway returns the identity of any of the ListGateways item
+// models, and whether BFD and MACsec are configured on the gateway.
+func describeGateway(item directlinkv1.GatewayCollectionGatewaysItemIntf) (gateway GatewayMetrics, hasBfd bool, hasMacsec bool) {
+	var id, name, gatewayType, location, status *string
+	switch item := item.(type) {
+	case *directlinkv1.GatewayCollectionGatewaysItem:
+		id, name, gatewayType, location, status = item.ID, item.Name, item.Type, item.LocationName, item.OperationalStatus
+		hasBfd, hasMacsec = item.BfdConfig != nil, item.Macsec != nil
+	case *directlinkv1.GatewayCollectionGatewaysItemGateway:
+		id, name, gatewayType, location, status = item.ID, item.Name, item.Type, item.LocationName, item.OperationalStatus
+		hasBfd, hasMacsec = item.BfdConfig != nil, item.Macsec != nil
+	case *directlinkv1.GatewayCollectionGatewaysItemCrossAccountGateway:
+		id, name, gatewayType, location, status = item.ID, item.Name, item.Type, item.LocationName, item.OperationalStatus
+	}
+	gateway = GatewayMetrics{
+		ID:                stringValue(id),
+		Name:              stringValue(name),
+		Type:              stringValue(gatewayType),
+		LocationName:      stringValue(location),
+		OperationalStatus: stringValue(status),
+	}
+	return
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
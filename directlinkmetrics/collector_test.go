@@ -0,0 +1,170 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package directlinkmetrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/IBM/networking-go-sdk/internal/testserver"
+	"github.com/stretchr/testify/assert"
+)
+
+const gatewaysBody = `{"gateways": [
+	{"id": "gw1", "name": "dl \"one\"", "type": "dedicated", "location_name": "dal03", "operational_status": "provisioned",
+	 "bfd_config": {"interval": 300, "multiplier": 3}, "macsec": {"status": "secured"}},
+	{"id": "gw2", "name": "dl-two", "type": "connect", "location_name": "dal10", "operational_status": "provisioned"}
+]}`
+
+// gatewayRoutes serve the gateways of gatewaysBody, with their statuses and the statistics of gw1.
+var gatewayRoutes = []testserver.Route{
+	{Path: "/gateways", Body: gatewaysBody},
+	{Path: "/gateways/gw1/status", Body: `{"status": [
+		{"type": "bgp", "value": "established", "updated_at": "2024-01-01T00:00:00Z"},
+		{"type": "link", "value": "up", "updated_at": "2024-01-01T00:00:00Z"},
+		{"type": "bfd", "value": "up", "updated_at": "2024-01-01T00:00:00Z"}
+	]}`},
+	{Path: "/gateways/gw2/status", Body: `{"status": [{"type": "bgp", "value": "flapping", "updated_at": "2024-01-01T00:00:00Z"}]}`},
+	{Path: "/gateways/gw1/statistics", Handler: func(res http.ResponseWriter, req *http.Request) {
+		statisticType := req.URL.Query().Get("type")
+		data := map[string]string{
+			"bfd_session":           bfdSessionData,
+			"macsec_mka_session":    mkaSessionData,
+			"macsec_mka_statistics": mkaStatisticsData,
+			"macsec_policy":         macsecPolicyData,
+		}[statisticType]
+		encoded, _ := json.Marshal(data)
+		fmt.Fprintf(res, `{"statistics": [{"type": %q, "data": %s, "created_at": "2024-01-01T00:00:00Z"}]}`, statisticType, encoded)
+	}},
+}
+
+func newTestCollector(t *testing.T, url string) *Collector {
+	service, err := directlinkv1.NewDirectLinkV1(&directlinkv1.DirectLinkV1Options{
+		URL:           url,
+		Authenticator: &core.NoAuthAuthenticator{},
+		Version:       core.StringPtr("2024-01-01"),
+	})
+	assert.Nil(t, err)
+	collector, err := NewCollector(service, nil)
+	assert.Nil(t, err)
+	return collector
+}
+
+func TestCollect(t *testing.T) {
+	server := testserver.New(t, "", gatewayRoutes...)
+	collector := newTestCollector(t, server.URL)
+
+	snapshot, err := collector.Collect()
+	assert.Nil(t, err)
+	assert.Same(t, snapshot, collector.LastSnapshot())
+	assert.Len(t, snapshot.Gateways, 2)
+
+	gateway := snapshot.Gateways[0]
+	assert.Equal(t, "established", gateway.BgpStatus)
+	assert.Equal(t, "up", gateway.LinkStatus)
+	assert.Equal(t, "up", gateway.BfdStatus)
+	assert.Len(t, gateway.BfdSessions, 2)
+	assert.Len(t, gateway.MkaSessions, 2)
+	assert.Len(t, gateway.MkaStatistics, 1)
+	assert.Len(t, gateway.MacsecConnections, 1)
+	assert.Empty(t, gateway.Errors)
+
+	gateway = snapshot.Gateways[1]
+	assert.Equal(t, "flapping", gateway.BgpStatus)
+	assert.Empty(t, gateway.LinkStatus)
+	assert.Empty(t, gateway.BfdSessions)
+	assert.Empty(t, gateway.MkaSessions)
+}
+
+func TestCollectRecordsGatewayErrors(t *testing.T) {
+	server := testserver.New(t, "",
+		testserver.Route{Path: "/gateways", Body: gatewaysBody},
+		testserver.Route{StatusCode: 500, Body: `{"errors": [{"code": "internal_error", "message": "try again"}]}`})
+	collector := newTestCollector(t, server.URL)
+	collector.Options.SetMacsecStatisticTypes([]string{directlinkv1.GetGatewayStatisticsOptions_Type_MacsecMkaSession})
+
+	snapshot, err := collector.Collect()
+	assert.Nil(t, err)
+	var sources []string
+	for _, collectErr := range snapshot.Gateways[0].Errors {
+		sources = append(sources, collectErr.Source)
+	}
+	assert.Equal(t, []string{"status", "bfd_session", "macsec_mka_session"}, sources)
+	assert.Len(t, snapshot.Gateways[1].Errors, 1)
+}
+
+func TestServeHTTP(t *testing.T) {
+	server := testserver.New(t, "", gatewayRoutes...)
+	collector := newTestCollector(t, server.URL)
+
+	recorder := httptest.NewRecorder()
+	collector.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, ContentType, recorder.Header().Get("Content-Type"))
+	body := recorder.Body.String()
+
+	const gw1 = `gateway_id="gw1",gateway_name="dl \"one\""`
+	const gw2 = `gateway_id="gw2",gateway_name="dl-two"`
+	for _, line := range []string{
+		"# HELP directlink_gateway_bgp_state Whether the BGP session of the gateway is in the state.",
+		"# TYPE directlink_gateway_bgp_state gauge",
+		`directlink_gateway_info{` + gw1 + `,type="dedicated",location_name="dal03",operational_status="provisioned"} 1`,
+		`directlink_gateway_bgp_state{` + gw1 + `,state="established"} 1`,
+		`directlink_gateway_bgp_state{` + gw1 + `,state="idle"} 0`,
+		`directlink_gateway_bgp_state{` + gw2 + `,state="established"} 0`,
+		`directlink_gateway_bgp_state{` + gw2 + `,state="flapping"} 1`,
+		`directlink_gateway_link_state{` + gw1 + `,state="up"} 1`,
+		`directlink_gateway_bfd_state{` + gw1 + `,state="not_available"} 0`,
+		`directlink_gateway_bfd_session_up{` + gw1 + `,address="10.254.30.82",interface="ae7.3002"} 0`,
+		`directlink_gateway_macsec_mka_session_live_peers{` + gw1 + `,interface="ae6.3001",cak_name="1234ABCD",cak_type="primary"} 1`,
+		`directlink_gateway_macsec_mka_key_server{` + gw1 + `,interface="ae6.3001",cak_name="5678EF01",cak_type="fallback"} 0`,
+		`directlink_gateway_macsec_mka_packets{` + gw1 + `,interface="ae6.3001",counter="cak_mismatch_packets"} 2`,
+		`directlink_gateway_macsec_encryption_enabled{` + gw1 + `,interface="ae6.3001"} 1`,
+		`directlink_gateway_macsec_secure_associations_in_use{` + gw1 + `,interface="ae6.3001",direction="inbound"} 1`,
+		`directlink_gateway_collect_errors{` + gw2 + `} 0`,
+	} {
+		assert.Contains(t, body, line+"\n")
+	}
+	assert.NotContains(t, body, `directlink_gateway_link_state{`+gw2)
+	assert.Contains(t, body, "directlink_collector_last_collect_timestamp_seconds ")
+}
+
+func TestServeHTTPWithoutSnapshot(t *testing.T) {
+	server := testserver.New(t, "", testserver.Route{StatusCode: 503, Body: `{"errors": [{"code": "unavailable", "message": "unavailable"}]}`})
+	collector := newTestCollector(t, server.URL)
+
+	recorder := httptest.NewRecorder()
+	collector.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	assert.Equal(t, 503, recorder.Code)
+	assert.Nil(t, collector.LastSnapshot())
+}
+
+func TestWriteToEscapesLabels(t *testing.T) {
+	snapshot := &Snapshot{CollectedAt: time.Unix(1700000000, 500000000), Gateways: []GatewayMetrics{{ID: "gw", Name: "a\\b\nc"}}}
+	var builder strings.Builder
+	_, err := snapshot.WriteTo(&builder)
+	assert.Nil(t, err)
+	assert.Contains(t, builder.String(), `directlink_gateway_collect_errors{gateway_id="gw",gateway_name="a\\b\nc"} 0`+"\n")
+	assert.Contains(t, builder.String(), "directlink_collector_last_collect_timestamp_seconds 1.7000000005e+09\n")
+}
@@ -0,0 +1,167 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package directlinkmetrics
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ContentType is the media type of the Prometheus text exposition format.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// The states reported by the state-set metrics. A gateway has a series for
+// each known state, set to 1 for its current state and 0 for the others, so
+// a flap shows as a change of value rather than as a new series. An unknown
+// state gets its own series.
+var (
+	BgpStates  = []string{"idle", "connect", "active", "established"}
+	LinkStates = []string{"up", "down"}
+	BfdStates  = []string{"up", "down", "init", "not_available"}
+)
+
+// metricFamily is a metric with its samples, written as one block.
+type metricFamily struct {
+	name    string
+	help    string
+	samples []sample
+}
+
+type sample struct {
+	labels []string // name, value pairs
+	value  float64
+}
+
+func (family *metricFamily) add(value float64, labels ...string) {
+	family.samples = append(family.samples, sample{labels: labels, value: value})
+}
+
+// WriteTo writes the snapshot in the Prometheus text exposition format. Every gateway metric has the gateway_id and
+// gateway_name labels.
+func (snapshot *Snapshot) WriteTo(w io.Writer) (int64, error) {
+	info := &metricFamily{name: "directlink_gateway_info", help: "Information about the gateway, always 1."}
+	bgp := &metricFamily{name: "directlink_gateway_bgp_state", help: "Whether the BGP session of the gateway is in the state."}
+	link := &metricFamily{name: "directlink_gateway_link_state", help: "Whether the link of the gateway is in the state."}
+	bfd := &metricFamily{name: "directlink_gateway_bfd_state", help: "Whether the BFD status of the gateway is the state."}
+	bfdSessionUp := &metricFamily{name: "directlink_gateway_bfd_session_up", help: "Whether the BFD session is up."}
+	mkaLivePeers := &metricFamily{name: "directlink_gateway_macsec_mka_session_live_peers", help: "The number of live peers of the MKA session."}
+	mkaKeyServer := &metricFamily{name: "directlink_gateway_macsec_mka_key_server", help: "Whether the gateway is the key server of the MKA session."}
+	mkaPackets := &metricFamily{name: "directlink_gateway_macsec_mka_packets", help: "The MKA packet counters of the interface."}
+	encryption := &metricFamily{name: "directlink_gateway_macsec_encryption_enabled", help: "Whether the MACsec connection of the interface encrypts traffic."}
+	secureAssociations := &metricFamily{name: "directlink_gateway_macsec_secure_associations_in_use", help: "The number of secure associations in use, by direction."}
+	collectErrors := &metricFamily{name: "directlink_gateway_collect_errors", help: "The number of failed requests for the status and statistics of the gateway in the last collection."}
+	lastCollect := &metricFamily{name: "directlink_collector_last_collect_timestamp_seconds", help: "When the last collection started, in seconds since the epoch."}
+
+	for _, gateway := range snapshot.Gateways {
+		gatewayLabels := []string{"gateway_id", gateway.ID, "gateway_name", gateway.Name}
+		with := func(labels ...string) []string {
+			return append(append([]string{}, gatewayLabels...), labels...)
+		}
+
+		info.add(1, with("type", gateway.Type, "location_name", gateway.LocationName, "operational_status", gateway.OperationalStatus)...)
+		addStateSet(bgp, gateway.BgpStatus, BgpStates, gatewayLabels)
+		addStateSet(link, gateway.LinkStatus, LinkStates, gatewayLabels)
+		addStateSet(bfd, gateway.BfdStatus, BfdStates, gatewayLabels)
+		for _, session := range gateway.BfdSessions {
+			bfdSessionUp.add(boolValue(session.Up()), with("address", session.Address, "interface", session.Interface)...)
+		}
+		for _, session := range gateway.MkaSessions {
+			labels := with("interface", session.Interface, "cak_name", session.CakName, "cak_type", session.CakType)
+			mkaLivePeers.add(float64(session.LivePeers()), labels...)
+			mkaKeyServer.add(boolValue(session.KeyServer), labels...)
+		}
+		for _, statistics := range gateway.MkaStatistics {
+			counters := make([]string, 0, len(statistics.Counters))
+			for counter := range statistics.Counters {
+				counters = append(counters, counter)
+			}
+			sort.Strings(counters)
+			for _, counter := range counters {
+				mkaPackets.add(float64(statistics.Counters[counter]), with("interface", statistics.Interface, "counter", counter)...)
+			}
+		}
+		for _, connection := range gateway.MacsecConnections {
+			encryption.add(boolValue(connection.Encryption), with("interface", connection.Interface)...)
+			secureAssociations.add(float64(connection.InboundSecureAssociations), with("interface", connection.Interface, "direction", "inbound")...)
+			secureAssociations.add(float64(connection.OutboundSecureAssociations), with("interface", connection.Interface, "direction", "outbound")...)
+		}
+		collectErrors.add(float64(len(gateway.Errors)), gatewayLabels...)
+	}
+	lastCollect.add(float64(snapshot.CollectedAt.UnixNano()) / 1e9)
+
+	var buffer bytes.Buffer
+	for _, family := range []*metricFamily{info, bgp, link, bfd, bfdSessionUp, mkaLivePeers, mkaKeyServer, mkaPackets,
+		encryption, secureAssociations, collectErrors, lastCollect} {
+		if len(family.samples) == 0 {
+			continue
+		}
+		fmt.Fprintf(&buffer, "# HELP %s %s\n# TYPE %s gauge\n", family.name, family.help, family.name)
+		for _, sample := range family.samples {
+			buffer.WriteString(family.name)
+			if len(sample.labels) > 0 {
+				buffer.WriteByte('{')
+				for i := 0; i < len(sample.labels); i += 2 {
+					if i > 0 {
+						buffer.WriteByte(',')
+					}
+					fmt.Fprintf(&buffer, "%s=\"%s\"", sample.labels[i], escapeLabelValue(sample.labels[i+1]))
+				}
+				buffer.WriteByte('}')
+			}
+			buffer.WriteByte(' ')
+			buffer.WriteString(strconv.FormatFloat(sample.value, 'g', -1, 64))
+			buffer.WriteByte('\n')
+		}
+	}
+	return buffer.WriteTo(w)
+}
+
+// addStateSet adds a sample per known state, and one for "current" if it is
+// not known. Nothing is added if the gateway did not report the status.
+func addStateSet(family *metricFamily, current string, states []string, gatewayLabels []string) {
+	if current == "" {
+		return
+	}
+	known := false
+	for _, state := range states {
+		value := 0.0
+		if state == current {
+			value, known = 1, true
+		}
+		family.add(value, append(append([]string{}, gatewayLabels...), "state", state)...)
+	}
+	if !known {
+		family.add(1, append(append([]string{}, gatewayLabels...), "state", current)...)
+	}
+}
+
+func boolValue(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabelValue(value string) string {
+	return labelValueEscaper.Replace(value)
+}
@@ -0,0 +1,286 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package directlinkmetrics
+
+import (
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// BfdSession : A BFD session, parsed from the bfd_session statistics of a gateway.
+type BfdSession struct {
+	// The address of the BFD peer.
+	Address string `json:"address"`
+
+	// The state of the session, e.g. Up, Down or Init.
+	State string `json:"state"`
+
+	// The interface of the session.
+	Interface string `json:"interface,omitempty"`
+
+	// The time after which the session goes down if no packet is received, in seconds.
+	DetectTime float64 `json:"detect_time"`
+
+	// The interval between transmitted packets, in seconds.
+	TransmitInterval float64 `json:"transmit_interval"`
+
+	// The number of missed packets after which the session goes down.
+	Multiplier int64 `json:"multiplier"`
+}
+
+// Up returns true if the session is up.
+func (session BfdSession) Up() bool {
+	return strings.EqualFold(session.State, "up")
+}
+
+// MkaSession : A MACsec Key Agreement session, parsed from the macsec_mka_session statistics of a gateway.
+type MkaSession struct {
+	// The interface of the session.
+	Interface string `json:"interface"`
+
+	// The name of the connectivity association key (CAK) of the session.
+	CakName string `json:"cak_name,omitempty"`
+
+	// The type of the CAK, primary or fallback.
+	CakType string `json:"cak_type,omitempty"`
+
+	// Whether the gateway is the key server of the session.
+	KeyServer bool `json:"key_server"`
+
+	// The number of the latest distributed security association key (SAK).
+	KeyNumber int64 `json:"key_number"`
+
+	// The peers of the session.
+	Peers []MkaPeer `json:"peers,omitempty"`
+}
+
+// LivePeers returns the number of peers in the live state.
+func (session MkaSession) LivePeers() (count int) {
+	for _, peer := range session.Peers {
+		if strings.EqualFold(peer.State, "live") {
+			count++
+		}
+	}
+	return
+}
+
+// MkaPeer : A peer of a MACsec Key Agreement session.
+type MkaPeer struct {
+	// The member identifier of the peer.
+	MemberID string `json:"member_id"`
+
+	// The state of the peer, e.g. live or potential.
+	State string `json:"state"`
+}
+
+// MkaStatistics : The MACsec Key Agreement packet counters of an interface, parsed from the macsec_mka_statistics
+// statistics of a gateway.
+type MkaStatistics struct {
+	// The interface of the counters.
+	Interface string `json:"interface"`
+
+	// The counters by name in snake case, e.g. "received_packets" or "cak_mismatch_packets".
+	Counters map[string]int64 `json:"counters"`
+}
+
+// MacsecConnection : The MACsec connection of an interface, parsed from the macsec_policy statistics of a gateway.
+type MacsecConnection struct {
+	// The interface of the connection.
+	Interface string `json:"interface"`
+
+	// The name of the connectivity association.
+	CaName string `json:"ca_name,omitempty"`
+
+	// The cipher suite of the connection.
+	CipherSuite string `json:"cipher_suite,omitempty"`
+
+	// Whether traffic is encrypted.
+	Encryption bool `json:"encryption"`
+
+	// The number of inbound secure associations in use.
+	InboundSecureAssociations int `json:"inbound_secure_associations"`
+
+	// The number of outbound secure associations in use.
+	OutboundSecureAssociations int `json:"outbound_secure_associations"`
+}
+
+var (
+	// mkaPeerPattern matches a peer line, e.g. "1. Member identifier: 7DD2 (live)".
+	mkaPeerPattern = regexp.MustCompile(`^\d+\.\s*Member identifier:\s*(\S+)\s*\((\w+)\)`)
+
+	// secureAssociationPattern matches a secure association line, e.g.
+	// "AN: 3 Status: inuse Create time: 1d 02:03:04".
+	secureAssociationPattern = regexp.MustCompile(`\bAN:\s*\d+\s+Status:\s*(\S+)`)
+
+	// fieldSeparatorPattern separates fields that share a line, e.g.
+	// "Interface name: ae6.3001, Connectivity association name: ca1".
+	fieldSeparatorPattern = regexp.MustCompile(`\s{2,}|\t|,\s+`)
+)
+
+// ParseBfdSessions parses the data of bfd_session statistics, a table with a
+// row per session:
+//
+//	Address         State  Interface  Detect Time  Transmit Interval  Multiplier
+//	10.254.30.78    Up     ae6.3001   0.900        0.300              3
+//
+// Lines that are not session rows are ignored.
+func ParseBfdSessions(data string) (sessions []BfdSession) {
+	for _, line := range strings.Split(data, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 5 {
+			continue
+		}
+		if _, err := netip.ParseAddr(fields[0]); err != nil {
+			continue
+		}
+		session := BfdSession{Address: fields[0], State: fields[1]}
+		numbers := fields[2:]
+		if len(fields) >= 6 {
+			session.Interface = fields[2]
+			numbers = fields[3:]
+		}
+		session.DetectTime, _ = strconv.ParseFloat(numbers[0], 64)
+		session.TransmitInterval, _ = strconv.ParseFloat(numbers[1], 64)
+		session.Multiplier, _ = strconv.ParseInt(numbers[2], 10, 64)
+		sessions = append(sessions, session)
+	}
+	return
+}
+
+// ParseMkaSessions parses the data of macsec_mka_session statistics, a block
+// per session starting with "Interface name:" and listing "Key: value"
+// fields and the peers of the session.
+func ParseMkaSessions(data string) (sessions []MkaSession) {
+	for _, block := range parseInterfaceBlocks(data) {
+		session := MkaSession{
+			Interface: block.fields["interface name"],
+			CakName:   block.fields["cak name"],
+			CakType:   block.fields["cak type"],
+			KeyServer: strings.EqualFold(block.fields["key server"], "yes"),
+		}
+		session.KeyNumber, _ = strconv.ParseInt(block.fields["key number"], 10, 64)
+		for _, line := range block.lines {
+			if match := mkaPeerPattern.FindStringSubmatch(line); match != nil {
+				session.Peers = append(session.Peers, MkaPeer{MemberID: match[1], State: match[2]})
+			}
+		}
+		sessions = append(sessions, session)
+	}
+	return
+}
+
+// ParseMkaStatistics parses the data of macsec_mka_statistics statistics, a
+// block per interface starting with "Interface name:" and listing a
+// "Name: count" line per counter.
+func ParseMkaStatistics(data string) (statistics []MkaStatistics) {
+	for _, block := range parseInterfaceBlocks(data) {
+		interfaceStatistics := MkaStatistics{Interface: block.fields["interface name"], Counters: map[string]int64{}}
+		for name, value := range block.fields {
+			if count, err := strconv.ParseInt(value, 10, 64); err == nil {
+				interfaceStatistics.Counters[strings.ReplaceAll(name, " ", "_")] = count
+			}
+		}
+		statistics = append(statistics, interfaceStatistics)
+	}
+	return
+}
+
+// ParseMacsecConnections parses the data of macsec_policy statistics, a block
+// per interface starting with "Interface name:" with the settings of the
+// connection followed by its outbound and inbound secure channels.
+func ParseMacsecConnections(data string) (connections []MacsecConnection) {
+	for _, block := range parseInterfaceBlocks(data) {
+		connection := MacsecConnection{
+			Interface:   block.fields["interface name"],
+			CaName:      block.fields["ca name"],
+			CipherSuite: block.fields["cipher suite"],
+			Encryption:  strings.EqualFold(block.fields["encryption"], "on"),
+		}
+		inbound := false
+		for _, line := range block.lines {
+			lower := strings.ToLower(line)
+			switch {
+			case strings.HasPrefix(lower, "outbound secure channels"):
+				inbound = false
+			case strings.HasPrefix(lower, "inbound secure channels"):
+				inbound = true
+			}
+			match := secureAssociationPattern.FindStringSubmatch(line)
+			if match == nil || !strings.EqualFold(match[1], "inuse") {
+				continue
+			}
+			if inbound {
+				connection.InboundSecureAssociations++
+			} else {
+				connection.OutboundSecureAssociations++
+			}
+		}
+		connections = append(connections, connection)
+	}
+	return
+}
+
+// interfaceBlock is the part of a statistics output about one interface.
+type interfaceBlock struct {
+	// The first value of each "Key: value" field, by lower-case key.
+	fields map[string]string
+
+	// The trimmed lines of the block.
+	lines []string
+}
+
+// parseInterfaceBlocks splits statistics output into blocks starting with an
+// "Interface name:" line. Lines before the first block are ignored.
+func parseInterfaceBlocks(data string) (blocks []interfaceBlock) {
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(strings.ToLower(line), "interface name:") {
+			blocks = append(blocks, interfaceBlock{fields: map[string]string{}})
+		}
+		if len(blocks) == 0 || line == "" {
+			continue
+		}
+		block := &blocks[len(blocks)-1]
+		block.lines = append(block.lines, line)
+		if mkaPeerPattern.MatchString(line) || secureAssociationPattern.MatchString(line) {
+			continue
+		}
+		// A column-aligned value, as in "Received packets:     1234", is
+		// split from its key; segments without a colon continue the
+		// previous field.
+		var keys, values []string
+		for _, segment := range fieldSeparatorPattern.Split(line, -1) {
+			key, value, found := strings.Cut(segment, ":")
+			if !found {
+				if len(values) > 0 {
+					values[len(values)-1] = strings.TrimSpace(values[len(values)-1] + " " + segment)
+				}
+				continue
+			}
+			keys = append(keys, strings.ToLower(strings.TrimSpace(key)))
+			values = append(values, strings.TrimSpace(value))
+		}
+		for i, key := range keys {
+			if _, seen := block.fields[key]; !seen {
+				block.fields[key] = values[i]
+			}
+		}
+	}
+	return
+}
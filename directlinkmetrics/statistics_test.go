@@ -0,0 +1,133 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package directlinkmetrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const bfdSessionData = `                                                  Detect   Transmit
+Address                  State     Interface      Time     Interval  Multiplier
+10.254.30.78             Up        ae6.3001       0.900     0.300        3
+10.254.30.82             Down      ae7.3002       0.000     1.000        3
+
+2 sessions, 2 clients
+`
+
+const mkaSessionData = `Interface name: ae6.3001
+   Member identifier: B51CFF2F9D5B7C2ABA6A7B2C
+   CAK name: 1234ABCD
+   CAK type: primary      Transmit interval: 2000(ms)
+   Outbound SCI: 2C:6B:F5:31:E6:89/1
+   Message number: 12345     Key number: 7
+   Key server: yes      Key server priority: 255
+   Latest SAK AN: 3     Latest SAK KI: B51CFF2F9D5B7C2ABA6A7B2C/7
+   Peer list:
+     1. Member identifier: 7DD2C7B2E0E84A5C8D1B2F3A (live)
+        Message number: 8765 Hold time: 6000 (ms)
+     2. Member identifier: 0A1B2C3D4E5F60718293A4B5 (potential)
+        Message number: 1 Hold time: 6000 (ms)
+
+Interface name: ae6.3001
+   Member identifier: C61D00F0AE6C8D3BCB7B8C3D
+   CAK name: 5678EF01
+   CAK type: fallback      Transmit interval: 2000(ms)
+   Key number: 0
+   Key server: no
+`
+
+const mkaStatisticsData = `Interface name: ae6.3001
+   Received packets:            1234
+   Transmitted packets:         2345
+   Version mismatch packets:    0
+   CAK mismatch packets:        2
+   ICV mismatch packets:        0
+`
+
+const macsecPolicyData = `Interface name: ae6.3001, Connectivity association name: ca1
+   CA name: ca1
+   Cipher suite: GCM-AES-XPN-256        Encryption: on
+   Key server offset: 0         Include SCI: no
+   Replay protect: off          Replay window: 0
+   Outbound secure channels
+     SC Id: 2C:6B:F5:31:E6:89/1
+     Outgoing packet number: 12345
+     Secure Association:
+       AN: 3 Status: inuse Create time: 1d 02:03:04
+       AN: 2 Status: notinuse Create time: 2d 00:00:00
+   Inbound secure channels
+     SC Id: 00:1F:12:34:56:78/1
+     Secure Association:
+       AN: 3 Status: inuse Create time: 1d 02:03:04
+`
+
+func TestParseBfdSessions(t *testing.T) {
+	sessions := ParseBfdSessions(bfdSessionData)
+	assert.Equal(t, []BfdSession{
+		{Address: "10.254.30.78", State: "Up", Interface: "ae6.3001", DetectTime: 0.9, TransmitInterval: 0.3, Multiplier: 3},
+		{Address: "10.254.30.82", State: "Down", Interface: "ae7.3002", DetectTime: 0, TransmitInterval: 1, Multiplier: 3},
+	}, sessions)
+	assert.True(t, sessions[0].Up())
+	assert.False(t, sessions[1].Up())
+
+	assert.Empty(t, ParseBfdSessions("no sessions\n"))
+}
+
+func TestParseMkaSessions(t *testing.T) {
+	sessions := ParseMkaSessions(mkaSessionData)
+	assert.Len(t, sessions, 2)
+	assert.Equal(t, "ae6.3001", sessions[0].Interface)
+	assert.Equal(t, "1234ABCD", sessions[0].CakName)
+	assert.Equal(t, "primary", sessions[0].CakType)
+	assert.True(t, sessions[0].KeyServer)
+	assert.Equal(t, int64(7), sessions[0].KeyNumber)
+	assert.Equal(t, []MkaPeer{
+		{MemberID: "7DD2C7B2E0E84A5C8D1B2F3A", State: "live"},
+		{MemberID: "0A1B2C3D4E5F60718293A4B5", State: "potential"},
+	}, sessions[0].Peers)
+	assert.Equal(t, 1, sessions[0].LivePeers())
+
+	assert.Equal(t, "fallback", sessions[1].CakType)
+	assert.False(t, sessions[1].KeyServer)
+	assert.Equal(t, 0, sessions[1].LivePeers())
+}
+
+func TestParseMkaStatistics(t *testing.T) {
+	assert.Equal(t, []MkaStatistics{{
+		Interface: "ae6.3001",
+		Counters: map[string]int64{
+			"received_packets":         1234,
+			"transmitted_packets":      2345,
+			"version_mismatch_packets": 0,
+			"cak_mismatch_packets":     2,
+			"icv_mismatch_packets":     0,
+		},
+	}}, ParseMkaStatistics(mkaStatisticsData))
+}
+
+func TestParseMacsecConnections(t *testing.T) {
+	assert.Equal(t, []MacsecConnection{{
+		Interface:                  "ae6.3001",
+		CaName:                     "ca1",
+		CipherSuite:                "GCM-AES-XPN-256",
+		Encryption:                 true,
+		InboundSecureAssociations:  1,
+		OutboundSecureAssociations: 1,
+	}}, ParseMacsecConnections(macsecPolicyData))
+}
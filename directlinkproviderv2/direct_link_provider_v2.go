@@ -44,6 +44,9 @@ type DirectLinkProviderV2 struct {
 	// Requests the version of the API as a date in the format `YYYY-MM-DD`. Any date from 2020-04-28 up to the current
 	// date may be provided. Specify the current date to request the latest version.
 	Version *string
+
+	// How the waiters poll, or nil for the defaults of common.NewWaitPolicy.
+	WaitPolicy *common.WaitPolicy
 }
 
 // DefaultServiceURL is the default URL to make service requests to.
@@ -175,6 +178,12 @@ func (directLinkProvider *DirectLinkProviderV2) DisableRetries() {
 	directLinkProvider.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (directLinkProvider *DirectLinkProviderV2) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(directLinkProvider.Service, policy)
+}
+
 // ListProviderGateways : List gateways
 // List all Direct Link Connect gateways created by this provider.
 func (directLinkProvider *DirectLinkProviderV2) ListProviderGateways(listProviderGatewaysOptions *ListProviderGatewaysOptions) (result *ProviderGatewayCollection, response *core.DetailedResponse, err error) {
@@ -222,6 +231,7 @@ func (directLinkProvider *DirectLinkProviderV2) ListProviderGatewaysWithContext(
 	var rawResponse map[string]json.RawMessage
 	response, err = directLinkProvider.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -316,6 +326,7 @@ func (directLinkProvider *DirectLinkProviderV2) CreateProviderGatewayWithContext
 	var rawResponse map[string]json.RawMessage
 	response, err = directLinkProvider.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -378,6 +389,7 @@ func (directLinkProvider *DirectLinkProviderV2) DeleteProviderGatewayWithContext
 	var rawResponse map[string]json.RawMessage
 	response, err = directLinkProvider.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -442,6 +454,7 @@ func (directLinkProvider *DirectLinkProviderV2) GetProviderGatewayWithContext(ct
 	var rawResponse map[string]json.RawMessage
 	response, err = directLinkProvider.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -532,6 +545,7 @@ func (directLinkProvider *DirectLinkProviderV2) UpdateProviderGatewayWithContext
 	var rawResponse map[string]json.RawMessage
 	response, err = directLinkProvider.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -592,6 +606,7 @@ func (directLinkProvider *DirectLinkProviderV2) ListProviderPortsWithContext(ctx
 	var rawResponse map[string]json.RawMessage
 	response, err = directLinkProvider.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -654,6 +669,7 @@ func (directLinkProvider *DirectLinkProviderV2) GetProviderPortWithContext(ctx c
 	var rawResponse map[string]json.RawMessage
 	response, err = directLinkProvider.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
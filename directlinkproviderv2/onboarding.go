@@ -0,0 +1,436 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package directlinkproviderv2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+)
+
+// Constants associated with the ProviderGatewayChange.Status property.
+const (
+	// The change did not need the customer's approval.
+	ProviderGatewayChange_Status_Applied = "applied"
+
+	// The customer accepted the change.
+	ProviderGatewayChange_Status_Accepted = "accepted"
+
+	// The customer rejected the change.
+	ProviderGatewayChange_Status_Rejected = "rejected"
+
+	// The change waits for the customer's answer.
+	ProviderGatewayChange_Status_Pending = "pending"
+)
+
+// ProviderGatewayChange : A change of a provider gateway and how far it got.
+type ProviderGatewayChange struct {
+	// The gateway, as last read.
+	Gateway *ProviderGateway `json:"gateway"`
+
+	// The type of the change request: create_gateway, update_attributes or delete_gateway. Empty for a change that
+	// did not need a change request.
+	Type string `json:"type,omitempty"`
+
+	// The status of the change.
+	Status string `json:"status"`
+
+	// The attribute updates of an update_attributes change.
+	Updates *ProviderGatewayUpdateAttributesUpdatesItem `json:"updates,omitempty"`
+}
+
+// OnboardProviderGateway : Onboard a customer gateway
+// Check the gateway against the provider port, create it and wait until the customer accepted it and it is
+// provisioned.
+//
+// The speed must be one of the supported link speeds of the port, found with a ProviderPortsPager, and the BGP ASN
+// and VLAN must be in the ranges the API accepts; problems are reported with common.ValidationErrors before any
+// gateway is created. If a gateway with the same name already exists for the customer account on the same port, it is
+// waited for instead of creating another, so an interrupted onboarding can be run again. With check_only set to
+// "true", the gateway is only checked by the API, or the existing gateway is returned, without waiting.
+//
+// If the customer rejects the gateway, a *common.WaitError with the create_rejected status is returned together with
+// the gateway.
+func (directLinkProvider *DirectLinkProviderV2) OnboardProviderGateway(createProviderGatewayOptions *CreateProviderGatewayOptions) (result *ProviderGateway, err error) {
+	result, err = directLinkProvider.OnboardProviderGatewayWithContext(context.Background(), createProviderGatewayOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// OnboardProviderGatewayWithContext is an alternate form of the OnboardProviderGateway method which supports a Context parameter
+func (directLinkProvider *DirectLinkProviderV2) OnboardProviderGatewayWithContext(ctx context.Context, createProviderGatewayOptions *CreateProviderGatewayOptions) (result *ProviderGateway, err error) {
+	err = core.ValidateNotNil(createProviderGatewayOptions, "createProviderGatewayOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(createProviderGatewayOptions, "createProviderGatewayOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	headers := createProviderGatewayOptions.Headers
+	portID := *createProviderGatewayOptions.Port.ID
+
+	port, err := directLinkProvider.findProviderPort(ctx, portID, headers)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-ports-error")
+		return
+	}
+	var validationErrs common.ValidationErrors
+	if port == nil {
+		validationErrs.Add("port.id", "is not a port of the provider: %s", portID)
+	} else {
+		validateProviderGatewaySpeed(&validationErrs, port, *createProviderGatewayOptions.SpeedMbps)
+	}
+	validateProviderGatewayBgpAsn(&validationErrs, *createProviderGatewayOptions.BgpAsn)
+	if createProviderGatewayOptions.Vlan != nil {
+		validateProviderGatewayVlan(&validationErrs, *createProviderGatewayOptions.Vlan)
+	}
+
+	existing, err := directLinkProvider.findProviderGateway(ctx, *createProviderGatewayOptions.CustomerAccountID, *createProviderGatewayOptions.Name, headers)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-gateways-error")
+		return
+	}
+	if existing != nil && existing.Port != nil && existing.Port.ID != nil && *existing.Port.ID != portID {
+		validationErrs.Add("name", "is already used by gateway %s of the customer on port %s", *existing.ID, *existing.Port.ID)
+	}
+	if err = validationErrs.Err(); err != nil {
+		return
+	}
+
+	checkOnly := createProviderGatewayOptions.CheckOnly != nil && *createProviderGatewayOptions.CheckOnly == "true"
+	result = existing
+	if result == nil {
+		result, _, err = directLinkProvider.CreateProviderGatewayWithContext(ctx, createProviderGatewayOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "create-gateway-error")
+			return
+		}
+	}
+	if checkOnly {
+		return
+	}
+	if result.OperationalStatus != nil && *result.OperationalStatus == ProviderGateway_OperationalStatus_Provisioned {
+		return
+	}
+	gateway, err := directLinkProvider.waitForProviderGatewayStatus(ctx, *result.ID, headers, ProviderGateway_OperationalStatus_Provisioned)
+	if gateway != nil {
+		result = gateway
+	}
+	return
+}
+
+// ChangeProviderGateway : Change a provider gateway
+// Update the gateway and, if the update needs the customer's approval, wait until the customer answers the change
+// request.
+//
+// A new speed must be one of the supported link speeds of the gateway's port, and the BGP ASN and VLAN must be in the
+// ranges the API accepts; problems are reported with common.ValidationErrors before the gateway is updated. A gateway
+// that already has a pending change request is not updated either.
+//
+// The result tells whether the change was applied directly (e.g. a new name), or accepted or rejected by the
+// customer. A rejected change is also reported with a *common.WaitError with the rejected status.
+func (directLinkProvider *DirectLinkProviderV2) ChangeProviderGateway(updateProviderGatewayOptions *UpdateProviderGatewayOptions) (result *ProviderGatewayChange, err error) {
+	result, err = directLinkProvider.ChangeProviderGatewayWithContext(context.Background(), updateProviderGatewayOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ChangeProviderGatewayWithContext is an alternate form of the ChangeProviderGateway method which supports a Context parameter
+func (directLinkProvider *DirectLinkProviderV2) ChangeProviderGatewayWithContext(ctx context.Context, updateProviderGatewayOptions *UpdateProviderGatewayOptions) (result *ProviderGatewayChange, err error) {
+	err = core.ValidateNotNil(updateProviderGatewayOptions, "updateProviderGatewayOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(updateProviderGatewayOptions, "updateProviderGatewayOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	id := *updateProviderGatewayOptions.ID
+	headers := updateProviderGatewayOptions.Headers
+
+	current, _, err := directLinkProvider.GetProviderGatewayWithContext(ctx, &GetProviderGatewayOptions{ID: core.StringPtr(id), Headers: headers})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-gateway-error")
+		return
+	}
+	if pending := ProviderGatewayPendingChange(current); pending != nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("provider gateway %s has a pending %s change request", id, pending.Type), "condition-not-met", common.GetComponentInfo())
+		return
+	}
+	var validationErrs common.ValidationErrors
+	if updateProviderGatewayOptions.SpeedMbps != nil {
+		var port *ProviderPort
+		if current.Port != nil && current.Port.ID != nil {
+			port, err = directLinkProvider.findProviderPort(ctx, *current.Port.ID, headers)
+			if err != nil {
+				err = core.RepurposeSDKProblem(err, "list-ports-error")
+				return
+			}
+		}
+		if port == nil {
+			validationErrs.Add("speed_mbps", "cannot be checked, the port of the gateway is not a port of the provider")
+		} else {
+			validateProviderGatewaySpeed(&validationErrs, port, *updateProviderGatewayOptions.SpeedMbps)
+		}
+	}
+	if updateProviderGatewayOptions.BgpAsn != nil {
+		validateProviderGatewayBgpAsn(&validationErrs, *updateProviderGatewayOptions.BgpAsn)
+	}
+	if updateProviderGatewayOptions.Vlan != nil {
+		validateProviderGatewayVlan(&validationErrs, *updateProviderGatewayOptions.Vlan)
+	}
+	if err = validationErrs.Err(); err != nil {
+		return
+	}
+
+	gateway, _, err := directLinkProvider.UpdateProviderGatewayWithContext(ctx, updateProviderGatewayOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "update-gateway-error")
+		return
+	}
+	result = ProviderGatewayPendingChange(gateway)
+	if result == nil {
+		result = &ProviderGatewayChange{Gateway: gateway, Status: ProviderGatewayChange_Status_Applied}
+		return
+	}
+	gateway, err = directLinkProvider.waitForProviderGatewayChangeRequest(ctx, id, headers)
+	if gateway != nil {
+		result.Gateway = gateway
+	}
+	if err != nil {
+		return
+	}
+
+	// The change request only says that the customer answered; the
+	// attributes of the gateway tell the answer.
+	var mismatches []string
+	check := func(name string, requested interface{}, actual interface{}) {
+		if fmt.Sprint(requested) != fmt.Sprint(actual) {
+			mismatches = append(mismatches, fmt.Sprintf("%s is %v, not %v", name, actual, requested))
+		}
+	}
+	if updates := result.Updates; updates != nil {
+		if updates.SpeedMbps != nil {
+			check("speed_mbps", *updates.SpeedMbps, int64Value(gateway.SpeedMbps))
+		}
+		if updates.BgpAsn != nil {
+			check("bgp_asn", *updates.BgpAsn, int64Value(gateway.BgpAsn))
+		}
+		if updates.Vlan != nil {
+			check("vlan", *updates.Vlan, int64Value(gateway.Vlan))
+		}
+		if updates.BgpCerCidr != nil {
+			check("bgp_cer_cidr", *updates.BgpCerCidr, stringValue(gateway.BgpCerCidr))
+		}
+		if updates.BgpIbmCidr != nil {
+			check("bgp_ibm_cidr", *updates.BgpIbmCidr, stringValue(gateway.BgpIbmCidr))
+		}
+	}
+	if len(mismatches) > 0 {
+		result.Status = ProviderGatewayChange_Status_Rejected
+		err = &common.WaitError{
+			Resource: "change request of provider gateway " + id,
+			Status:   ProviderGatewayChange_Status_Rejected,
+			Reasons:  mismatches,
+			Failed:   true,
+		}
+		return
+	}
+	result.Status = ProviderGatewayChange_Status_Accepted
+	return
+}
+
+// ListPendingProviderGatewayChanges : List pending changes
+// List the gateways of the provider with a change request the customer has not answered yet, using a
+// ProviderGatewaysPager.
+func (directLinkProvider *DirectLinkProviderV2) ListPendingProviderGatewayChanges(listProviderGatewaysOptions *ListProviderGatewaysOptions) (result []ProviderGatewayChange, err error) {
+	result, err = directLinkProvider.ListPendingProviderGatewayChangesWithContext(context.Background(), listProviderGatewaysOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListPendingProviderGatewayChangesWithContext is an alternate form of the ListPendingProviderGatewayChanges method which supports a Context parameter
+func (directLinkProvider *DirectLinkProviderV2) ListPendingProviderGatewayChangesWithContext(ctx context.Context, listProviderGatewaysOptions *ListProviderGatewaysOptions) (result []ProviderGatewayChange, err error) {
+	if listProviderGatewaysOptions == nil {
+		listProviderGatewaysOptions = directLinkProvider.NewListProviderGatewaysOptions()
+	}
+	pager, err := directLinkProvider.NewProviderGatewaysPager(listProviderGatewaysOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "new-pager-error")
+		return
+	}
+	gateways, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-gateways-error")
+		return
+	}
+	result = []ProviderGatewayChange{}
+	for i := range gateways {
+		if pending := ProviderGatewayPendingChange(&gateways[i]); pending != nil {
+			result = append(result, *pending)
+		}
+	}
+	return
+}
+
+// ProviderGatewayPendingChange returns the change request of the gateway as a pending ProviderGatewayChange, with the
+// updates of an update_attributes request merged into one ProviderGatewayUpdateAttributesUpdatesItem, or nil if the
+// gateway has no change request.
+func ProviderGatewayPendingChange(gateway *ProviderGateway) *ProviderGatewayChange {
+	if gateway == nil || gateway.ChangeRequest == nil {
+		return nil
+	}
+	change := &ProviderGatewayChange{Gateway: gateway, Status: ProviderGatewayChange_Status_Pending}
+	var updates []ProviderGatewayUpdateAttributesUpdatesItemIntf
+	switch changeRequest := gateway.ChangeRequest.(type) {
+	case *ProviderGatewayChangeRequest:
+		change.Type, updates = stringValue(changeRequest.Type), changeRequest.Updates
+	case *ProviderGatewayChangeRequestProviderGatewayCreate:
+		change.Type = stringValue(changeRequest.Type)
+	case *ProviderGatewayChangeRequestProviderGatewayDelete:
+		change.Type = stringValue(changeRequest.Type)
+	case *ProviderGatewayChangeRequestProviderGatewayUpdateAttributes:
+		change.Type, updates = stringValue(changeRequest.Type), changeRequest.Updates
+	}
+	if len(updates) == 0 {
+		return change
+	}
+	merged := &ProviderGatewayUpdateAttributesUpdatesItem{}
+	for _, update := range updates {
+		switch update := update.(type) {
+		case *ProviderGatewayUpdateAttributesUpdatesItem:
+			if update.SpeedMbps != nil {
+				merged.SpeedMbps = update.SpeedMbps
+			}
+			if update.BgpAsn != nil {
+				merged.BgpAsn = update.BgpAsn
+			}
+			if update.Vlan != nil {
+				merged.Vlan = update.Vlan
+			}
+			if update.BgpCerCidr != nil {
+				merged.BgpCerCidr = update.BgpCerCidr
+			}
+			if update.BgpIbmCidr != nil {
+				merged.BgpIbmCidr = update.BgpIbmCidr
+			}
+		case *ProviderGatewayUpdateAttributesUpdatesItemProviderGatewaySpeedUpdate:
+			merged.SpeedMbps = update.SpeedMbps
+		case *ProviderGatewayUpdateAttributesUpdatesItemProviderGatewayBGPASNUpdate:
+			merged.BgpAsn = update.BgpAsn
+		case *ProviderGatewayUpdateAttributesUpdatesItemProviderGatewayVLAN:
+			merged.Vlan = update.Vlan
+		case *ProviderGatewayUpdateAttributesUpdatesItemProviderGatewayBGPIPUpdate:
+			merged.BgpCerCidr, merged.BgpIbmCidr = update.BgpCerCidr, update.BgpIbmCidr
+		}
+	}
+	change.Updates = merged
+	return change
+}
+
+// findProviderPort returns the port of the provider with the given ID, or nil
+// if there is none.
+func (directLinkProvider *DirectLinkProviderV2) findProviderPort(ctx context.Context, id string, headers map[string]string) (*ProviderPort, error) {
+	pager, err := directLinkProvider.NewProviderPortsPager(&ListProviderPortsOptions{Headers: headers})
+	if err != nil {
+		return nil, err
+	}
+	for pager.HasNext() {
+		ports, err := pager.GetNextWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range ports {
+			if ports[i].ID != nil && *ports[i].ID == id {
+				return &ports[i], nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// findProviderGateway returns the gateway of the provider with the given
+// customer account and name, or nil if there is none.
+func (directLinkProvider *DirectLinkProviderV2) findProviderGateway(ctx context.Context, customerAccountID string, name string, headers map[string]string) (*ProviderGateway, error) {
+	pager, err := directLinkProvider.NewProviderGatewaysPager(&ListProviderGatewaysOptions{Headers: headers})
+	if err != nil {
+		return nil, err
+	}
+	for pager.HasNext() {
+		gateways, err := pager.GetNextWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range gateways {
+			if stringValue(gateways[i].CustomerAccountID) == customerAccountID && stringValue(gateways[i].Name) == name {
+				return &gateways[i], nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func validateProviderGatewaySpeed(validationErrs *common.ValidationErrors, port *ProviderPort, speedMbps int64) {
+	supported := make([]string, 0, len(port.SupportedLinkSpeeds))
+	for _, linkSpeed := range port.SupportedLinkSpeeds {
+		if linkSpeed == speedMbps {
+			return
+		}
+		supported = append(supported, fmt.Sprint(linkSpeed))
+	}
+	validationErrs.Add("speed_mbps", "must be one of the speeds port %s supports (%s), got %d", stringValue(port.ID), strings.Join(supported, ", "), speedMbps)
+}
+
+// validateProviderGatewayBgpAsn checks the ranges given in the description
+// of ProviderGateway.BgpAsn.
+func validateProviderGatewayBgpAsn(validationErrs *common.ValidationErrors, bgpAsn int64) {
+	switch {
+	case bgpAsn >= 1 && bgpAsn <= 64495, bgpAsn == 64999:
+	case bgpAsn >= 131072 && bgpAsn <= 4199999999:
+	case bgpAsn >= 4201000000 && bgpAsn <= 4201064511:
+	default:
+		validationErrs.Add("bgp_asn", "must be between 1 and 64495, 64999, between 131072 and 4199999999 or between 4201000000 and 4201064511, got %d", bgpAsn)
+	}
+}
+
+func validateProviderGatewayVlan(validationErrs *common.ValidationErrors, vlan int64) {
+	if vlan < 2 || vlan > 3967 {
+		validationErrs.Add("vlan", "must be between 2 and 3967, got %d", vlan)
+	}
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func int64Value(value *int64) int64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
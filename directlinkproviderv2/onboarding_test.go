@@ -0,0 +1,254 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package directlinkproviderv2_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/directlinkproviderv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const providerPortsBody = `{"first": {"href": "x"}, "limit": 1, "ports": [%s]%s}`
+
+const providerPortBody = `{"id": "%s", "label": "label", "location_display_name": "Dallas 3", "location_name": "dal03", "provider_name": "provider", "supported_link_speeds": [1000, 2000, 5000]}`
+
+var _ = Describe(`DirectLinkProviderV2 onboarding`, func() {
+	var testServer *httptest.Server
+	var service *directlinkproviderv2.DirectLinkProviderV2
+	var requests []string
+	var gateway map[string]interface{}
+	// polls is the number of GETs of the gateway after which the customer
+	// answers the change request; accept tells the answer.
+	var polls int
+	var accept bool
+	// pollHeaders are the X-Test headers of the GETs of the gateway.
+	var pollHeaders []string
+
+	BeforeEach(func() {
+		requests, gateway, polls, accept, pollHeaders = nil, nil, 2, true, nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			requests = append(requests, req.Method+" "+req.URL.Path)
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.URL.Path == "/ports" && req.URL.Query().Get("start") == "":
+				fmt.Fprintf(res, providerPortsBody, fmt.Sprintf(providerPortBody, "port1"), `, "next": {"href": "x", "start": "p2"}`)
+			case req.URL.Path == "/ports":
+				fmt.Fprintf(res, providerPortsBody, fmt.Sprintf(providerPortBody, "port2"), "")
+			case req.URL.Path == "/gateways" && req.Method == "GET":
+				gateways := []interface{}{}
+				if gateway != nil {
+					gateways = append(gateways, gateway)
+				}
+				Expect(json.NewEncoder(res).Encode(map[string]interface{}{"gateways": gateways})).To(Succeed())
+			case req.URL.Path == "/gateways" && req.Method == "POST":
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				gateway = body
+				gateway["id"] = "gw1"
+				gateway["operational_status"] = "create_pending"
+				gateway["change_request"] = map[string]interface{}{"type": "create_gateway"}
+				res.WriteHeader(201)
+				Expect(json.NewEncoder(res).Encode(gateway)).To(Succeed())
+			case req.Method == "PATCH":
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				if name, ok := body["name"]; ok {
+					gateway["name"] = name
+				} else {
+					updates := []interface{}{}
+					for key, value := range body {
+						updates = append(updates, map[string]interface{}{key: value})
+					}
+					gateway["change_request"] = map[string]interface{}{"type": "update_attributes", "updates": updates}
+					gateway["pending"] = body
+				}
+				Expect(json.NewEncoder(res).Encode(gateway)).To(Succeed())
+			default:
+				pollHeaders = append(pollHeaders, req.Header.Get("X-Test"))
+				polls--
+				if polls == 0 && gateway["change_request"] != nil {
+					delete(gateway, "change_request")
+					if gateway["operational_status"] == "create_pending" {
+						gateway["operational_status"] = map[bool]string{true: "provisioned", false: "create_rejected"}[accept]
+					} else if accept {
+						for key, value := range gateway["pending"].(map[string]interface{}) {
+							gateway[key] = value
+						}
+					}
+				}
+				Expect(json.NewEncoder(res).Encode(gateway)).To(Succeed())
+			}
+		}))
+		var serviceErr error
+		service, serviceErr = directlinkproviderv2.NewDirectLinkProviderV2(&directlinkproviderv2.DirectLinkProviderV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			Version:       core.StringPtr("2020-04-28"),
+		})
+		Expect(serviceErr).To(BeNil())
+		service.SetWaitPolicy(common.NewWaitPolicy().SetInterval(time.Millisecond, time.Millisecond))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	createOptions := func(portID string, speed int64) *directlinkproviderv2.CreateProviderGatewayOptions {
+		return service.NewCreateProviderGatewayOptions(64999, "customer1", "gw-one",
+			&directlinkproviderv2.ProviderGatewayPortIdentity{ID: core.StringPtr(portID)}, speed)
+	}
+	validationFields := func(err error) (fields []string) {
+		validationErrs, ok := common.AsValidationErrors(err)
+		Expect(ok).To(BeTrue())
+		for _, validationErr := range validationErrs {
+			fields = append(fields, validationErr.Field)
+		}
+		return
+	}
+
+	It(`Creates a gateway and waits until the customer accepts it`, func() {
+		result, err := service.OnboardProviderGateway(createOptions("port2", 2000))
+		Expect(err).To(BeNil())
+		Expect(*result.OperationalStatus).To(Equal("provisioned"))
+		Expect(requests).To(Equal([]string{
+			"GET /ports", "GET /ports", "GET /gateways", "POST /gateways", "GET /gateways/gw1", "GET /gateways/gw1",
+		}))
+
+		requests = nil
+		result, err = service.OnboardProviderGateway(createOptions("port2", 2000))
+		Expect(err).To(BeNil())
+		Expect(*result.ID).To(Equal("gw1"))
+		Expect(requests).To(Equal([]string{"GET /ports", "GET /ports", "GET /gateways"}))
+	})
+
+	It(`Only waits for the gateway unless check_only is true`, func() {
+		result, err := service.OnboardProviderGateway(createOptions("port2", 2000).SetCheckOnly("true"))
+		Expect(err).To(BeNil())
+		Expect(*result.OperationalStatus).To(Equal("create_pending"))
+		Expect(requests[len(requests)-1]).To(Equal("POST /gateways"))
+
+		// An existing gateway is returned as is.
+		requests = nil
+		result, err = service.OnboardProviderGateway(createOptions("port2", 2000).SetCheckOnly("true"))
+		Expect(err).To(BeNil())
+		Expect(*result.OperationalStatus).To(Equal("create_pending"))
+		Expect(requests).To(Equal([]string{"GET /ports", "GET /ports", "GET /gateways"}))
+
+		gateway = nil
+		result, err = service.OnboardProviderGateway(createOptions("port2", 2000).SetCheckOnly("false"))
+		Expect(err).To(BeNil())
+		Expect(*result.OperationalStatus).To(Equal("provisioned"))
+	})
+
+	It(`Reports a gateway the customer rejects`, func() {
+		accept = false
+		result, err := service.OnboardProviderGateway(createOptions("port1", 1000))
+		var waitErr *common.WaitError
+		Expect(errors.As(err, &waitErr)).To(BeTrue())
+		Expect(waitErr.Status).To(Equal("create_rejected"))
+		Expect(*result.OperationalStatus).To(Equal("create_rejected"))
+	})
+
+	It(`Validates the gateway against the port before creating it`, func() {
+		_, err := service.OnboardProviderGateway(createOptions("port1", 10000).SetBgpAsn(64512).SetVlan(4000))
+		Expect(validationFields(err)).To(Equal([]string{"speed_mbps", "bgp_asn", "vlan"}))
+		Expect(err.Error()).To(ContainSubstring("(1000, 2000, 5000)"))
+
+		_, err = service.OnboardProviderGateway(createOptions("port3", 1000))
+		Expect(validationFields(err)).To(Equal([]string{"port.id"}))
+		Expect(requests).ToNot(ContainElement("POST /gateways"))
+
+		_, err = service.OnboardProviderGateway(nil)
+		var sdkProblem *core.SDKProblem
+		Expect(errors.As(err, &sdkProblem)).To(BeTrue())
+		_, err = service.WaitForProviderGatewayStatus(context.Background(), "gw1")
+		Expect(errors.As(err, &sdkProblem)).To(BeTrue())
+		Expect(err.Error()).To(Equal("at least one target status must be given"))
+	})
+
+	It(`Sends the headers of the options with every poll of the gateway`, func() {
+		headers := map[string]string{"X-Test": "onboard"}
+		_, err := service.OnboardProviderGateway(createOptions("port1", 1000).SetHeaders(headers))
+		Expect(err).To(BeNil())
+		Expect(pollHeaders).To(Equal([]string{"onboard", "onboard"}))
+
+		polls, pollHeaders = 3, nil
+		_, err = service.ChangeProviderGateway(service.NewUpdateProviderGatewayOptions("gw1").SetBgpAsn(4201000001).SetHeaders(headers))
+		Expect(err).To(BeNil())
+		// The gateway is read once before the update and polled twice after it.
+		Expect(pollHeaders).To(Equal([]string{"onboard", "onboard", "onboard"}))
+	})
+
+	It(`Tracks speed changes until the customer answers`, func() {
+		_, err := service.OnboardProviderGateway(createOptions("port1", 1000))
+		Expect(err).To(BeNil())
+
+		polls = 3
+		pending, _, err := service.UpdateProviderGateway(service.NewUpdateProviderGatewayOptions("gw1").SetSpeedMbps(5000))
+		Expect(err).To(BeNil())
+		changes, err := service.ListPendingProviderGatewayChanges(nil)
+		Expect(err).To(BeNil())
+		Expect(changes).To(HaveLen(1))
+		Expect(changes[0].Type).To(Equal("update_attributes"))
+		Expect(*changes[0].Updates.SpeedMbps).To(Equal(int64(5000)))
+		Expect(*pending.ID).To(Equal("gw1"))
+
+		_, err = service.ChangeProviderGateway(service.NewUpdateProviderGatewayOptions("gw1").SetBgpAsn(65000))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("pending update_attributes change request"))
+
+		result, err := service.WaitForProviderGatewayChangeRequest(context.Background(), "gw1")
+		Expect(err).To(BeNil())
+		Expect(*result.SpeedMbps).To(Equal(int64(5000)))
+	})
+
+	It(`Reports whether the customer accepted or rejected a change`, func() {
+		_, err := service.OnboardProviderGateway(createOptions("port1", 1000))
+		Expect(err).To(BeNil())
+
+		polls = 3
+		change, err := service.ChangeProviderGateway(service.NewUpdateProviderGatewayOptions("gw1").SetBgpAsn(4201000001))
+		Expect(err).To(BeNil())
+		Expect(change.Status).To(Equal(directlinkproviderv2.ProviderGatewayChange_Status_Accepted))
+		Expect(*change.Gateway.BgpAsn).To(Equal(int64(4201000001)))
+
+		polls, accept = 3, false
+		change, err = service.ChangeProviderGateway(service.NewUpdateProviderGatewayOptions("gw1").SetSpeedMbps(2000))
+		var waitErr *common.WaitError
+		Expect(errors.As(err, &waitErr)).To(BeTrue())
+		Expect(waitErr.Reasons).To(Equal([]string{"speed_mbps is 1000, not 2000"}))
+		Expect(change.Status).To(Equal(directlinkproviderv2.ProviderGatewayChange_Status_Rejected))
+
+		change, err = service.ChangeProviderGateway(service.NewUpdateProviderGatewayOptions("gw1").SetName("gw-renamed"))
+		Expect(err).To(BeNil())
+		Expect(change.Status).To(Equal(directlinkproviderv2.ProviderGatewayChange_Status_Applied))
+
+		_, err = service.ChangeProviderGateway(service.NewUpdateProviderGatewayOptions("gw1").SetSpeedMbps(3000))
+		Expect(validationFields(err)).To(Equal([]string{"speed_mbps"}))
+	})
+})
@@ -0,0 +1,106 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package directlinkproviderv2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+)
+
+// SetWaitPolicy sets how the waiters of this service instance poll. Passing nil restores the defaults of
+// common.NewWaitPolicy.
+func (directLinkProvider *DirectLinkProviderV2) SetWaitPolicy(policy *common.WaitPolicy) {
+	directLinkProvider.WaitPolicy = policy
+}
+
+// WaitForProviderGatewayStatus : Wait for a provider gateway status
+// Poll the gateway until its operational status is one of the target statuses and return it. If the customer rejects
+// the gateway (create_rejected) while that is not a target, the gateway is deleted, or the context ends first, a
+// *common.WaitError carrying the last status is returned together with the last gateway polled.
+func (directLinkProvider *DirectLinkProviderV2) WaitForProviderGatewayStatus(ctx context.Context, id string, targetStatuses ...string) (result *ProviderGateway, err error) {
+	result, err = directLinkProvider.waitForProviderGatewayStatus(ctx, id, nil, targetStatuses...)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// waitForProviderGatewayStatus is WaitForProviderGatewayStatus sending "headers" with every poll.
+func (directLinkProvider *DirectLinkProviderV2) waitForProviderGatewayStatus(ctx context.Context, id string, headers map[string]string, targetStatuses ...string) (result *ProviderGateway, err error) {
+	if len(targetStatuses) == 0 {
+		err = core.SDKErrorf(nil, "at least one target status must be given", "missing-target-status", common.GetComponentInfo())
+		return
+	}
+	getProviderGatewayOptions := directLinkProvider.NewGetProviderGatewayOptions(id).SetHeaders(headers)
+	err = common.Wait(ctx, directLinkProvider.WaitPolicy, "provider gateway "+id, func(ctx context.Context) (status common.WaitStatus, err error) {
+		gateway, _, err := directLinkProvider.GetProviderGatewayWithContext(ctx, getProviderGatewayOptions)
+		if common.IsNotFound(err) {
+			return common.WaitStatus{Failed: true, Reasons: []string{"the gateway was deleted"}}, nil
+		}
+		if err != nil {
+			return
+		}
+		result = gateway
+		operationalStatus := ""
+		if gateway.OperationalStatus != nil {
+			operationalStatus = *gateway.OperationalStatus
+		}
+		status.Status = operationalStatus
+		for _, targetStatus := range targetStatuses {
+			if operationalStatus == targetStatus {
+				status.Done = true
+				return
+			}
+		}
+		status.Failed = operationalStatus == ProviderGateway_OperationalStatus_CreateRejected
+		return
+	})
+	err = core.RepurposeSDKProblem(err, "wait-error")
+	return
+}
+
+// WaitForProviderGatewayChangeRequest : Wait for the customer to answer a change request
+// Poll the gateway until it has no pending change request, i.e. the customer accepted or rejected the change, and
+// return it. If the gateway is deleted or the context ends first, a *common.WaitError carrying the last operational
+// status is returned together with the last gateway polled.
+func (directLinkProvider *DirectLinkProviderV2) WaitForProviderGatewayChangeRequest(ctx context.Context, id string) (result *ProviderGateway, err error) {
+	result, err = directLinkProvider.waitForProviderGatewayChangeRequest(ctx, id, nil)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// waitForProviderGatewayChangeRequest is WaitForProviderGatewayChangeRequest sending "headers" with every poll.
+func (directLinkProvider *DirectLinkProviderV2) waitForProviderGatewayChangeRequest(ctx context.Context, id string, headers map[string]string) (result *ProviderGateway, err error) {
+	getProviderGatewayOptions := directLinkProvider.NewGetProviderGatewayOptions(id).SetHeaders(headers)
+	err = common.Wait(ctx, directLinkProvider.WaitPolicy, "change request of provider gateway "+id, func(ctx context.Context) (status common.WaitStatus, err error) {
+		gateway, _, err := directLinkProvider.GetProviderGatewayWithContext(ctx, getProviderGatewayOptions)
+		if common.IsNotFound(err) {
+			return common.WaitStatus{Failed: true, Reasons: []string{"the gateway was deleted"}}, nil
+		}
+		if err != nil {
+			return
+		}
+		result = gateway
+		if gateway.OperationalStatus != nil {
+			status.Status = *gateway.OperationalStatus
+		}
+		status.Done = gateway.ChangeRequest == nil
+		return
+	})
+	err = core.RepurposeSDKProblem(err, "wait-error")
+	return
+}
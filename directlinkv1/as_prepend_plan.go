@@ -0,0 +1,333 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package directlinkv1
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+)
+
+// Limits of the AS prepends of a gateway, checked by ComputeAsPrependsPlan.
+const (
+	// The minimum number of times the ASN can be prepended.
+	AsPrependMinLength = 3
+
+	// The maximum number of times the ASN can be prepended.
+	AsPrependMaxLength = 10
+
+	// The maximum number of specific prefixes of an AS prepend.
+	AsPrependMaxSpecificPrefixes = 10
+)
+
+// PlanGatewayAsPrepends : Plan AS prepend changes
+// List the AS prepends of a gateway together with their ETag and compute the changes needed to reach the desired AS
+// prepends. Apply the plan with ApplyGatewayAsPrependsPlan.
+func (directLink *DirectLinkV1) PlanGatewayAsPrepends(planGatewayAsPrependsOptions *PlanGatewayAsPrependsOptions) (plan *AsPrependsPlan, err error) {
+	plan, err = directLink.PlanGatewayAsPrependsWithContext(context.Background(), planGatewayAsPrependsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PlanGatewayAsPrependsWithContext is an alternate form of the PlanGatewayAsPrepends method which supports a Context parameter
+func (directLink *DirectLinkV1) PlanGatewayAsPrependsWithContext(ctx context.Context, planGatewayAsPrependsOptions *PlanGatewayAsPrependsOptions) (plan *AsPrependsPlan, err error) {
+	err = core.ValidateNotNil(planGatewayAsPrependsOptions, "planGatewayAsPrependsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(planGatewayAsPrependsOptions, "planGatewayAsPrependsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	collection, response, err := directLink.ListGatewayAsPrependsWithContext(ctx, &ListGatewayAsPrependsOptions{
+		GatewayID: planGatewayAsPrependsOptions.GatewayID,
+		Headers:   planGatewayAsPrependsOptions.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-as-prepends-error")
+		return
+	}
+	plan, err = ComputeAsPrependsPlan(collection.AsPrepends, planGatewayAsPrependsOptions.AsPrepends)
+	if err != nil {
+		return
+	}
+	plan.GatewayID = *planGatewayAsPrependsOptions.GatewayID
+	plan.ETag = response.GetHeaders().Get("ETag")
+	plan.Headers = planGatewayAsPrependsOptions.Headers
+	return
+}
+
+// ApplyGatewayAsPrependsPlan : Apply an AS prepend plan
+// Replace the AS prepends of the gateway with the desired AS prepends of the plan, provided they have not changed
+// since the plan was made. If they have, the request fails with 412 Precondition Failed (see
+// common.IsPreconditionFailed) and a new plan should be made. Nothing is sent if the plan has no changes.
+func (directLink *DirectLinkV1) ApplyGatewayAsPrependsPlan(plan *AsPrependsPlan) (result *AsPrependCollection, response *core.DetailedResponse, err error) {
+	result, response, err = directLink.ApplyGatewayAsPrependsPlanWithContext(context.Background(), plan)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ApplyGatewayAsPrependsPlanWithContext is an alternate form of the ApplyGatewayAsPrependsPlan method which supports a Context parameter
+func (directLink *DirectLinkV1) ApplyGatewayAsPrependsPlanWithContext(ctx context.Context, plan *AsPrependsPlan) (result *AsPrependCollection, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(plan, "plan cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if !plan.HasChanges() {
+		return
+	}
+	if plan.ETag == "" {
+		err = core.SDKErrorf(nil, "the plan has no ETag to replace the AS prepends with", "missing-etag", common.GetComponentInfo())
+		return
+	}
+	result, response, err = directLink.ReplaceGatewayAsPrependsWithContext(ctx, plan.ReplaceGatewayAsPrependsOptions())
+	err = core.RepurposeSDKProblem(err, "replace-as-prepends-error")
+	return
+}
+
+// ComputeAsPrependsPlan computes the changes that turn the "current" AS prepends of a gateway into "desired". AS
+// prepends are matched by policy and set of specific prefixes; a matching AS prepend with another length is updated.
+// The desired AS prepends are checked first, and every problem is listed in the common.ValidationErrors returned:
+//   - the length is between AsPrependMinLength and AsPrependMaxLength,
+//   - the policy is import or export,
+//   - the specific prefixes are IPv4 prefixes in canonical form, at most AsPrependMaxSpecificPrefixes of them,
+//   - each policy has at most one AS prepend for all prefixes, and each prefix is in at most one AS prepend of a
+//     policy.
+func ComputeAsPrependsPlan(current []AsPrependEntry, desired []AsPrependPrefixArrayTemplate) (plan *AsPrependsPlan, err error) {
+	if err = validateAsPrepends(desired); err != nil {
+		return
+	}
+
+	plan = &AsPrependsPlan{}
+	currentByKey := map[string]AsPrependEntry{}
+	for _, entry := range current {
+		currentByKey[asPrependKey(stringValue(entry.Policy), entry.SpecificPrefixes)] = entry
+	}
+	for _, template := range desired {
+		template = AsPrependPrefixArrayTemplate{
+			Length:           template.Length,
+			Policy:           template.Policy,
+			SpecificPrefixes: sortedAsPrependPrefixes(template.SpecificPrefixes),
+		}
+		plan.Desired = append(plan.Desired, template)
+		key := asPrependKey(*template.Policy, template.SpecificPrefixes)
+		entry, ok := currentByKey[key]
+		switch {
+		case !ok:
+			plan.Creates = append(plan.Creates, template)
+		case entry.Length == nil || *entry.Length != *template.Length:
+			plan.Updates = append(plan.Updates, AsPrependUpdate{Current: entry, Desired: template})
+		default:
+			plan.Unchanged = append(plan.Unchanged, entry)
+		}
+		delete(currentByKey, key)
+	}
+	for _, entry := range current {
+		if _, ok := currentByKey[asPrependKey(stringValue(entry.Policy), entry.SpecificPrefixes)]; ok {
+			plan.Deletes = append(plan.Deletes, entry)
+		}
+	}
+	return
+}
+
+// PlanGatewayAsPrependsOptions : The PlanGatewayAsPrepends options.
+type PlanGatewayAsPrependsOptions struct {
+	// Direct Link gateway identifier.
+	GatewayID *string `json:"gateway_id" validate:"required,ne="`
+
+	// The desired AS prepends. An empty list removes all AS prepends.
+	AsPrepends []AsPrependPrefixArrayTemplate `json:"as_prepends,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewPlanGatewayAsPrependsOptions : Instantiate PlanGatewayAsPrependsOptions
+func (*DirectLinkV1) NewPlanGatewayAsPrependsOptions(gatewayID string) *PlanGatewayAsPrependsOptions {
+	return &PlanGatewayAsPrependsOptions{
+		GatewayID: core.StringPtr(gatewayID),
+	}
+}
+
+// SetGatewayID : Allow user to set GatewayID
+func (_options *PlanGatewayAsPrependsOptions) SetGatewayID(gatewayID string) *PlanGatewayAsPrependsOptions {
+	_options.GatewayID = core.StringPtr(gatewayID)
+	return _options
+}
+
+// SetAsPrepends : Allow user to set AsPrepends
+func (_options *PlanGatewayAsPrependsOptions) SetAsPrepends(asPrepends []AsPrependPrefixArrayTemplate) *PlanGatewayAsPrependsOptions {
+	_options.AsPrepends = asPrepends
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *PlanGatewayAsPrependsOptions) SetHeaders(param map[string]string) *PlanGatewayAsPrependsOptions {
+	options.Headers = param
+	return options
+}
+
+// AsPrependsPlan : The changes that bring the AS prepends of a gateway to their desired state.
+type AsPrependsPlan struct {
+	// Direct Link gateway identifier.
+	GatewayID string
+
+	// The ETag of the AS prepends when the plan was made.
+	ETag string
+
+	// The desired AS prepends, with their specific prefixes sorted.
+	Desired []AsPrependPrefixArrayTemplate
+
+	// AS prepends to create.
+	Creates []AsPrependPrefixArrayTemplate
+
+	// AS prepends whose length changes.
+	Updates []AsPrependUpdate
+
+	// AS prepends to delete.
+	Deletes []AsPrependEntry
+
+	// AS prepends that already match the desired state.
+	Unchanged []AsPrependEntry
+
+	// The headers of the PlanGatewayAsPrepends request, sent again when the plan is applied.
+	Headers map[string]string
+}
+
+// AsPrependUpdate : An AS prepend whose length changes.
+type AsPrependUpdate struct {
+	Current AsPrependEntry
+	Desired AsPrependPrefixArrayTemplate
+}
+
+// HasChanges returns true if applying the plan would change the AS prepends.
+func (plan *AsPrependsPlan) HasChanges() bool {
+	return len(plan.Creates)+len(plan.Updates)+len(plan.Deletes) > 0
+}
+
+// ReplaceGatewayAsPrependsOptions returns the ReplaceGatewayAsPrepends request that applies the plan.
+func (plan *AsPrependsPlan) ReplaceGatewayAsPrependsOptions() *ReplaceGatewayAsPrependsOptions {
+	asPrepends := plan.Desired
+	if asPrepends == nil {
+		asPrepends = []AsPrependPrefixArrayTemplate{}
+	}
+	return &ReplaceGatewayAsPrependsOptions{
+		GatewayID:  core.StringPtr(plan.GatewayID),
+		IfMatch:    core.StringPtr(plan.ETag),
+		AsPrepends: asPrepends,
+		Headers:    plan.Headers,
+	}
+}
+
+// String renders the plan as a diff, one AS prepend per line: "+" creates, "~" updates and "-" deletes.
+func (plan *AsPrependsPlan) String() string {
+	var lines []string
+	for _, template := range plan.Creates {
+		lines = append(lines, "+ "+describeAsPrepend(*template.Policy, template.SpecificPrefixes, template.Length))
+	}
+	for _, update := range plan.Updates {
+		lines = append(lines, fmt.Sprintf("~ %s => length %d", describeAsPrepend(stringValue(update.Current.Policy), update.Current.SpecificPrefixes, update.Current.Length), *update.Desired.Length))
+	}
+	for _, entry := range plan.Deletes {
+		lines = append(lines, "- "+describeAsPrepend(stringValue(entry.Policy), entry.SpecificPrefixes, entry.Length))
+	}
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i][2:] < lines[j][2:]
+	})
+	if len(lines) == 0 {
+		return "no changes\n"
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func validateAsPrepends(asPrepends []AsPrependPrefixArrayTemplate) error {
+	var validationErrs common.ValidationErrors
+	allPrefixes := map[string]int{}
+	prefixOwners := map[string]int{}
+	for i, template := range asPrepends {
+		field := fmt.Sprintf("as_prepends[%d]", i)
+		if template.Length == nil || *template.Length < AsPrependMinLength || *template.Length > AsPrependMaxLength {
+			validationErrs.Add(field+".length", "must be between %d and %d", AsPrependMinLength, AsPrependMaxLength)
+		}
+		policy := stringValue(template.Policy)
+		if policy != AsPrependPrefixArrayTemplate_Policy_Import && policy != AsPrependPrefixArrayTemplate_Policy_Export {
+			validationErrs.Add(field+".policy", "must be import or export, got %q", policy)
+			continue
+		}
+		if len(template.SpecificPrefixes) == 0 {
+			if other, ok := allPrefixes[policy]; ok {
+				validationErrs.Add(field, "applies to all prefixes, like as_prepends[%d] of the %s policy", other, policy)
+			} else {
+				allPrefixes[policy] = i
+			}
+			continue
+		}
+		if len(template.SpecificPrefixes) > AsPrependMaxSpecificPrefixes {
+			validationErrs.Add(field+".specific_prefixes", "must have at most %d prefixes, got %d", AsPrependMaxSpecificPrefixes, len(template.SpecificPrefixes))
+		}
+		for j, prefix := range template.SpecificPrefixes {
+			prefixField := fmt.Sprintf("%s.specific_prefixes[%d]", field, j)
+			parsed, err := netip.ParsePrefix(prefix)
+			if err != nil || !parsed.Addr().Is4() || parsed.Masked() != parsed {
+				validationErrs.Add(prefixField, "%q is not an IPv4 prefix in canonical form", prefix)
+				continue
+			}
+			key := policy + " " + parsed.String()
+			if other, ok := prefixOwners[key]; ok && other != i {
+				validationErrs.Add(prefixField, "%s is also in as_prepends[%d] of the %s policy", prefix, other, policy)
+			} else if ok {
+				validationErrs.Add(prefixField, "%s is listed twice", prefix)
+			}
+			prefixOwners[key] = i
+		}
+	}
+	return validationErrs.Err()
+}
+
+func asPrependKey(policy string, specificPrefixes []string) string {
+	return policy + " " + strings.Join(sortedAsPrependPrefixes(specificPrefixes), ",")
+}
+
+func sortedAsPrependPrefixes(prefixes []string) []string {
+	if len(prefixes) == 0 {
+		return nil
+	}
+	sorted := append([]string{}, prefixes...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+func describeAsPrepend(policy string, specificPrefixes []string, length *int64) string {
+	prefixes := "all prefixes"
+	if len(specificPrefixes) > 0 {
+		prefixes = strings.Join(sortedAsPrependPrefixes(specificPrefixes), ", ")
+	}
+	description := policy + " " + prefixes
+	if length != nil {
+		description += fmt.Sprintf(" length %d", *length)
+	}
+	return description
+}
@@ -0,0 +1,149 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package directlinkv1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const asPrependsBody = `{"as_prepends": [
+	{"id": "p1", "length": 4, "policy": "import", "created_at": "2019-01-01T12:00:00.000Z", "updated_at": "2019-01-01T12:00:00.000Z"},
+	{"id": "p2", "length": 3, "policy": "export", "specific_prefixes": ["10.2.0.0/16", "10.1.0.0/16"], "created_at": "2019-01-01T12:00:00.000Z", "updated_at": "2019-01-01T12:00:00.000Z"},
+	{"id": "p3", "length": 5, "policy": "export", "specific_prefixes": ["192.168.0.0/24"], "created_at": "2019-01-01T12:00:00.000Z", "updated_at": "2019-01-01T12:00:00.000Z"}
+]}`
+
+var _ = Describe(`DirectLinkV1 AS prepend plans`, func() {
+	asPrepend := func(length int64, policy string, prefixes ...string) directlinkv1.AsPrependPrefixArrayTemplate {
+		return directlinkv1.AsPrependPrefixArrayTemplate{Length: core.Int64Ptr(length), Policy: core.StringPtr(policy), SpecificPrefixes: prefixes}
+	}
+	current := func() []directlinkv1.AsPrependEntry {
+		var collection directlinkv1.AsPrependCollection
+		Expect(json.Unmarshal([]byte(asPrependsBody), &collection)).To(Succeed())
+		return collection.AsPrepends
+	}
+
+	It(`Computes the changes to reach the desired AS prepends`, func() {
+		plan, err := directlinkv1.ComputeAsPrependsPlan(current(), []directlinkv1.AsPrependPrefixArrayTemplate{
+			asPrepend(4, "import"),
+			asPrepend(6, "export", "10.1.0.0/16", "10.2.0.0/16"),
+			asPrepend(3, "export", "172.16.0.0/12"),
+		})
+		Expect(err).To(BeNil())
+		Expect(plan.HasChanges()).To(BeTrue())
+		Expect(plan.Unchanged).To(HaveLen(1))
+		Expect(plan.Updates).To(HaveLen(1))
+		Expect(*plan.Updates[0].Current.ID).To(Equal("p2"))
+		Expect(plan.Creates).To(HaveLen(1))
+		Expect(plan.Deletes).To(HaveLen(1))
+		Expect(plan.String()).To(Equal(`~ export 10.1.0.0/16, 10.2.0.0/16 length 3 => length 6
++ export 172.16.0.0/12 length 3
+- export 192.168.0.0/24 length 5
+`))
+
+		plan, err = directlinkv1.ComputeAsPrependsPlan(current(), []directlinkv1.AsPrependPrefixArrayTemplate{
+			asPrepend(4, "import"),
+			asPrepend(3, "export", "10.2.0.0/16", "10.1.0.0/16"),
+			asPrepend(5, "export", "192.168.0.0/24"),
+		})
+		Expect(err).To(BeNil())
+		Expect(plan.HasChanges()).To(BeFalse())
+		Expect(plan.String()).To(Equal("no changes\n"))
+	})
+
+	It(`Lists every problem of the desired AS prepends`, func() {
+		_, err := directlinkv1.ComputeAsPrependsPlan(nil, []directlinkv1.AsPrependPrefixArrayTemplate{
+			asPrepend(2, "import"),
+			asPrepend(4, "import"),
+			asPrepend(4, "export", "10.1.0.0/16", "10.1.2.0/16", "10.1.0.0/16"),
+			asPrepend(4, "export", "10.1.0.0/16"),
+			asPrepend(4, "both"),
+		})
+		validationErrs, ok := common.AsValidationErrors(err)
+		Expect(ok).To(BeTrue())
+		var fields []string
+		for _, validationErr := range validationErrs {
+			fields = append(fields, validationErr.Field)
+		}
+		Expect(fields).To(Equal([]string{
+			"as_prepends[0].length",
+			"as_prepends[1]",
+			"as_prepends[2].specific_prefixes[1]",
+			"as_prepends[2].specific_prefixes[2]",
+			"as_prepends[3].specific_prefixes[0]",
+			"as_prepends[4].policy",
+		}))
+	})
+
+	It(`Plans against the gateway and replaces with If-Match`, func() {
+		var requests []string
+		var ifMatch, body, transactionID string
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			requests = append(requests, req.Method+" "+req.URL.Path)
+			res.Header().Set("Content-type", "application/json")
+			res.Header().Set("ETag", `W/"v1"`)
+			if req.Method == "PUT" {
+				ifMatch = req.Header.Get("If-Match")
+				transactionID = req.Header.Get("Transaction-Id")
+				raw, _ := io.ReadAll(req.Body)
+				body = strings.TrimSpace(string(raw))
+			}
+			res.WriteHeader(200)
+			fmt.Fprint(res, asPrependsBody)
+		}))
+		defer testServer.Close()
+		directLinkService, serviceErr := directlinkv1.NewDirectLinkV1(&directlinkv1.DirectLinkV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			Version:       core.StringPtr("2024-01-01"),
+		})
+		Expect(serviceErr).To(BeNil())
+
+		plan, err := directLinkService.PlanGatewayAsPrepends(directLinkService.NewPlanGatewayAsPrependsOptions("gw1").
+			SetAsPrepends([]directlinkv1.AsPrependPrefixArrayTemplate{asPrepend(4, "import")}).
+			SetHeaders(map[string]string{"Transaction-Id": "tx1"}))
+		Expect(err).To(BeNil())
+		Expect(plan.ETag).To(Equal(`W/"v1"`))
+		Expect(plan.Deletes).To(HaveLen(2))
+
+		result, _, err := directLinkService.ApplyGatewayAsPrependsPlan(plan)
+		Expect(err).To(BeNil())
+		Expect(result.AsPrepends).To(HaveLen(3))
+		Expect(requests).To(Equal([]string{"GET /gateways/gw1/as_prepends", "PUT /gateways/gw1/as_prepends"}))
+		Expect(ifMatch).To(Equal(`W/"v1"`))
+		Expect(transactionID).To(Equal("tx1"))
+		Expect(body).To(Equal(`{"as_prepends":[{"length":4,"policy":"import"}]}`))
+
+		plan.Creates, plan.Deletes = nil, nil
+		result, _, err = directLinkService.ApplyGatewayAsPrependsPlan(plan)
+		Expect(err).To(BeNil())
+		Expect(result).To(BeNil())
+		Expect(requests).To(HaveLen(2))
+	})
+})
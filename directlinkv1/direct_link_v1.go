@@ -45,6 +45,9 @@ type DirectLinkV1 struct {
 	// Requests the version of the API as a date in the format `YYYY-MM-DD`. Any date from 2019-12-13 up to the current
 	// date may be provided. Specify the current date to request the latest version.
 	Version *string
+
+	// How the waiters poll, or nil for the defaults of common.NewWaitPolicy.
+	WaitPolicy *common.WaitPolicy
 }
 
 // DefaultServiceURL is the default URL to make service requests to.
@@ -187,6 +190,12 @@ func (directLink *DirectLinkV1) DisableRetries() {
 	directLink.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (directLink *DirectLinkV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(directLink.Service, policy)
+}
+
 // ListGateways : List gateways
 // List all Direct Link gateways in this account.  Gateways in other accounts with connections to networks in this
 // account are also returned.
@@ -234,6 +243,7 @@ func (directLink *DirectLinkV1) ListGatewaysWithContext(ctx context.Context, lis
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_gateways", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -308,6 +318,7 @@ func (directLink *DirectLinkV1) CreateGatewayWithContext(ctx context.Context, cr
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_gateway", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -377,6 +388,7 @@ func (directLink *DirectLinkV1) DeleteGatewayWithContext(ctx context.Context, de
 
 	response, err = directLink.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_gateway", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -440,6 +452,7 @@ func (directLink *DirectLinkV1) GetGatewayWithContext(ctx context.Context, getGa
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_gateway", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -518,6 +531,7 @@ func (directLink *DirectLinkV1) UpdateGatewayWithContext(ctx context.Context, up
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_gateway", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -639,6 +653,7 @@ func (directLink *DirectLinkV1) CreateGatewayActionWithContext(ctx context.Conte
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_gateway_action", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -709,6 +724,7 @@ func (directLink *DirectLinkV1) ListGatewayCompletionNoticeWithContext(ctx conte
 
 	response, err = directLink.Service.Request(request, &result)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_gateway_completion_notice", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -779,6 +795,7 @@ func (directLink *DirectLinkV1) CreateGatewayCompletionNoticeWithContext(ctx con
 
 	response, err = directLink.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_gateway_completion_notice", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -841,6 +858,7 @@ func (directLink *DirectLinkV1) ListGatewayLetterOfAuthorizationWithContext(ctx
 
 	response, err = directLink.Service.Request(request, &result)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_gateway_letter_of_authorization", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -906,6 +924,7 @@ func (directLink *DirectLinkV1) GetGatewayStatisticsWithContext(ctx context.Cont
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_gateway_statistics", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -980,6 +999,7 @@ func (directLink *DirectLinkV1) GetGatewayStatusWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_gateway_status", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1051,6 +1071,7 @@ func (directLink *DirectLinkV1) ListGatewayAsPrependsWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_gateway_as_prepends", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1137,6 +1158,7 @@ func (directLink *DirectLinkV1) ReplaceGatewayAsPrependsWithContext(ctx context.
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "replace_gateway_as_prepends", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1215,6 +1237,7 @@ func (directLink *DirectLinkV1) ListGatewayExportRouteFiltersWithContext(ctx con
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_gateway_export_route_filters", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1319,6 +1342,7 @@ func (directLink *DirectLinkV1) CreateGatewayExportRouteFilterWithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_gateway_export_route_filter", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1404,6 +1428,7 @@ func (directLink *DirectLinkV1) ReplaceGatewayExportRouteFiltersWithContext(ctx
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "replace_gateway_export_route_filters", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1478,6 +1503,7 @@ func (directLink *DirectLinkV1) DeleteGatewayExportRouteFilterWithContext(ctx co
 
 	response, err = directLink.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_gateway_export_route_filter", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1542,6 +1568,7 @@ func (directLink *DirectLinkV1) GetGatewayExportRouteFilterWithContext(ctx conte
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_gateway_export_route_filter", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1629,6 +1656,7 @@ func (directLink *DirectLinkV1) UpdateGatewayExportRouteFilterWithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_gateway_export_route_filter", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1706,6 +1734,7 @@ func (directLink *DirectLinkV1) ListGatewayImportRouteFiltersWithContext(ctx con
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_gateway_import_route_filters", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1810,6 +1839,7 @@ func (directLink *DirectLinkV1) CreateGatewayImportRouteFilterWithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_gateway_import_route_filter", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1895,6 +1925,7 @@ func (directLink *DirectLinkV1) ReplaceGatewayImportRouteFiltersWithContext(ctx
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "replace_gateway_import_route_filters", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1969,6 +2000,7 @@ func (directLink *DirectLinkV1) DeleteGatewayImportRouteFilterWithContext(ctx co
 
 	response, err = directLink.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_gateway_import_route_filter", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2033,6 +2065,7 @@ func (directLink *DirectLinkV1) GetGatewayImportRouteFilterWithContext(ctx conte
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_gateway_import_route_filter", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2120,6 +2153,7 @@ func (directLink *DirectLinkV1) UpdateGatewayImportRouteFilterWithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_gateway_import_route_filter", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2189,6 +2223,7 @@ func (directLink *DirectLinkV1) UnsetGatewayMacsecWithContext(ctx context.Contex
 
 	response, err = directLink.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "unset_gateway_macsec", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2252,6 +2287,7 @@ func (directLink *DirectLinkV1) GetGatewayMacsecWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_gateway_macsec", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2330,6 +2366,7 @@ func (directLink *DirectLinkV1) UpdateGatewayMacsecWithContext(ctx context.Conte
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_gateway_macsec", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2427,6 +2464,7 @@ func (directLink *DirectLinkV1) SetGatewayMacsecWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "set_gateway_macsec", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2498,6 +2536,7 @@ func (directLink *DirectLinkV1) ListGatewayMacsecCaksWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_gateway_macsec_caks", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2586,6 +2625,7 @@ func (directLink *DirectLinkV1) CreateGatewayMacsecCakWithContext(ctx context.Co
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_gateway_macsec_cak", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2656,6 +2696,7 @@ func (directLink *DirectLinkV1) DeleteGatewayMacsecCakWithContext(ctx context.Co
 
 	response, err = directLink.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_gateway_macsec_cak", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2720,6 +2761,7 @@ func (directLink *DirectLinkV1) GetGatewayMacsecCakWithContext(ctx context.Conte
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_gateway_macsec_cak", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2799,6 +2841,7 @@ func (directLink *DirectLinkV1) UpdateGatewayMacsecCakWithContext(ctx context.Co
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_gateway_macsec_cak", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2870,6 +2913,7 @@ func (directLink *DirectLinkV1) ListGatewayRouteReportsWithContext(ctx context.C
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_gateway_route_reports", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2945,6 +2989,7 @@ func (directLink *DirectLinkV1) CreateGatewayRouteReportWithContext(ctx context.
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_gateway_route_report", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3015,6 +3060,7 @@ func (directLink *DirectLinkV1) DeleteGatewayRouteReportWithContext(ctx context.
 
 	response, err = directLink.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_gateway_route_report", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3079,6 +3125,7 @@ func (directLink *DirectLinkV1) GetGatewayRouteReportWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_gateway_route_report", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3151,6 +3198,7 @@ func (directLink *DirectLinkV1) ListGatewayVirtualConnectionsWithContext(ctx con
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_gateway_virtual_connections", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3239,6 +3287,7 @@ func (directLink *DirectLinkV1) CreateGatewayVirtualConnectionWithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_gateway_virtual_connection", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3309,6 +3358,7 @@ func (directLink *DirectLinkV1) DeleteGatewayVirtualConnectionWithContext(ctx co
 
 	response, err = directLink.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_gateway_virtual_connection", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3373,6 +3423,7 @@ func (directLink *DirectLinkV1) GetGatewayVirtualConnectionWithContext(ctx conte
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_gateway_virtual_connection", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3452,6 +3503,7 @@ func (directLink *DirectLinkV1) UpdateGatewayVirtualConnectionWithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_gateway_virtual_connection", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3523,6 +3575,7 @@ func (directLink *DirectLinkV1) ListOfferingTypeLocationsWithContext(ctx context
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_offering_type_locations", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3595,6 +3648,7 @@ func (directLink *DirectLinkV1) ListOfferingTypeLocationCrossConnectRoutersWithC
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_offering_type_location_cross_connect_routers", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3666,6 +3720,7 @@ func (directLink *DirectLinkV1) ListOfferingTypeSpeedsWithContext(ctx context.Co
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_offering_type_speeds", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3738,6 +3793,7 @@ func (directLink *DirectLinkV1) ListPortsWithContext(ctx context.Context, listPo
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_ports", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3809,6 +3865,7 @@ func (directLink *DirectLinkV1) GetPortWithContext(ctx context.Context, getPortO
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_port", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
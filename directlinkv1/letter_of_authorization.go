@@ -0,0 +1,295 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package directlinkv1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+)
+
+// PdfMaxSize is the largest letter of authorization or completion notice, in bytes, the helpers of this file accept.
+const PdfMaxSize int64 = 10 * 1024 * 1024
+
+// PdfContentType is the content type of letters of authorization and completion notices.
+const PdfContentType = "application/pdf"
+
+var (
+	pdfHeader  = []byte("%PDF-")
+	pdfTrailer = []byte("%%EOF")
+)
+
+// pdfTrailerWindow is how far from the end of a PDF its end-of-file marker
+// is looked for; writers may append whitespace or a few bytes after it.
+const pdfTrailerWindow = 1024
+
+// GetGatewayLetterOfAuthorizationPdf : Get a validated letter of authorization
+// Download the Letter of Authorization (LOA) of a Direct Link Dedicated gateway and check that it is a complete PDF
+// of at most PdfMaxSize bytes. A document that is not is reported with common.ValidationErrors on the
+// letter_of_authorization field.
+func (directLink *DirectLinkV1) GetGatewayLetterOfAuthorizationPdf(listGatewayLetterOfAuthorizationOptions *ListGatewayLetterOfAuthorizationOptions) (result []byte, err error) {
+	result, err = directLink.GetGatewayLetterOfAuthorizationPdfWithContext(context.Background(), listGatewayLetterOfAuthorizationOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetGatewayLetterOfAuthorizationPdfWithContext is an alternate form of the GetGatewayLetterOfAuthorizationPdf method which supports a Context parameter
+func (directLink *DirectLinkV1) GetGatewayLetterOfAuthorizationPdfWithContext(ctx context.Context, listGatewayLetterOfAuthorizationOptions *ListGatewayLetterOfAuthorizationOptions) (result []byte, err error) {
+	loa, _, err := directLink.ListGatewayLetterOfAuthorizationWithContext(ctx, listGatewayLetterOfAuthorizationOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-loa-error")
+		return
+	}
+	if loa == nil {
+		err = core.SDKErrorf(nil, "the letter of authorization is empty", "empty-loa", common.GetComponentInfo())
+		return
+	}
+	defer loa.Close()
+	pdf, err := readPdf(loa)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-loa-error", common.GetComponentInfo())
+		return
+	}
+	if err = validatePdf("letter_of_authorization", pdf).Err(); err != nil {
+		return
+	}
+	result = pdf
+	return
+}
+
+// SaveGatewayLetterOfAuthorization : Save the letter of authorization
+// Download and validate the Letter of Authorization (LOA) of a Direct Link Dedicated gateway as
+// GetGatewayLetterOfAuthorizationPdf does, and write it to the file at "path". The file is written to a temporary file
+// in the same directory first and renamed, so "path" never holds a partial document.
+func (directLink *DirectLinkV1) SaveGatewayLetterOfAuthorization(listGatewayLetterOfAuthorizationOptions *ListGatewayLetterOfAuthorizationOptions, path string) (err error) {
+	err = directLink.SaveGatewayLetterOfAuthorizationWithContext(context.Background(), listGatewayLetterOfAuthorizationOptions, path)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SaveGatewayLetterOfAuthorizationWithContext is an alternate form of the SaveGatewayLetterOfAuthorization method which supports a Context parameter
+func (directLink *DirectLinkV1) SaveGatewayLetterOfAuthorizationWithContext(ctx context.Context, listGatewayLetterOfAuthorizationOptions *ListGatewayLetterOfAuthorizationOptions, path string) (err error) {
+	pdf, err := directLink.GetGatewayLetterOfAuthorizationPdfWithContext(ctx, listGatewayLetterOfAuthorizationOptions)
+	if err != nil {
+		return
+	}
+	file, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "save-loa-error", common.GetComponentInfo())
+		return
+	}
+	defer os.Remove(file.Name())
+	_, err = file.Write(pdf)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), path)
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "save-loa-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// UploadGatewayCompletionNotice : Upload a validated completion notice
+// Check that the completion notice is a complete PDF of at most PdfMaxSize bytes with the application/pdf content
+// type (the default when UploadContentType is not set), then check that the gateway is awaiting a completion notice
+// and upload it. Problems with the document are reported with common.ValidationErrors, without any request; a gateway
+// in another operational status is reported with a *GatewayStatusError, without uploading. The upload is closed in
+// every case.
+func (directLink *DirectLinkV1) UploadGatewayCompletionNotice(createGatewayCompletionNoticeOptions *CreateGatewayCompletionNoticeOptions) (response *core.DetailedResponse, err error) {
+	response, err = directLink.UploadGatewayCompletionNoticeWithContext(context.Background(), createGatewayCompletionNoticeOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UploadGatewayCompletionNoticeWithContext is an alternate form of the UploadGatewayCompletionNotice method which supports a Context parameter
+func (directLink *DirectLinkV1) UploadGatewayCompletionNoticeWithContext(ctx context.Context, createGatewayCompletionNoticeOptions *CreateGatewayCompletionNoticeOptions) (response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createGatewayCompletionNoticeOptions, "createGatewayCompletionNoticeOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if createGatewayCompletionNoticeOptions.Upload != nil {
+		defer createGatewayCompletionNoticeOptions.Upload.Close()
+	}
+	err = core.ValidateStruct(createGatewayCompletionNoticeOptions, "createGatewayCompletionNoticeOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	if createGatewayCompletionNoticeOptions.Upload == nil {
+		err = core.SDKErrorf(nil, "upload must be supplied", "condition-not-met", common.GetComponentInfo())
+		return
+	}
+	pdf, err := readPdf(createGatewayCompletionNoticeOptions.Upload)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-upload-error", common.GetComponentInfo())
+		return
+	}
+	validationErrs := validatePdf("upload", pdf)
+	contentType := PdfContentType
+	if createGatewayCompletionNoticeOptions.UploadContentType != nil {
+		contentType = *createGatewayCompletionNoticeOptions.UploadContentType
+	}
+	if contentType != PdfContentType {
+		validationErrs.Add("upload_content_type", "must be %s, got %q", PdfContentType, contentType)
+	}
+	if err = validationErrs.Err(); err != nil {
+		return
+	}
+
+	gatewayID := *createGatewayCompletionNoticeOptions.ID
+	headers := createGatewayCompletionNoticeOptions.Headers
+	gateway, _, err := directLink.GetGatewayWithContext(ctx, &GetGatewayOptions{ID: core.StringPtr(gatewayID), Headers: headers})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-gateway-error")
+		return
+	}
+	status, _ := gatewayOperationalStatus(gateway)
+	if status != Gateway_OperationalStatus_AwaitingCompletionNotice {
+		err = &GatewayStatusError{ID: gatewayID, Status: status, Required: Gateway_OperationalStatus_AwaitingCompletionNotice}
+		return
+	}
+
+	response, err = directLink.CreateGatewayCompletionNoticeWithContext(ctx, &CreateGatewayCompletionNoticeOptions{
+		ID:                core.StringPtr(gatewayID),
+		Upload:            io.NopCloser(bytes.NewReader(pdf)),
+		UploadContentType: core.StringPtr(contentType),
+		Headers:           headers,
+	})
+	err = core.RepurposeSDKProblem(err, "upload-completion-notice-error")
+	return
+}
+
+// GatewayStatusError is returned when a gateway is not in the operational status an operation requires.
+type GatewayStatusError struct {
+	// The gateway identifier.
+	ID string
+
+	// The operational status of the gateway.
+	Status string
+
+	// The operational status the operation requires.
+	Required string
+}
+
+func (statusErr *GatewayStatusError) Error() string {
+	return fmt.Sprintf("gateway %s is %s, not %s", statusErr.ID, statusErr.Status, statusErr.Required)
+}
+
+// CrossConnect : The cross-connect details of a Direct Link Dedicated gateway, as needed to order the cross-connect
+// named in its letter of authorization.
+type CrossConnect struct {
+	// The gateway identifier.
+	GatewayID string `json:"gateway_id"`
+
+	// The gateway name.
+	GatewayName string `json:"gateway_name,omitempty"`
+
+	// The location of the gateway.
+	LocationName string `json:"location_name,omitempty"`
+
+	// The cross-connect router.
+	Router string `json:"router"`
+
+	// The port identifier.
+	PortID string `json:"port_id"`
+
+	// The carrier and customer names of the letter of authorization.
+	CarrierName  string `json:"carrier_name,omitempty"`
+	CustomerName string `json:"customer_name,omitempty"`
+
+	// The patch panel details of the completion notice, once it is uploaded.
+	PatchPanelCompletionNotice string `json:"patch_panel_completion_notice,omitempty"`
+}
+
+// GatewayCrossConnect returns the cross-connect details of a gateway returned by GetGateway. An error is returned if
+// the gateway is not a dedicated gateway, or has no cross-connect router or port yet.
+func GatewayCrossConnect(gateway GetGatewayResponseIntf) (result *CrossConnect, err error) {
+	var id, name, gatewayType, location, router, carrier, customer, patchPanel *string
+	var port *GatewayPortReference
+	switch gateway := gateway.(type) {
+	case *GetGatewayResponse:
+		id, name, gatewayType, location = gateway.ID, gateway.Name, gateway.Type, gateway.LocationName
+		router, port, carrier, customer = gateway.CrossConnectRouter, gateway.Port, gateway.CarrierName, gateway.CustomerName
+		patchPanel = gateway.PatchPanelCompletionNotice
+	case *GetGatewayResponseGateway:
+		id, name, gatewayType, location = gateway.ID, gateway.Name, gateway.Type, gateway.LocationName
+		router, port, carrier, customer = gateway.CrossConnectRouter, gateway.Port, gateway.CarrierName, gateway.CustomerName
+		patchPanel = gateway.PatchPanelCompletionNotice
+	case *GetGatewayResponseCrossAccountGateway:
+		id, name, gatewayType = gateway.ID, gateway.Name, gateway.Type
+	}
+	if stringValue(gatewayType) != Gateway_Type_Dedicated {
+		err = core.SDKErrorf(nil, fmt.Sprintf("gateway %s is not a dedicated gateway", stringValue(id)), "not-dedicated", common.GetComponentInfo())
+		return
+	}
+	if router == nil || port == nil || port.ID == nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("gateway %s has no cross-connect router and port yet", stringValue(id)), "missing-cross-connect", common.GetComponentInfo())
+		return
+	}
+	result = &CrossConnect{
+		GatewayID:                  stringValue(id),
+		GatewayName:                stringValue(name),
+		LocationName:               stringValue(location),
+		Router:                     *router,
+		PortID:                     *port.ID,
+		CarrierName:                stringValue(carrier),
+		CustomerName:               stringValue(customer),
+		PatchPanelCompletionNotice: stringValue(patchPanel),
+	}
+	return
+}
+
+// readPdf reads up to one byte more than PdfMaxSize, enough for validatePdf
+// to tell that a document is too large.
+func readPdf(reader io.Reader) ([]byte, error) {
+	return io.ReadAll(io.LimitReader(reader, PdfMaxSize+1))
+}
+
+// validatePdf checks that "pdf" starts with the PDF header, has an
+// end-of-file marker near its end and is at most PdfMaxSize bytes.
+func validatePdf(field string, pdf []byte) (validationErrs common.ValidationErrors) {
+	switch {
+	case len(pdf) == 0:
+		validationErrs.Add(field, "must not be empty")
+		return
+	case int64(len(pdf)) > PdfMaxSize:
+		validationErrs.Add(field, "must be at most %d bytes", PdfMaxSize)
+		return
+	}
+	if !bytes.HasPrefix(pdf, pdfHeader) {
+		validationErrs.Add(field, "must be a PDF document")
+		return
+	}
+	tail := pdf
+	if len(tail) > pdfTrailerWindow {
+		tail = tail[len(tail)-pdfTrailerWindow:]
+	}
+	if !bytes.Contains(tail, pdfTrailer) {
+		validationErrs.Add(field, "must be a complete PDF document, the end-of-file marker is missing")
+	}
+	return
+}
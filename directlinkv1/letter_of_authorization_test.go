@@ -0,0 +1,190 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package directlinkv1_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const dedicatedGatewayBody = `{"id": "gw1", "name": "dl-dedicated", "type": "dedicated", "location_name": "dal03", "operational_status": "%s",
+	"cross_connect_router": "xcr01.dal03", "port": {"id": "port1"}, "carrier_name": "carrier", "customer_name": "customer",
+	"bgp_asn": 64999, "created_at": "2020-11-02T20:40:29.622Z", "crn": "crn:gw1", "global": true, "metered": false,
+	"speed_mbps": 1000, "resource_group": {"id": "rg1"}}`
+
+var _ = Describe(`DirectLinkV1 letters of authorization and completion notices`, func() {
+	var testServer *httptest.Server
+	var directLinkService *directlinkv1.DirectLinkV1
+	var requests []string
+	var loa []byte
+	var operationalStatus string
+	var uploaded []byte
+	var tempDir string
+
+	BeforeEach(func() {
+		requests, uploaded = nil, nil
+		var dirErr error
+		tempDir, dirErr = os.MkdirTemp("", "loa")
+		Expect(dirErr).To(BeNil())
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			requests = append(requests, req.Method+" "+req.URL.Path)
+			switch req.URL.Path {
+			case "/gateways/gw1/letter_of_authorization":
+				res.Header().Set("Content-type", "application/pdf")
+				res.WriteHeader(200)
+				res.Write(loa)
+			case "/gateways/gw1/completion_notice":
+				file, header, err := req.FormFile("upload")
+				Expect(err).To(BeNil())
+				Expect(header.Header.Get("Content-Type")).To(Equal("application/pdf"))
+				uploaded, _ = io.ReadAll(file)
+				res.WriteHeader(204)
+			default:
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				fmt.Fprintf(res, dedicatedGatewayBody, operationalStatus)
+			}
+		}))
+		var serviceErr error
+		directLinkService, serviceErr = directlinkv1.NewDirectLinkV1(&directlinkv1.DirectLinkV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			Version:       core.StringPtr("2024-01-01"),
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+		os.RemoveAll(tempDir)
+	})
+
+	completionNotice := func() []byte {
+		pdf, err := os.ReadFile("completion_notice.pdf")
+		Expect(err).To(BeNil())
+		return pdf
+	}
+	validationFields := func(err error) (fields []string) {
+		validationErrs, ok := common.AsValidationErrors(err)
+		Expect(ok).To(BeTrue())
+		for _, validationErr := range validationErrs {
+			fields = append(fields, validationErr.Field)
+		}
+		return
+	}
+
+	It(`Saves a valid letter of authorization`, func() {
+		loa = completionNotice()
+		path := filepath.Join(tempDir, "loa.pdf")
+		err := directLinkService.SaveGatewayLetterOfAuthorization(directLinkService.NewListGatewayLetterOfAuthorizationOptions("gw1"), path)
+		Expect(err).To(BeNil())
+		saved, err := os.ReadFile(path)
+		Expect(err).To(BeNil())
+		Expect(saved).To(Equal(loa))
+		entries, _ := os.ReadDir(filepath.Dir(path))
+		Expect(entries).To(HaveLen(1))
+	})
+
+	It(`Rejects a letter of authorization that is not a complete PDF`, func() {
+		loa = []byte("<html>error</html>")
+		path := filepath.Join(tempDir, "loa.pdf")
+		err := directLinkService.SaveGatewayLetterOfAuthorization(directLinkService.NewListGatewayLetterOfAuthorizationOptions("gw1"), path)
+		Expect(validationFields(err)).To(Equal([]string{"letter_of_authorization"}))
+		_, statErr := os.Stat(path)
+		Expect(os.IsNotExist(statErr)).To(BeTrue())
+
+		loa = completionNotice()[:4096]
+		_, err = directLinkService.GetGatewayLetterOfAuthorizationPdf(directLinkService.NewListGatewayLetterOfAuthorizationOptions("gw1"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("end-of-file marker"))
+
+		loa = append([]byte("%PDF-1.3"), bytes.Repeat([]byte{' '}, int(directlinkv1.PdfMaxSize))...)
+		_, err = directLinkService.GetGatewayLetterOfAuthorizationPdf(directLinkService.NewListGatewayLetterOfAuthorizationOptions("gw1"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("at most"))
+	})
+
+	It(`Uploads a completion notice to a gateway awaiting one`, func() {
+		operationalStatus = "awaiting_completion_notice"
+		pdf := completionNotice()
+		options := directLinkService.NewCreateGatewayCompletionNoticeOptions("gw1").SetUpload(io.NopCloser(bytes.NewReader(pdf)))
+		_, err := directLinkService.UploadGatewayCompletionNotice(options)
+		Expect(err).To(BeNil())
+		Expect(requests).To(Equal([]string{"GET /gateways/gw1", "PUT /gateways/gw1/completion_notice"}))
+		Expect(uploaded).To(Equal(pdf))
+	})
+
+	It(`Refuses to upload to a gateway in another status`, func() {
+		operationalStatus = "loa_accepted"
+		options := directLinkService.NewCreateGatewayCompletionNoticeOptions("gw1").SetUpload(io.NopCloser(bytes.NewReader(completionNotice())))
+		_, err := directLinkService.UploadGatewayCompletionNotice(options)
+		var statusErr *directlinkv1.GatewayStatusError
+		Expect(errors.As(err, &statusErr)).To(BeTrue())
+		Expect(statusErr.Status).To(Equal("loa_accepted"))
+		Expect(statusErr.Required).To(Equal("awaiting_completion_notice"))
+		Expect(requests).To(Equal([]string{"GET /gateways/gw1"}))
+	})
+
+	It(`Validates the completion notice before any request`, func() {
+		options := directLinkService.NewCreateGatewayCompletionNoticeOptions("gw1").
+			SetUpload(io.NopCloser(bytes.NewReader([]byte("not a pdf")))).
+			SetUploadContentType("image/png")
+		_, err := directLinkService.UploadGatewayCompletionNotice(options)
+		Expect(validationFields(err)).To(Equal([]string{"upload", "upload_content_type"}))
+		Expect(requests).To(BeEmpty())
+
+		_, err = directLinkService.UploadGatewayCompletionNotice(directLinkService.NewCreateGatewayCompletionNoticeOptions("gw1"))
+		Expect(err).ToNot(BeNil())
+		_, err = directLinkService.UploadGatewayCompletionNotice(nil)
+		Expect(err).ToNot(BeNil())
+	})
+
+	It(`Extracts the cross-connect details of a dedicated gateway`, func() {
+		operationalStatus = "loa_created"
+		gateway, _, err := directLinkService.GetGateway(directLinkService.NewGetGatewayOptions("gw1"))
+		Expect(err).To(BeNil())
+		crossConnect, err := directlinkv1.GatewayCrossConnect(gateway)
+		Expect(err).To(BeNil())
+		Expect(*crossConnect).To(Equal(directlinkv1.CrossConnect{
+			GatewayID:    "gw1",
+			GatewayName:  "dl-dedicated",
+			LocationName: "dal03",
+			Router:       "xcr01.dal03",
+			PortID:       "port1",
+			CarrierName:  "carrier",
+			CustomerName: "customer",
+		}))
+
+		_, err = directlinkv1.GatewayCrossConnect(&directlinkv1.GetGatewayResponseGateway{ID: core.StringPtr("gw2"), Type: core.StringPtr("connect")})
+		Expect(err).ToNot(BeNil())
+		_, err = directlinkv1.GatewayCrossConnect(&directlinkv1.GetGatewayResponseGateway{ID: core.StringPtr("gw3"), Type: core.StringPtr("dedicated")})
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,299 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package directlinkv1
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+)
+
+// RotateGatewayMacsecCak : Rotate a MACsec connectivity association key
+// Move the primary or fallback session of a gateway's MACsec key chain to a new CAK name and key, given by the CRN of
+// a Key Protect, Hyper Protect Crypto Services or Secrets Manager key, and wait until the new key is in use.
+//
+// If the session has a CAK, it is patched with the new name and key; the gateway keeps the previous key in the key
+// chain (see GatewayMacsecCak.ActiveDelta) and retires it once the new key secures the session. If it has none, e.g. a
+// first fallback CAK, one is created. The rotation completes when the CAK is active, or for a fallback CAK also
+// operational or inactive, and the previous key is retired.
+//
+// A primary CAK is inactive while the peer router is still being rekeyed, so the wait goes on through the rotating
+// and inactive statuses until the CAK is active or the wait policy times out; a *common.WaitError carrying the last
+// status is returned then, and the rotation is left in place. If the CAK fails or the MACsec configuration of the
+// gateway fails, the rotation is rolled back: a patched CAK gets its previous name and key again, and a created CAK
+// is deleted. A *MacsecCakRotationError wrapping the *common.WaitError with the failure reasons is returned then.
+func (directLink *DirectLinkV1) RotateGatewayMacsecCak(rotateGatewayMacsecCakOptions *RotateGatewayMacsecCakOptions) (result *GatewayMacsecCak, err error) {
+	result, err = directLink.RotateGatewayMacsecCakWithContext(context.Background(), rotateGatewayMacsecCakOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// RotateGatewayMacsecCakWithContext is an alternate form of the RotateGatewayMacsecCak method which supports a Context parameter
+func (directLink *DirectLinkV1) RotateGatewayMacsecCakWithContext(ctx context.Context, rotateGatewayMacsecCakOptions *RotateGatewayMacsecCakOptions) (result *GatewayMacsecCak, err error) {
+	err = core.ValidateNotNil(rotateGatewayMacsecCakOptions, "rotateGatewayMacsecCakOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(rotateGatewayMacsecCakOptions, "rotateGatewayMacsecCakOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	var validationErrs common.ValidationErrors
+	if !isMacsecCakName(*rotateGatewayMacsecCakOptions.Name) {
+		validationErrs.Add("name", "must be a hexadecimal string of even length between 2 and 64, got %q", *rotateGatewayMacsecCakOptions.Name)
+	}
+	session := GatewayMacsecCak_Session_Primary
+	if rotateGatewayMacsecCakOptions.Session != nil {
+		session = *rotateGatewayMacsecCakOptions.Session
+	}
+	if session != GatewayMacsecCak_Session_Primary && session != GatewayMacsecCak_Session_Fallback {
+		validationErrs.Add("session", "must be primary or fallback, got %q", session)
+	}
+	if err = validationErrs.Err(); err != nil {
+		return
+	}
+	gatewayID := *rotateGatewayMacsecCakOptions.ID
+	headers := rotateGatewayMacsecCakOptions.Headers
+	key := &GatewayMacsecCakKeyReference{Crn: rotateGatewayMacsecCakOptions.KeyCrn}
+
+	collection, _, err := directLink.ListGatewayMacsecCaksWithContext(ctx, &ListGatewayMacsecCaksOptions{ID: core.StringPtr(gatewayID), Headers: headers})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-caks-error")
+		return
+	}
+	var previous *GatewayMacsecCak
+	for i, cak := range collection.Caks {
+		if cak.Session != nil && *cak.Session == session {
+			previous = &collection.Caks[i]
+			break
+		}
+	}
+
+	var cakID string
+	if previous != nil {
+		if stringValue(previous.Name) == *rotateGatewayMacsecCakOptions.Name {
+			validationErrs.Add("name", "must differ from the name of the current %s CAK", session)
+			err = validationErrs.Err()
+			return
+		}
+		cakID = stringValue(previous.ID)
+		patch, _ := (&GatewayMacsecCakPatch{Name: rotateGatewayMacsecCakOptions.Name, Key: key}).AsPatch()
+		result, _, err = directLink.UpdateGatewayMacsecCakWithContext(ctx, &UpdateGatewayMacsecCakOptions{
+			ID:                    core.StringPtr(gatewayID),
+			CakID:                 core.StringPtr(cakID),
+			GatewayMacsecCakPatch: patch,
+			Headers:               headers,
+		})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "update-cak-error")
+			return
+		}
+	} else {
+		result, _, err = directLink.CreateGatewayMacsecCakWithContext(ctx, &CreateGatewayMacsecCakOptions{
+			ID:      core.StringPtr(gatewayID),
+			Key:     key,
+			Name:    rotateGatewayMacsecCakOptions.Name,
+			Session: core.StringPtr(session),
+			Headers: headers,
+		})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "create-cak-error")
+			return
+		}
+		cakID = stringValue(result.ID)
+	}
+
+	cak, err := directLink.waitForMacsecCakRotation(ctx, gatewayID, cakID, session, headers)
+	if cak != nil {
+		result = cak
+	}
+	var waitErr *common.WaitError
+	if err == nil || !errors.As(err, &waitErr) || !waitErr.Failed {
+		return
+	}
+
+	rotationErr := &MacsecCakRotationError{Err: err}
+	if previous != nil {
+		patch, _ := (&GatewayMacsecCakPatch{Name: previous.Name, Key: previous.Key}).AsPatch()
+		_, _, rotationErr.RollbackErr = directLink.UpdateGatewayMacsecCakWithContext(ctx, &UpdateGatewayMacsecCakOptions{
+			ID:                    core.StringPtr(gatewayID),
+			CakID:                 core.StringPtr(cakID),
+			GatewayMacsecCakPatch: patch,
+			Headers:               headers,
+		})
+	} else {
+		_, rotationErr.RollbackErr = directLink.DeleteGatewayMacsecCakWithContext(ctx, &DeleteGatewayMacsecCakOptions{
+			ID:      core.StringPtr(gatewayID),
+			CakID:   core.StringPtr(cakID),
+			Headers: headers,
+		})
+	}
+	rotationErr.RolledBack = rotationErr.RollbackErr == nil
+	err = rotationErr
+	return
+}
+
+// waitForMacsecCakRotation polls the CAK and the MACsec configuration of the
+// gateway until the CAK is in use for "session" and no longer carries the
+// previous key.
+func (directLink *DirectLinkV1) waitForMacsecCakRotation(ctx context.Context, gatewayID string, cakID string, session string, headers map[string]string) (result *GatewayMacsecCak, err error) {
+	targetStatuses := []string{GatewayMacsecCak_Status_Active}
+	failureStatuses := []string{GatewayMacsecCak_Status_Failed}
+	if session == GatewayMacsecCak_Session_Fallback {
+		targetStatuses = append(targetStatuses, GatewayMacsecCak_Status_Operational, GatewayMacsecCak_Status_Inactive)
+	}
+	getGatewayMacsecCakOptions := &GetGatewayMacsecCakOptions{ID: core.StringPtr(gatewayID), CakID: core.StringPtr(cakID), Headers: headers}
+	getGatewayMacsecOptions := &GetGatewayMacsecOptions{ID: core.StringPtr(gatewayID), Headers: headers}
+	err = common.Wait(ctx, directLink.WaitPolicy, "MACsec CAK "+cakID+" of gateway "+gatewayID, func(ctx context.Context) (status common.WaitStatus, err error) {
+		result, _, err = directLink.GetGatewayMacsecCakWithContext(ctx, getGatewayMacsecCakOptions)
+		if err != nil {
+			return
+		}
+		macsec, _, err := directLink.GetGatewayMacsecWithContext(ctx, getGatewayMacsecOptions)
+		if err != nil {
+			return
+		}
+		status = waitStatus(stringValue(result.Status), targetStatuses, failureStatuses)
+		if status.Done && result.ActiveDelta != nil {
+			status.Done = false
+		}
+		if stringValue(macsec.Status) == GatewayMacsec_Status_Failed {
+			status.Done, status.Failed = false, true
+			for _, reason := range macsec.StatusReasons {
+				status.Reasons = append(status.Reasons, describeMacsecStatusReason(reason))
+			}
+		}
+		return
+	})
+	err = core.RepurposeSDKProblem(err, "wait-error")
+	return
+}
+
+// RotateGatewayMacsecCakOptions : The RotateGatewayMacsecCak options.
+type RotateGatewayMacsecCakOptions struct {
+	// Direct Link gateway identifier.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// The new name of the CAK, a hexadecimal string of even length between 2 and 64. It must differ from the current
+	// name.
+	Name *string `json:"name" validate:"required,ne="`
+
+	// The CRN of the new key.
+	KeyCrn *string `json:"key_crn" validate:"required,ne="`
+
+	// The session to rotate the CAK of, primary (the default) or fallback.
+	Session *string `json:"session,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// Constants associated with the RotateGatewayMacsecCakOptions.Session property.
+// The session to rotate the CAK of.
+const (
+	RotateGatewayMacsecCakOptions_Session_Fallback = "fallback"
+	RotateGatewayMacsecCakOptions_Session_Primary  = "primary"
+)
+
+// NewRotateGatewayMacsecCakOptions : Instantiate RotateGatewayMacsecCakOptions
+func (*DirectLinkV1) NewRotateGatewayMacsecCakOptions(id string, name string, keyCrn string) *RotateGatewayMacsecCakOptions {
+	return &RotateGatewayMacsecCakOptions{
+		ID:     core.StringPtr(id),
+		Name:   core.StringPtr(name),
+		KeyCrn: core.StringPtr(keyCrn),
+	}
+}
+
+// SetID : Allow user to set ID
+func (_options *RotateGatewayMacsecCakOptions) SetID(id string) *RotateGatewayMacsecCakOptions {
+	_options.ID = core.StringPtr(id)
+	return _options
+}
+
+// SetName : Allow user to set Name
+func (_options *RotateGatewayMacsecCakOptions) SetName(name string) *RotateGatewayMacsecCakOptions {
+	_options.Name = core.StringPtr(name)
+	return _options
+}
+
+// SetKeyCrn : Allow user to set KeyCrn
+func (_options *RotateGatewayMacsecCakOptions) SetKeyCrn(keyCrn string) *RotateGatewayMacsecCakOptions {
+	_options.KeyCrn = core.StringPtr(keyCrn)
+	return _options
+}
+
+// SetSession : Allow user to set Session
+func (_options *RotateGatewayMacsecCakOptions) SetSession(session string) *RotateGatewayMacsecCakOptions {
+	_options.Session = core.StringPtr(session)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *RotateGatewayMacsecCakOptions) SetHeaders(param map[string]string) *RotateGatewayMacsecCakOptions {
+	options.Headers = param
+	return options
+}
+
+// MacsecCakRotationError is returned by RotateGatewayMacsecCak when the
+// gateway reports a failure during the rotation.
+type MacsecCakRotationError struct {
+	// The *common.WaitError describing the failure.
+	Err error
+
+	// Whether the rotation was rolled back.
+	RolledBack bool
+
+	// The error rolling back, if rolling back failed.
+	RollbackErr error
+}
+
+func (rotationErr *MacsecCakRotationError) Error() string {
+	if rotationErr.RolledBack {
+		return rotationErr.Err.Error() + "; rolled back"
+	}
+	return rotationErr.Err.Error() + "; rolling back failed: " + rotationErr.RollbackErr.Error()
+}
+
+func (rotationErr *MacsecCakRotationError) Unwrap() error {
+	return rotationErr.Err
+}
+
+func describeMacsecStatusReason(reason GatewayMacsecStatusReason) string {
+	description := stringValue(reason.Code)
+	if reason.Message != nil {
+		if description != "" {
+			description += ": "
+		}
+		description += *reason.Message
+	}
+	return description
+}
+
+// isMacsecCakName returns true if "name" is a hexadecimal string of even
+// length between 2 and 64.
+func isMacsecCakName(name string) bool {
+	if len(name) < 2 || len(name) > 64 {
+		return false
+	}
+	_, err := hex.DecodeString(name)
+	return err == nil
+}
@@ -0,0 +1,172 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package directlinkv1_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const macsecCakBody = `{"id": "cak1", "name": "%s", "session": "%s", "status": "%s", %s"key": {"crn": "%s"}, "created_at": "2020-11-02T20:40:29.622Z", "updated_at": "2020-11-02T20:40:29.622Z"}`
+
+const macsecActiveDelta = `"active_delta": {"name": "aa", "status": "active", "key": {"crn": "crn:old"}}, `
+
+var _ = Describe(`DirectLinkV1 MACsec CAK rotation`, func() {
+	var testServer *httptest.Server
+	var directLinkService *directlinkv1.DirectLinkV1
+	var requests []string
+	var bodies []string
+	var existing bool
+	var cakStatuses []string
+	var macsecStatus string
+	var polls int
+
+	BeforeEach(func() {
+		requests, bodies, polls = nil, nil, 0
+		existing = true
+		macsecStatus = "secured"
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			requests = append(requests, req.Method+" "+req.URL.Path)
+			body, _ := io.ReadAll(req.Body)
+			if len(body) > 0 {
+				bodies = append(bodies, strings.TrimSpace(string(body)))
+			}
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == "GET" && req.URL.Path == "/gateways/gw1/macsec/caks":
+				res.WriteHeader(200)
+				if existing {
+					fmt.Fprintf(res, `{"caks": [`+macsecCakBody+`]}`, "aa", "primary", "active", "", "crn:old")
+				} else {
+					fmt.Fprint(res, `{"caks": []}`)
+				}
+			case req.Method == "GET" && req.URL.Path == "/gateways/gw1/macsec":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"active": true, "cipher_suite": "gcm_aes_xpn_256", "confidentiality_offset": 0, "created_at": "2020-11-02T20:40:29.622Z", "key_server_priority": 255, "sak_rekey": {"interval": 3600, "mode": "timer"}, "security_policy": "must_secure", "status": "%s", "status_reasons": [{"code": "macsec_cak_failed", "message": "CAK failed"}], "updated_at": "2020-11-02T20:40:29.622Z", "window_size": 148809600}`, macsecStatus)
+			case req.Method == "GET":
+				status := cakStatuses[len(cakStatuses)-1]
+				if polls < len(cakStatuses) {
+					status = cakStatuses[polls]
+				}
+				polls++
+				delta := ""
+				if status == "rotating" {
+					delta = macsecActiveDelta
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, macsecCakBody, "bb", "primary", status, delta, "crn:new")
+			case req.Method == "DELETE":
+				res.WriteHeader(204)
+			default:
+				res.WriteHeader(200)
+				fmt.Fprintf(res, macsecCakBody, "bb", "primary", "rotating", macsecActiveDelta, "crn:new")
+			}
+		}))
+		var serviceErr error
+		directLinkService, serviceErr = directlinkv1.NewDirectLinkV1(&directlinkv1.DirectLinkV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			Version:       core.StringPtr("2024-01-01"),
+		})
+		Expect(serviceErr).To(BeNil())
+		directLinkService.SetWaitPolicy(common.NewWaitPolicy().SetInterval(time.Millisecond, time.Millisecond))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Patches the primary CAK and waits until the previous key is retired`, func() {
+		cakStatuses = []string{"rotating", "rotating", "active"}
+		result, err := directLinkService.RotateGatewayMacsecCak(directLinkService.NewRotateGatewayMacsecCakOptions("gw1", "bb", "crn:new"))
+		Expect(err).To(BeNil())
+		Expect(*result.Status).To(Equal("active"))
+		Expect(result.ActiveDelta).To(BeNil())
+		Expect(requests[:2]).To(Equal([]string{"GET /gateways/gw1/macsec/caks", "PATCH /gateways/gw1/macsec/caks/cak1"}))
+		Expect(bodies).To(Equal([]string{`{"key":{"crn":"crn:new"},"name":"bb"}`}))
+		Expect(polls).To(Equal(3))
+	})
+
+	It(`Keeps waiting while the primary CAK is inactive`, func() {
+		cakStatuses = []string{"rotating", "inactive", "inactive", "active"}
+		result, err := directLinkService.RotateGatewayMacsecCak(directLinkService.NewRotateGatewayMacsecCakOptions("gw1", "bb", "crn:new"))
+		Expect(err).To(BeNil())
+		Expect(*result.Status).To(Equal("active"))
+		Expect(polls).To(Equal(4))
+
+		// A CAK still inactive when the wait times out is reported, not rolled back.
+		polls, bodies = 0, nil
+		cakStatuses = []string{"inactive"}
+		directLinkService.SetWaitPolicy(common.NewWaitPolicy().SetInterval(time.Millisecond, time.Millisecond).SetTimeout(20 * time.Millisecond))
+		_, err = directLinkService.RotateGatewayMacsecCak(directLinkService.NewRotateGatewayMacsecCakOptions("gw1", "bb", "crn:new"))
+		var waitErr *common.WaitError
+		Expect(errors.As(err, &waitErr)).To(BeTrue())
+		Expect(waitErr.Failed).To(BeFalse())
+		Expect(waitErr.Status).To(Equal("inactive"))
+		var rotationErr *directlinkv1.MacsecCakRotationError
+		Expect(errors.As(err, &rotationErr)).To(BeFalse())
+		Expect(bodies).To(Equal([]string{`{"key":{"crn":"crn:new"},"name":"bb"}`}))
+	})
+
+	It(`Rolls back a rotation the gateway fails`, func() {
+		cakStatuses = []string{"rotating", "failed"}
+		_, err := directLinkService.RotateGatewayMacsecCak(directLinkService.NewRotateGatewayMacsecCakOptions("gw1", "bb", "crn:new"))
+		var rotationErr *directlinkv1.MacsecCakRotationError
+		Expect(errors.As(err, &rotationErr)).To(BeTrue())
+		Expect(rotationErr.RolledBack).To(BeTrue())
+		var waitErr *common.WaitError
+		Expect(errors.As(err, &waitErr)).To(BeTrue())
+		Expect(waitErr.Status).To(Equal("failed"))
+		Expect(bodies).To(Equal([]string{`{"key":{"crn":"crn:new"},"name":"bb"}`, `{"key":{"crn":"crn:old"},"name":"aa"}`}))
+	})
+
+	It(`Deletes a created fallback CAK when MACsec fails`, func() {
+		existing = false
+		macsecStatus = "failed"
+		cakStatuses = []string{"operational"}
+		_, err := directLinkService.RotateGatewayMacsecCak(directLinkService.NewRotateGatewayMacsecCakOptions("gw1", "bb", "crn:new").SetSession("fallback"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("macsec_cak_failed: CAK failed"))
+		Expect(requests[1]).To(Equal("POST /gateways/gw1/macsec/caks"))
+		Expect(bodies[0]).To(ContainSubstring(`"session":"fallback"`))
+		Expect(requests[len(requests)-1]).To(Equal("DELETE /gateways/gw1/macsec/caks/cak1"))
+	})
+
+	It(`Validates the new name`, func() {
+		_, err := directLinkService.RotateGatewayMacsecCak(directLinkService.NewRotateGatewayMacsecCakOptions("gw1", "abc", "crn:new"))
+		_, ok := common.AsValidationErrors(err)
+		Expect(ok).To(BeTrue())
+		_, err = directLinkService.RotateGatewayMacsecCak(directLinkService.NewRotateGatewayMacsecCakOptions("gw1", "aa", "crn:new"))
+		_, ok = common.AsValidationErrors(err)
+		Expect(ok).To(BeTrue())
+		Expect(requests).To(Equal([]string{"GET /gateways/gw1/macsec/caks"}))
+		_, err = directLinkService.RotateGatewayMacsecCak(nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
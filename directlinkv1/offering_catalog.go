@@ -0,0 +1,412 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package directlinkv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+)
+
+// OfferingCatalogFormatVersion is the version of the format OfferingCatalog.Save writes. Load rejects other versions.
+const OfferingCatalogFormatVersion = 1
+
+// OfferingCatalog caches the Direct Link offerings: the locations, speeds and cross-connect routers of the dedicated
+// and connect offering types, and the ports of the connect providers. Each list is fetched on first use and again
+// once it is older than the TTL. A catalog can be saved to a file and loaded again, e.g. to answer queries offline.
+//
+// An OfferingCatalog is safe for concurrent use.
+type OfferingCatalog struct {
+	// The client used to fetch the offerings, or nil for an offline catalog, which answers from its cache whatever
+	// the TTL.
+	Service *DirectLinkV1
+
+	// How long fetched lists are used before they are fetched again. Zero or less keeps them until Invalidate is
+	// called.
+	TTL time.Duration
+
+	// If true, the catalog only answers from its cache, whatever the age of the cached lists.
+	Offline bool
+
+	mutex   sync.Mutex
+	entries map[string]*offeringCatalogEntry
+}
+
+// offeringCatalogFile is the format of a saved catalog.
+type offeringCatalogFile struct {
+	Version int                              `json:"version"`
+	Entries map[string]*offeringCatalogEntry `json:"entries"`
+}
+
+// offeringCatalogEntry is a cached list, with the time it was fetched. The
+// key of the entry tells which list it holds, e.g. "locations/dedicated" or
+// "cross_connect_routers/dedicated/dal10".
+type offeringCatalogEntry struct {
+	FetchedAt           time.Time            `json:"fetched_at"`
+	Locations           []LocationOutput     `json:"locations,omitempty"`
+	Speeds              []OfferingSpeed      `json:"speeds,omitempty"`
+	CrossConnectRouters []CrossConnectRouter `json:"cross_connect_routers,omitempty"`
+	Ports               []Port               `json:"ports,omitempty"`
+}
+
+// NewOfferingCatalog returns an empty catalog fetching with "service" and keeping fetched lists for "ttl".
+func NewOfferingCatalog(service *DirectLinkV1, ttl time.Duration) *OfferingCatalog {
+	return &OfferingCatalog{Service: service, TTL: ttl}
+}
+
+// Locations returns the locations of an offering type, dedicated or connect.
+func (catalog *OfferingCatalog) Locations(offeringType string) ([]LocationOutput, error) {
+	return catalog.LocationsWithContext(context.Background(), offeringType)
+}
+
+// LocationsWithContext is an alternate form of the Locations method which supports a Context parameter
+func (catalog *OfferingCatalog) LocationsWithContext(ctx context.Context, offeringType string) ([]LocationOutput, error) {
+	entry, err := catalog.cached("locations/"+offeringType, "the "+offeringType+" locations", func(entry *offeringCatalogEntry) error {
+		result, _, err := catalog.Service.ListOfferingTypeLocationsWithContext(ctx, catalog.Service.NewListOfferingTypeLocationsOptions(offeringType))
+		if err != nil {
+			return core.RepurposeSDKProblem(err, "list-locations-error")
+		}
+		entry.Locations = result.Locations
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entry.Locations, nil
+}
+
+// Speeds returns the link speeds of an offering type, dedicated or connect.
+func (catalog *OfferingCatalog) Speeds(offeringType string) ([]OfferingSpeed, error) {
+	return catalog.SpeedsWithContext(context.Background(), offeringType)
+}
+
+// SpeedsWithContext is an alternate form of the Speeds method which supports a Context parameter
+func (catalog *OfferingCatalog) SpeedsWithContext(ctx context.Context, offeringType string) ([]OfferingSpeed, error) {
+	entry, err := catalog.cached("speeds/"+offeringType, "the "+offeringType+" speeds", func(entry *offeringCatalogEntry) error {
+		result, _, err := catalog.Service.ListOfferingTypeSpeedsWithContext(ctx, catalog.Service.NewListOfferingTypeSpeedsOptions(offeringType))
+		if err != nil {
+			return core.RepurposeSDKProblem(err, "list-speeds-error")
+		}
+		entry.Speeds = result.Speeds
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entry.Speeds, nil
+}
+
+// CrossConnectRouters returns the cross-connect routers of an offering type at a location.
+func (catalog *OfferingCatalog) CrossConnectRouters(offeringType string, locationName string) ([]CrossConnectRouter, error) {
+	return catalog.CrossConnectRoutersWithContext(context.Background(), offeringType, locationName)
+}
+
+// CrossConnectRoutersWithContext is an alternate form of the CrossConnectRouters method which supports a Context parameter
+func (catalog *OfferingCatalog) CrossConnectRoutersWithContext(ctx context.Context, offeringType string, locationName string) ([]CrossConnectRouter, error) {
+	key := "cross_connect_routers/" + offeringType + "/" + locationName
+	entry, err := catalog.cached(key, "the "+offeringType+" cross-connect routers of "+locationName, func(entry *offeringCatalogEntry) error {
+		result, _, err := catalog.Service.ListOfferingTypeLocationCrossConnectRoutersWithContext(ctx,
+			catalog.Service.NewListOfferingTypeLocationCrossConnectRoutersOptions(offeringType, locationName))
+		if err != nil {
+			return core.RepurposeSDKProblem(err, "list-cross-connect-routers-error")
+		}
+		entry.CrossConnectRouters = result.CrossConnectRouters
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entry.CrossConnectRouters, nil
+}
+
+// Ports returns the ports of the connect providers in all locations.
+func (catalog *OfferingCatalog) Ports() ([]Port, error) {
+	return catalog.PortsWithContext(context.Background())
+}
+
+// PortsWithContext is an alternate form of the Ports method which supports a Context parameter
+func (catalog *OfferingCatalog) PortsWithContext(ctx context.Context) ([]Port, error) {
+	entry, err := catalog.cached("ports", "the ports", func(entry *offeringCatalogEntry) error {
+		pager, err := catalog.Service.NewPortsPager(catalog.Service.NewListPortsOptions())
+		if err != nil {
+			return core.RepurposeSDKProblem(err, "list-ports-error")
+		}
+		entry.Ports, err = pager.GetAllWithContext(ctx)
+		return core.RepurposeSDKProblem(err, "list-ports-error")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entry.Ports, nil
+}
+
+// CrossConnectRouterQuery : A query of OfferingCatalog.FindCrossConnectRouters.
+type CrossConnectRouterQuery struct {
+	// The offering type, dedicated by default.
+	OfferingType string
+
+	// The location, e.g. "dal10".
+	LocationName string
+
+	// If set, the offering type must support this link speed in megabits per second.
+	SpeedMbps int64
+
+	// If true, the router, the location and, if set, the speed must support MACsec.
+	Macsec bool
+}
+
+// FindCrossConnectRouters returns the cross-connect routers matching the query, e.g. the routers in dal10 able to
+// provision a MACsec gateway at 10 Gbps. An unknown location is reported with common.ValidationErrors on the
+// location_name field.
+func (catalog *OfferingCatalog) FindCrossConnectRouters(query CrossConnectRouterQuery) ([]CrossConnectRouter, error) {
+	return catalog.FindCrossConnectRoutersWithContext(context.Background(), query)
+}
+
+// FindCrossConnectRoutersWithContext is an alternate form of the FindCrossConnectRouters method which supports a Context parameter
+func (catalog *OfferingCatalog) FindCrossConnectRoutersWithContext(ctx context.Context, query CrossConnectRouterQuery) (result []CrossConnectRouter, err error) {
+	offeringType := query.OfferingType
+	if offeringType == "" {
+		offeringType = ListOfferingTypeLocationCrossConnectRoutersOptions_OfferingType_Dedicated
+	}
+	result = []CrossConnectRouter{}
+
+	locations, err := catalog.LocationsWithContext(ctx, offeringType)
+	if err != nil {
+		return nil, err
+	}
+	var location *LocationOutput
+	for i := range locations {
+		if stringValue(locations[i].Name) == query.LocationName {
+			location = &locations[i]
+		}
+	}
+	if location == nil {
+		var validationErrs common.ValidationErrors
+		validationErrs.Add("location_name", "is not a %s location: %q", offeringType, query.LocationName)
+		return nil, validationErrs.Err()
+	}
+	if location.ProvisionEnabled != nil && !*location.ProvisionEnabled {
+		return
+	}
+	if query.Macsec && (location.MacsecEnabled == nil || !*location.MacsecEnabled) {
+		return
+	}
+	if query.SpeedMbps > 0 {
+		var speeds []OfferingSpeed
+		speeds, err = catalog.SpeedsWithContext(ctx, offeringType)
+		if err != nil {
+			return nil, err
+		}
+		supported := false
+		for _, speed := range speeds {
+			if speed.LinkSpeed != nil && *speed.LinkSpeed == query.SpeedMbps {
+				supported = !query.Macsec || (speed.MacsecEnabled != nil && *speed.MacsecEnabled)
+			}
+		}
+		if !supported {
+			return
+		}
+	}
+
+	routers, err := catalog.CrossConnectRoutersWithContext(ctx, offeringType, query.LocationName)
+	if err != nil {
+		return nil, err
+	}
+	for _, router := range routers {
+		if query.Macsec && !hasMacsecCapability(router.Capabilities) {
+			continue
+		}
+		result = append(result, router)
+	}
+	return
+}
+
+// PortQuery : A query of OfferingCatalog.FindPorts.
+type PortQuery struct {
+	// If set, the location of the port.
+	LocationName string
+
+	// If set, the provider of the port.
+	ProviderName string
+
+	// If set, the port must support this link speed in megabits per second.
+	SpeedMbps int64
+
+	// If set, the port must have fewer direct links than this.
+	MaxDirectLinks int64
+}
+
+// FindPorts returns the ports matching the query, e.g. the ports of a location with room for another direct link at
+// a given speed.
+func (catalog *OfferingCatalog) FindPorts(query PortQuery) ([]Port, error) {
+	return catalog.FindPortsWithContext(context.Background(), query)
+}
+
+// FindPortsWithContext is an alternate form of the FindPorts method which supports a Context parameter
+func (catalog *OfferingCatalog) FindPortsWithContext(ctx context.Context, query PortQuery) (result []Port, err error) {
+	ports, err := catalog.PortsWithContext(ctx)
+	if err != nil {
+		return
+	}
+	result = []Port{}
+	for _, port := range ports {
+		if query.LocationName != "" && stringValue(port.LocationName) != query.LocationName {
+			continue
+		}
+		if query.ProviderName != "" && stringValue(port.ProviderName) != query.ProviderName {
+			continue
+		}
+		if query.MaxDirectLinks > 0 && (port.DirectLinkCount == nil || *port.DirectLinkCount >= query.MaxDirectLinks) {
+			continue
+		}
+		if query.SpeedMbps > 0 && !containsInt64(port.SupportedLinkSpeeds, query.SpeedMbps) {
+			continue
+		}
+		result = append(result, port)
+	}
+	return
+}
+
+// Invalidate drops the cached lists, so they are fetched again on next use.
+func (catalog *OfferingCatalog) Invalidate() {
+	catalog.mutex.Lock()
+	defer catalog.mutex.Unlock()
+	catalog.entries = nil
+}
+
+// Save writes the cached lists as JSON, with the time each one was fetched.
+func (catalog *OfferingCatalog) Save(w io.Writer) error {
+	catalog.mutex.Lock()
+	saved := offeringCatalogFile{Version: OfferingCatalogFormatVersion, Entries: map[string]*offeringCatalogEntry{}}
+	for key, entry := range catalog.entries {
+		saved.Entries[key] = entry
+	}
+	catalog.mutex.Unlock()
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(saved); err != nil {
+		return core.SDKErrorf(err, "", "save-catalog-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// Load replaces the cached lists with the ones written by Save. The lists keep the time they were fetched, so with a
+// TTL they are fetched again once they are too old unless Offline is set.
+func (catalog *OfferingCatalog) Load(r io.Reader) error {
+	var saved offeringCatalogFile
+	if err := json.NewDecoder(r).Decode(&saved); err != nil {
+		return core.SDKErrorf(err, "", "load-catalog-error", common.GetComponentInfo())
+	}
+	if saved.Version != OfferingCatalogFormatVersion {
+		return core.SDKErrorf(nil, fmt.Sprintf("unsupported catalog format version %d", saved.Version), "load-catalog-error", common.GetComponentInfo())
+	}
+	catalog.mutex.Lock()
+	defer catalog.mutex.Unlock()
+	catalog.entries = saved.Entries
+	return nil
+}
+
+// SaveFile saves the catalog to the file at "path", through a temporary file in the same directory so that "path"
+// never holds a partial catalog.
+func (catalog *OfferingCatalog) SaveFile(path string) (err error) {
+	file, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return core.SDKErrorf(err, "", "save-catalog-error", common.GetComponentInfo())
+	}
+	defer os.Remove(file.Name())
+	err = catalog.Save(file)
+	if closeErr := file.Close(); err == nil && closeErr != nil {
+		err = core.SDKErrorf(closeErr, "", "save-catalog-error", common.GetComponentInfo())
+	}
+	if err == nil {
+		if renameErr := os.Rename(file.Name(), path); renameErr != nil {
+			err = core.SDKErrorf(renameErr, "", "save-catalog-error", common.GetComponentInfo())
+		}
+	}
+	return
+}
+
+// LoadFile loads the catalog saved to the file at "path".
+func (catalog *OfferingCatalog) LoadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return core.SDKErrorf(err, "", "load-catalog-error", common.GetComponentInfo())
+	}
+	defer file.Close()
+	return catalog.Load(file)
+}
+
+// cached returns the entry under "key" if it is fresh, and otherwise lets
+// "fetch" fill a new entry and caches it. The description of the list is
+// used in the error returned when the catalog cannot fetch it.
+func (catalog *OfferingCatalog) cached(key string, description string, fetch func(entry *offeringCatalogEntry) error) (*offeringCatalogEntry, error) {
+	catalog.mutex.Lock()
+	entry := catalog.entries[key]
+	catalog.mutex.Unlock()
+	if entry != nil && catalog.fresh(entry.FetchedAt) {
+		return entry, nil
+	}
+	if catalog.Offline || catalog.Service == nil {
+		// Without a client a stale list is still the best answer.
+		if entry != nil {
+			return entry, nil
+		}
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("%s are not in the offline catalog", description), "not-cached", common.GetComponentInfo())
+	}
+	entry = &offeringCatalogEntry{FetchedAt: time.Now()}
+	if err := fetch(entry); err != nil {
+		return nil, err
+	}
+	catalog.mutex.Lock()
+	defer catalog.mutex.Unlock()
+	if catalog.entries == nil {
+		catalog.entries = map[string]*offeringCatalogEntry{}
+	}
+	catalog.entries[key] = entry
+	return entry, nil
+}
+
+// fresh returns true if a list fetched at "fetchedAt" can still be used.
+func (catalog *OfferingCatalog) fresh(fetchedAt time.Time) bool {
+	return catalog.Offline || catalog.TTL <= 0 || time.Since(fetchedAt) < catalog.TTL
+}
+
+func hasMacsecCapability(capabilities []string) bool {
+	for _, capability := range capabilities {
+		if capability == Gateway_MacsecCapability_Macsec || capability == Gateway_MacsecCapability_MacsecOptional {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt64(values []int64, value int64) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,194 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package directlinkv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DirectLinkV1 offering catalog`, func() {
+	var testServer *httptest.Server
+	var directLinkService *directlinkv1.DirectLinkV1
+	var requests []string
+
+	BeforeEach(func() {
+		requests = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			requests = append(requests, req.URL.Path)
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			switch req.URL.Path {
+			case "/offering_types/dedicated/locations":
+				fmt.Fprint(res, `{"locations": [
+					{"name": "dal10", "display_name": "Dallas 10", "location_type": "POP", "market": "Dallas", "offering_type": "dedicated", "provision_enabled": true, "macsec_enabled": true},
+					{"name": "dal03", "display_name": "Dallas 3", "location_type": "POP", "market": "Dallas", "offering_type": "dedicated", "provision_enabled": true, "macsec_enabled": false}
+				]}`)
+			case "/offering_types/dedicated/speeds":
+				fmt.Fprint(res, `{"speeds": [
+					{"link_speed": 1000, "capabilities": ["metered", "unmetered"], "macsec_enabled": false},
+					{"link_speed": 10000, "capabilities": ["metered", "unmetered"], "macsec_enabled": true}
+				]}`)
+			case "/offering_types/dedicated/locations/dal10/cross_connect_routers":
+				fmt.Fprint(res, `{"cross_connect_routers": [
+					{"router_name": "xcr01.dal10", "total_connections": 1, "capabilities": ["non_macsec"]},
+					{"router_name": "xcr02.dal10", "total_connections": 5, "capabilities": ["macsec", "non_macsec"]}
+				]}`)
+			case "/ports":
+				if req.URL.Query().Get("start") == "" {
+					fmt.Fprint(res, `{"first": {"href": "x"}, "limit": 1, "total_count": 2, "next": {"href": "x", "start": "p2"}, "ports": [
+						{"id": "port1", "direct_link_count": 1, "label": "XCR-FRK-CS-SEC-01", "location_display_name": "Dallas 10", "location_name": "dal10", "provider_name": "provider1", "supported_link_speeds": [1000, 2000]}
+					]}`)
+				} else {
+					fmt.Fprint(res, `{"first": {"href": "x"}, "limit": 1, "total_count": 2, "ports": [
+						{"id": "port2", "direct_link_count": 8, "label": "XCR-FRK-CS-SEC-02", "location_display_name": "Dallas 10", "location_name": "dal10", "provider_name": "provider2", "supported_link_speeds": [1000, 5000]}
+					]}`)
+				}
+			}
+		}))
+		var serviceErr error
+		directLinkService, serviceErr = directlinkv1.NewDirectLinkV1(&directlinkv1.DirectLinkV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			Version:       core.StringPtr("2024-01-01"),
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	routerNames := func(routers []directlinkv1.CrossConnectRouter) (names []string) {
+		names = []string{}
+		for _, router := range routers {
+			names = append(names, *router.RouterName)
+		}
+		return
+	}
+
+	It(`Finds the cross-connect routers supporting MACsec at a speed`, func() {
+		catalog := directlinkv1.NewOfferingCatalog(directLinkService, time.Hour)
+		routers, err := catalog.FindCrossConnectRouters(directlinkv1.CrossConnectRouterQuery{LocationName: "dal10", SpeedMbps: 10000, Macsec: true})
+		Expect(err).To(BeNil())
+		Expect(routerNames(routers)).To(Equal([]string{"xcr02.dal10"}))
+
+		routers, err = catalog.FindCrossConnectRouters(directlinkv1.CrossConnectRouterQuery{LocationName: "dal10", SpeedMbps: 1000, Macsec: true})
+		Expect(err).To(BeNil())
+		Expect(routers).To(BeEmpty())
+		routers, err = catalog.FindCrossConnectRouters(directlinkv1.CrossConnectRouterQuery{LocationName: "dal10", SpeedMbps: 1000})
+		Expect(err).To(BeNil())
+		Expect(routerNames(routers)).To(Equal([]string{"xcr01.dal10", "xcr02.dal10"}))
+		routers, err = catalog.FindCrossConnectRouters(directlinkv1.CrossConnectRouterQuery{LocationName: "dal03", Macsec: true})
+		Expect(err).To(BeNil())
+		Expect(routers).To(BeEmpty())
+
+		_, err = catalog.FindCrossConnectRouters(directlinkv1.CrossConnectRouterQuery{LocationName: "wdc04"})
+		validationErrs, ok := common.AsValidationErrors(err)
+		Expect(ok).To(BeTrue())
+		Expect(validationErrs[0].Field).To(Equal("location_name"))
+
+		Expect(requests).To(Equal([]string{
+			"/offering_types/dedicated/locations",
+			"/offering_types/dedicated/speeds",
+			"/offering_types/dedicated/locations/dal10/cross_connect_routers",
+		}))
+	})
+
+	It(`Finds the ports with capacity`, func() {
+		catalog := directlinkv1.NewOfferingCatalog(directLinkService, time.Hour)
+		ports, err := catalog.FindPorts(directlinkv1.PortQuery{LocationName: "dal10", SpeedMbps: 1000, MaxDirectLinks: 5})
+		Expect(err).To(BeNil())
+		Expect(ports).To(HaveLen(1))
+		Expect(*ports[0].ID).To(Equal("port1"))
+
+		ports, err = catalog.FindPorts(directlinkv1.PortQuery{SpeedMbps: 5000})
+		Expect(err).To(BeNil())
+		Expect(ports).To(HaveLen(1))
+		Expect(*ports[0].ID).To(Equal("port2"))
+		Expect(requests).To(Equal([]string{"/ports", "/ports"}))
+	})
+
+	It(`Fetches lists again once they expire`, func() {
+		catalog := directlinkv1.NewOfferingCatalog(directLinkService, time.Millisecond)
+		_, err := catalog.Speeds("dedicated")
+		Expect(err).To(BeNil())
+		time.Sleep(5 * time.Millisecond)
+		_, err = catalog.Speeds("dedicated")
+		Expect(err).To(BeNil())
+		Expect(requests).To(HaveLen(2))
+
+		catalog.TTL = 0
+		_, err = catalog.Speeds("dedicated")
+		Expect(err).To(BeNil())
+		Expect(requests).To(HaveLen(2))
+		catalog.Invalidate()
+		_, err = catalog.Speeds("dedicated")
+		Expect(err).To(BeNil())
+		Expect(requests).To(HaveLen(3))
+	})
+
+	It(`Saves the catalog and answers from it offline`, func() {
+		catalog := directlinkv1.NewOfferingCatalog(directLinkService, time.Millisecond)
+		_, err := catalog.FindCrossConnectRouters(directlinkv1.CrossConnectRouterQuery{LocationName: "dal10", SpeedMbps: 10000, Macsec: true})
+		Expect(err).To(BeNil())
+		_, err = catalog.Ports()
+		Expect(err).To(BeNil())
+		dir, err := os.MkdirTemp("", "catalog")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "catalog.json")
+		Expect(catalog.SaveFile(path)).To(Succeed())
+		requests = nil
+		time.Sleep(5 * time.Millisecond)
+
+		offline := directlinkv1.NewOfferingCatalog(nil, time.Millisecond)
+		offline.Offline = true
+		Expect(offline.LoadFile(path)).To(Succeed())
+		routers, err := offline.FindCrossConnectRouters(directlinkv1.CrossConnectRouterQuery{LocationName: "dal10", SpeedMbps: 10000, Macsec: true})
+		Expect(err).To(BeNil())
+		Expect(routerNames(routers)).To(Equal([]string{"xcr02.dal10"}))
+		ports, err := offline.FindPorts(directlinkv1.PortQuery{ProviderName: "provider2"})
+		Expect(err).To(BeNil())
+		Expect(ports).To(HaveLen(1))
+		Expect(requests).To(BeEmpty())
+
+		_, err = offline.Locations("connect")
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("the connect locations are not in the offline catalog"))
+
+		// A catalog without a client answers from its stale lists too.
+		stale := directlinkv1.NewOfferingCatalog(nil, time.Millisecond)
+		Expect(stale.LoadFile(path)).To(Succeed())
+		routers, err = stale.FindCrossConnectRouters(directlinkv1.CrossConnectRouterQuery{LocationName: "dal10", SpeedMbps: 10000, Macsec: true})
+		Expect(err).To(BeNil())
+		Expect(routerNames(routers)).To(Equal([]string{"xcr02.dal10"}))
+		_, err = stale.Locations("connect")
+		Expect(err).ToNot(BeNil())
+	})
+})
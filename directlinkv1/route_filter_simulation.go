@@ -0,0 +1,471 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package directlinkv1
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+)
+
+// Constants associated with the PlanGatewayRouteFiltersOptions.Direction property.
+// Import route filters apply to the routes learned from the on-premises network, export route filters to the routes
+// advertised to it.
+const (
+	PlanGatewayRouteFiltersOptions_Direction_Export = "export"
+	PlanGatewayRouteFiltersOptions_Direction_Import = "import"
+)
+
+// RouteFilterSimulation : What a list of route filters does to the routes of a route report.
+type RouteFilterSimulation struct {
+	// The direction of the route filters, import or export.
+	Direction string `json:"direction"`
+
+	// The action taken on routes no route filter matches.
+	DefaultAction string `json:"default_action"`
+
+	// The routes of the report the route filters apply to, with the action taken on each.
+	Routes []SimulatedRoute `json:"routes"`
+}
+
+// SimulatedRoute : The action a list of route filters takes on a route.
+type SimulatedRoute struct {
+	// The prefix of the route.
+	Prefix string `json:"prefix"`
+
+	// The BGP AS path of the route.
+	AsPath string `json:"as_path,omitempty"`
+
+	// The action taken, permit or deny.
+	Action string `json:"action"`
+
+	// The position of the route filter that matches, starting at 0, or -1 if the default action applies.
+	FilterIndex int `json:"filter_index"`
+}
+
+// Permitted returns true if the route is permitted.
+func (route SimulatedRoute) Permitted() bool {
+	return route.Action == RouteFilter_Action_Permit
+}
+
+// SimulateRouteFilters returns what "filters", in the order they are applied, and "defaultAction" do to the routes of
+// "report": import route filters apply to its on-premises routes, export route filters to the routes it shows
+// advertised to the on-premises network. Every invalid route filter is listed in the common.ValidationErrors returned.
+func SimulateRouteFilters(report *RouteReport, direction string, filters []GatewayTemplateRouteFilter, defaultAction string) (simulation *RouteFilterSimulation, err error) {
+	var validationErrs common.ValidationErrors
+	if report == nil {
+		validationErrs.Add("route_report", "must be given")
+	}
+	if direction != PlanGatewayRouteFiltersOptions_Direction_Import && direction != PlanGatewayRouteFiltersOptions_Direction_Export {
+		validationErrs.Add("direction", "must be import or export, got %q", direction)
+	}
+	if defaultAction != RouteFilter_Action_Permit && defaultAction != RouteFilter_Action_Deny {
+		validationErrs.Add("default_action", "must be permit or deny, got %q", defaultAction)
+	}
+	validationErrs = append(validationErrs, validateRouteFilters(direction+"_route_filters", filters)...)
+	if err = validationErrs.Err(); err != nil {
+		return
+	}
+
+	prefixes := make([]netip.Prefix, len(filters))
+	for i, filter := range filters {
+		prefixes[i] = netip.MustParsePrefix(*filter.Prefix).Masked()
+	}
+	simulation = &RouteFilterSimulation{Direction: direction, DefaultAction: defaultAction, Routes: []SimulatedRoute{}}
+	simulate := func(prefix *string, asPath *string) {
+		route := SimulatedRoute{Prefix: stringValue(prefix), AsPath: stringValue(asPath), Action: defaultAction, FilterIndex: -1}
+		parsed, parseErr := netip.ParsePrefix(route.Prefix)
+		if parseErr == nil {
+			for i, filter := range filters {
+				if common.PrefixFilterMatches(parsed.Masked(), prefixes[i], filter.Ge, filter.Le) {
+					route.Action, route.FilterIndex = *filter.Action, i
+					break
+				}
+			}
+		}
+		simulation.Routes = append(simulation.Routes, route)
+	}
+	if direction == PlanGatewayRouteFiltersOptions_Direction_Import {
+		for _, route := range report.OnPremRoutes {
+			simulate(route.Prefix, route.AsPath)
+		}
+	} else {
+		for _, route := range report.AdvertisedRoutes {
+			simulate(route.Prefix, route.AsPath)
+		}
+	}
+	return
+}
+
+// Dropped returns the routes the route filters deny.
+func (simulation *RouteFilterSimulation) Dropped() (routes []SimulatedRoute) {
+	for _, route := range simulation.Routes {
+		if !route.Permitted() {
+			routes = append(routes, route)
+		}
+	}
+	return
+}
+
+// NewlyDropped returns the routes the route filters deny that are permitted in "baseline", a simulation of the same
+// routes, e.g. with the route filters currently on the gateway.
+func (simulation *RouteFilterSimulation) NewlyDropped(baseline *RouteFilterSimulation) (routes []SimulatedRoute) {
+	permitted := map[string]bool{}
+	if baseline != nil {
+		for _, route := range baseline.Routes {
+			permitted[route.Prefix] = permitted[route.Prefix] || route.Permitted()
+		}
+	}
+	for _, route := range simulation.Dropped() {
+		if baseline == nil || permitted[route.Prefix] {
+			routes = append(routes, route)
+		}
+	}
+	return
+}
+
+func (simulation *RouteFilterSimulation) String() string {
+	var b strings.Builder
+	dropped := simulation.Dropped()
+	fmt.Fprintf(&b, "%d of %d %s routes dropped\n", len(dropped), len(simulation.Routes), simulation.Direction)
+	for _, route := range dropped {
+		if route.FilterIndex < 0 {
+			fmt.Fprintf(&b, "  %s denied by the default action\n", route.Prefix)
+		} else {
+			fmt.Fprintf(&b, "  %s denied by route filter %d\n", route.Prefix, route.FilterIndex)
+		}
+	}
+	return b.String()
+}
+
+// RouteFilterTemplates returns route filters, e.g. those listed by ListGatewayImportRouteFilters, in the order they
+// are applied and in the form ReplaceGatewayImportRouteFilters and ReplaceGatewayExportRouteFilters take. Route
+// filters whose before field names a route filter that is not in the list are left out.
+func RouteFilterTemplates(filters []RouteFilter) (templates []GatewayTemplateRouteFilter) {
+	templates = []GatewayTemplateRouteFilter{}
+	ids := make([]string, len(filters))
+	befores := make([]string, len(filters))
+	for i, filter := range filters {
+		ids[i], befores[i] = stringValue(filter.ID), stringValue(filter.Before)
+	}
+	for _, i := range common.OrderPrefixFilters(ids, befores) {
+		filter := filters[i]
+		templates = append(templates, GatewayTemplateRouteFilter{
+			Action: filter.Action,
+			Ge:     filter.Ge,
+			Le:     filter.Le,
+			Prefix: filter.Prefix,
+		})
+	}
+	return
+}
+
+// PlanGatewayRouteFilters : Simulate a replacement of the route filters of a gateway
+// Read the import or export route filters of a gateway together with their ETag and the gateway's default route
+// filter, and simulate both the current and the proposed route filters on a route report. If no route report is
+// given, one is created and waited for. Apply the plan with ApplyGatewayRouteFiltersPlan.
+func (directLink *DirectLinkV1) PlanGatewayRouteFilters(planGatewayRouteFiltersOptions *PlanGatewayRouteFiltersOptions) (result *RouteFiltersPlan, err error) {
+	result, err = directLink.PlanGatewayRouteFiltersWithContext(context.Background(), planGatewayRouteFiltersOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PlanGatewayRouteFiltersWithContext is an alternate form of the PlanGatewayRouteFilters method which supports a Context parameter
+func (directLink *DirectLinkV1) PlanGatewayRouteFiltersWithContext(ctx context.Context, planGatewayRouteFiltersOptions *PlanGatewayRouteFiltersOptions) (result *RouteFiltersPlan, err error) {
+	err = core.ValidateNotNil(planGatewayRouteFiltersOptions, "planGatewayRouteFiltersOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(planGatewayRouteFiltersOptions, "planGatewayRouteFiltersOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	gatewayID := *planGatewayRouteFiltersOptions.GatewayID
+	direction := *planGatewayRouteFiltersOptions.Direction
+	headers := planGatewayRouteFiltersOptions.Headers
+
+	// Check the input before creating a route report for it.
+	var validationErrs common.ValidationErrors
+	if direction != PlanGatewayRouteFiltersOptions_Direction_Import && direction != PlanGatewayRouteFiltersOptions_Direction_Export {
+		validationErrs.Add("direction", "must be import or export, got %q", direction)
+	}
+	validationErrs = append(validationErrs, validateRouteFilters("route_filters", planGatewayRouteFiltersOptions.RouteFilters)...)
+	if err = validationErrs.Err(); err != nil {
+		return
+	}
+
+	gateway, _, err := directLink.GetGatewayWithContext(ctx, &GetGatewayOptions{ID: core.StringPtr(gatewayID), Headers: headers})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-gateway-error")
+		return
+	}
+	var current []RouteFilter
+	var response *core.DetailedResponse
+	if direction == PlanGatewayRouteFiltersOptions_Direction_Export {
+		var collection *ExportRouteFilterCollection
+		collection, response, err = directLink.ListGatewayExportRouteFiltersWithContext(ctx, &ListGatewayExportRouteFiltersOptions{GatewayID: core.StringPtr(gatewayID), Headers: headers})
+		if collection != nil {
+			current = collection.ExportRouteFilters
+		}
+	} else {
+		var collection *ImportRouteFilterCollection
+		collection, response, err = directLink.ListGatewayImportRouteFiltersWithContext(ctx, &ListGatewayImportRouteFiltersOptions{GatewayID: core.StringPtr(gatewayID), Headers: headers})
+		if collection != nil {
+			current = collection.ImportRouteFilters
+		}
+	}
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-route-filters-error")
+		return
+	}
+
+	var report *RouteReport
+	if planGatewayRouteFiltersOptions.RouteReportID != nil {
+		report, _, err = directLink.GetGatewayRouteReportWithContext(ctx, &GetGatewayRouteReportOptions{
+			GatewayID: core.StringPtr(gatewayID),
+			ID:        planGatewayRouteFiltersOptions.RouteReportID,
+			Headers:   headers,
+		})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "get-report-error")
+			return
+		}
+	} else {
+		report, _, err = directLink.CreateGatewayRouteReportWithContext(ctx, &CreateGatewayRouteReportOptions{GatewayID: core.StringPtr(gatewayID), Headers: headers})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "create-report-error")
+			return
+		}
+	}
+	if report.Status == nil || *report.Status != RouteReport_Status_Complete {
+		report, err = directLink.WaitForGatewayRouteReport(ctx, gatewayID, stringValue(report.ID))
+		if err != nil {
+			return
+		}
+	}
+
+	result = &RouteFiltersPlan{
+		GatewayID: gatewayID,
+		Direction: direction,
+		ETag:      response.GetHeaders().Get("ETag"),
+		Current:   RouteFilterTemplates(current),
+		Proposed:  planGatewayRouteFiltersOptions.RouteFilters,
+		Headers:   headers,
+	}
+	defaultAction := gatewayDefaultRouteFilter(gateway, direction)
+	result.Baseline, err = SimulateRouteFilters(report, direction, result.Current, defaultAction)
+	if err != nil {
+		result = nil
+		return
+	}
+	result.Simulation, err = SimulateRouteFilters(report, direction, result.Proposed, defaultAction)
+	if err != nil {
+		result = nil
+	}
+	return
+}
+
+// ApplyGatewayRouteFiltersPlan : Replace the route filters of a gateway as planned
+// Replace the route filters of the gateway with the proposed route filters of "plan", provided they have not changed
+// since the plan was made. If they have, the request fails with 412 Precondition Failed (see
+// common.IsPreconditionFailed) and a new plan should be made.
+func (directLink *DirectLinkV1) ApplyGatewayRouteFiltersPlan(plan *RouteFiltersPlan) (result []RouteFilter, err error) {
+	result, err = directLink.ApplyGatewayRouteFiltersPlanWithContext(context.Background(), plan)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ApplyGatewayRouteFiltersPlanWithContext is an alternate form of the ApplyGatewayRouteFiltersPlan method which supports a Context parameter
+func (directLink *DirectLinkV1) ApplyGatewayRouteFiltersPlanWithContext(ctx context.Context, plan *RouteFiltersPlan) (result []RouteFilter, err error) {
+	err = core.ValidateNotNil(plan, "plan cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if plan.ETag == "" {
+		err = core.SDKErrorf(nil, "the plan has no ETag to replace the route filters with", "missing-etag", common.GetComponentInfo())
+		return
+	}
+	proposed := plan.Proposed
+	if proposed == nil {
+		proposed = []GatewayTemplateRouteFilter{}
+	}
+
+	if plan.Direction == PlanGatewayRouteFiltersOptions_Direction_Export {
+		var collection *ExportRouteFilterCollection
+		collection, _, err = directLink.ReplaceGatewayExportRouteFiltersWithContext(ctx, directLink.NewReplaceGatewayExportRouteFiltersOptions(plan.GatewayID, plan.ETag).
+			SetExportRouteFilters(proposed).SetHeaders(plan.Headers))
+		if collection != nil {
+			result = collection.ExportRouteFilters
+		}
+	} else {
+		var collection *ImportRouteFilterCollection
+		collection, _, err = directLink.ReplaceGatewayImportRouteFiltersWithContext(ctx, directLink.NewReplaceGatewayImportRouteFiltersOptions(plan.GatewayID, plan.ETag).
+			SetImportRouteFilters(proposed).SetHeaders(plan.Headers))
+		if collection != nil {
+			result = collection.ImportRouteFilters
+		}
+	}
+	err = core.RepurposeSDKProblem(err, "replace-route-filters-error")
+	return
+}
+
+// PlanGatewayRouteFiltersOptions : The PlanGatewayRouteFilters options.
+type PlanGatewayRouteFiltersOptions struct {
+	// Direct Link gateway identifier.
+	GatewayID *string `json:"gateway_id" validate:"required,ne="`
+
+	// The direction of the route filters, import or export.
+	Direction *string `json:"direction" validate:"required"`
+
+	// The proposed route filters, in the order they are to be applied.
+	RouteFilters []GatewayTemplateRouteFilter `json:"route_filters,omitempty"`
+
+	// The identifier of a complete route report to simulate the route filters on. If not set, a new route report is
+	// created.
+	RouteReportID *string `json:"route_report_id,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewPlanGatewayRouteFiltersOptions : Instantiate PlanGatewayRouteFiltersOptions
+func (*DirectLinkV1) NewPlanGatewayRouteFiltersOptions(gatewayID string, direction string) *PlanGatewayRouteFiltersOptions {
+	return &PlanGatewayRouteFiltersOptions{
+		GatewayID: core.StringPtr(gatewayID),
+		Direction: core.StringPtr(direction),
+	}
+}
+
+// SetGatewayID : Allow user to set GatewayID
+func (_options *PlanGatewayRouteFiltersOptions) SetGatewayID(gatewayID string) *PlanGatewayRouteFiltersOptions {
+	_options.GatewayID = core.StringPtr(gatewayID)
+	return _options
+}
+
+// SetDirection : Allow user to set Direction
+func (_options *PlanGatewayRouteFiltersOptions) SetDirection(direction string) *PlanGatewayRouteFiltersOptions {
+	_options.Direction = core.StringPtr(direction)
+	return _options
+}
+
+// SetRouteFilters : Allow user to set RouteFilters
+func (_options *PlanGatewayRouteFiltersOptions) SetRouteFilters(routeFilters []GatewayTemplateRouteFilter) *PlanGatewayRouteFiltersOptions {
+	_options.RouteFilters = routeFilters
+	return _options
+}
+
+// SetRouteReportID : Allow user to set RouteReportID
+func (_options *PlanGatewayRouteFiltersOptions) SetRouteReportID(routeReportID string) *PlanGatewayRouteFiltersOptions {
+	_options.RouteReportID = core.StringPtr(routeReportID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *PlanGatewayRouteFiltersOptions) SetHeaders(param map[string]string) *PlanGatewayRouteFiltersOptions {
+	options.Headers = param
+	return options
+}
+
+// RouteFiltersPlan : A planned replacement of the route filters of a gateway.
+type RouteFiltersPlan struct {
+	// Direct Link gateway identifier.
+	GatewayID string `json:"gateway_id"`
+
+	// The direction of the route filters, import or export.
+	Direction string `json:"direction"`
+
+	// The ETag of the route filters when the plan was made.
+	ETag string `json:"etag"`
+
+	// The current route filters, in the order they are applied.
+	Current []GatewayTemplateRouteFilter `json:"current"`
+
+	// The proposed route filters, in the order they are to be applied.
+	Proposed []GatewayTemplateRouteFilter `json:"proposed"`
+
+	// What the current route filters do to the routes of the route report.
+	Baseline *RouteFilterSimulation `json:"baseline"`
+
+	// What the proposed route filters would do to the routes of the route report.
+	Simulation *RouteFilterSimulation `json:"simulation"`
+
+	// The headers of the PlanGatewayRouteFilters request, sent again when the plan is applied.
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// NewlyDropped returns the routes the proposed route filters would drop that the current route filters permit.
+func (plan *RouteFiltersPlan) NewlyDropped() []SimulatedRoute {
+	return plan.Simulation.NewlyDropped(plan.Baseline)
+}
+
+// validateRouteFilters lists the problems of the route filters in the list
+// named "name".
+func validateRouteFilters(name string, filters []GatewayTemplateRouteFilter) (validationErrs common.ValidationErrors) {
+	for i, filter := range filters {
+		field := fmt.Sprintf("%s[%d]", name, i)
+		if filter.Action == nil || (*filter.Action != RouteFilter_Action_Permit && *filter.Action != RouteFilter_Action_Deny) {
+			validationErrs.Add(field+".action", "must be permit or deny")
+		}
+		prefix, err := netip.ParsePrefix(stringValue(filter.Prefix))
+		if err != nil || !prefix.Addr().Is4() {
+			validationErrs.Add(field+".prefix", "%q is not an IPv4 prefix", stringValue(filter.Prefix))
+			continue
+		}
+		ge, le := common.PrefixFilterBound(filter.Ge), common.PrefixFilterBound(filter.Le)
+		if ge != 0 && (ge < int64(prefix.Bits()) || ge > 32) {
+			validationErrs.Add(field+".ge", "must be between %d and 32, got %d", prefix.Bits(), ge)
+		}
+		if le != 0 && (le < int64(prefix.Bits()) || le > 32 || (ge != 0 && le < ge)) {
+			validationErrs.Add(field+".le", "must be between %d and 32, got %d", max(ge, int64(prefix.Bits())), le)
+		}
+	}
+	return
+}
+
+// gatewayDefaultRouteFilter returns the default import or export route
+// filter of any of the GetGateway response models, permit if it is unknown.
+func gatewayDefaultRouteFilter(gateway GetGatewayResponseIntf, direction string) string {
+	var importFilter, exportFilter *string
+	switch gateway := gateway.(type) {
+	case *GetGatewayResponse:
+		importFilter, exportFilter = gateway.DefaultImportRouteFilter, gateway.DefaultExportRouteFilter
+	case *GetGatewayResponseGateway:
+		importFilter, exportFilter = gateway.DefaultImportRouteFilter, gateway.DefaultExportRouteFilter
+	}
+	defaultFilter := importFilter
+	if direction == PlanGatewayRouteFiltersOptions_Direction_Export {
+		defaultFilter = exportFilter
+	}
+	if defaultFilter == nil || *defaultFilter == "" {
+		return RouteFilter_Action_Permit
+	}
+	return *defaultFilter
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
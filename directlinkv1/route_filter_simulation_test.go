@@ -0,0 +1,221 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package directlinkv1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const routeFilterReportBody = `{
+	"id": "report1", "created_at": "2019-01-01T12:00:00.000Z", "status": "%s",
+	"gateway_routes": [], "overlapping_routes": [], "virtual_connection_routes": [],
+	"on_prem_routes": [
+		{"prefix": "10.10.0.0/16", "as_path": "64999", "next_hop": "172.17.0.1"},
+		{"prefix": "10.20.1.0/24", "as_path": "64999", "next_hop": "172.17.0.1"},
+		{"prefix": "192.168.0.0/24", "as_path": "64999", "next_hop": "172.17.0.1"}
+	],
+	"advertised_routes": [{"prefix": "10.240.0.0/24", "as_path": "64999"}]
+}`
+
+const importRouteFiltersBody = `{"import_route_filters": [
+	{"id": "f2", "action": "deny", "prefix": "192.168.0.0/16", "le": 32, "created_at": "2019-01-01T12:00:00.000Z", "updated_at": "2019-01-01T12:00:00.000Z"},
+	{"id": "f1", "action": "permit", "prefix": "10.0.0.0/8", "le": 24, "before": "f2", "created_at": "2019-01-01T12:00:00.000Z", "updated_at": "2019-01-01T12:00:00.000Z"}
+]}`
+
+var _ = Describe(`DirectLinkV1 route filter simulation`, func() {
+	routeFilter := func(action string, prefix string) directlinkv1.GatewayTemplateRouteFilter {
+		return directlinkv1.GatewayTemplateRouteFilter{Action: core.StringPtr(action), Prefix: core.StringPtr(prefix)}
+	}
+	report := func() *directlinkv1.RouteReport {
+		report := new(directlinkv1.RouteReport)
+		Expect(json.Unmarshal([]byte(fmt.Sprintf(routeFilterReportBody, "complete")), report)).To(Succeed())
+		return report
+	}
+
+	It(`Simulates import and export route filters on a route report`, func() {
+		denyLab := routeFilter("deny", "10.20.0.0/16")
+		denyLab.Ge = core.Int64Ptr(24)
+		permitAll := routeFilter("permit", "10.0.0.0/8")
+		permitAll.Le = core.Int64Ptr(32)
+
+		simulation, err := directlinkv1.SimulateRouteFilters(report(), "import", []directlinkv1.GatewayTemplateRouteFilter{denyLab, permitAll}, "deny")
+		Expect(err).To(BeNil())
+		Expect(simulation.Routes).To(HaveLen(3))
+		Expect(simulation.Routes[0].Permitted()).To(BeTrue())
+		Expect(simulation.Routes[0].FilterIndex).To(Equal(1))
+		Expect(simulation.Routes[1].FilterIndex).To(Equal(0))
+		Expect(simulation.Routes[2].FilterIndex).To(Equal(-1))
+		Expect(simulation.String()).To(Equal(`2 of 3 import routes dropped
+  10.20.1.0/24 denied by route filter 0
+  192.168.0.0/24 denied by the default action
+`))
+
+		simulation, err = directlinkv1.SimulateRouteFilters(report(), "export", []directlinkv1.GatewayTemplateRouteFilter{routeFilter("deny", "10.240.0.0/24")}, "permit")
+		Expect(err).To(BeNil())
+		Expect(simulation.Dropped()).To(HaveLen(1))
+		Expect(simulation.Dropped()[0].Prefix).To(Equal("10.240.0.0/24"))
+	})
+
+	It(`Lists every invalid route filter`, func() {
+		badBounds := routeFilter("permit", "10.0.0.0/16")
+		badBounds.Le = core.Int64Ptr(8)
+		_, err := directlinkv1.SimulateRouteFilters(report(), "import", []directlinkv1.GatewayTemplateRouteFilter{routeFilter("allow", "10.0.0.0"), badBounds}, "permit")
+		validationErrs, ok := common.AsValidationErrors(err)
+		Expect(ok).To(BeTrue())
+		Expect(validationErrs.Error()).To(ContainSubstring("import_route_filters[0].action"))
+		Expect(validationErrs.Error()).To(ContainSubstring("import_route_filters[0].prefix"))
+		Expect(validationErrs.Error()).To(ContainSubstring("import_route_filters[1].le"))
+	})
+
+	It(`Orders listed route filters by their before field`, func() {
+		var collection directlinkv1.ImportRouteFilterCollection
+		Expect(json.Unmarshal([]byte(importRouteFiltersBody), &collection)).To(Succeed())
+		templates := directlinkv1.RouteFilterTemplates(collection.ImportRouteFilters)
+		Expect(templates).To(HaveLen(2))
+		Expect(*templates[0].Prefix).To(Equal("10.0.0.0/8"))
+		Expect(*templates[1].Prefix).To(Equal("192.168.0.0/16"))
+	})
+
+	Describe(`PlanGatewayRouteFilters(planGatewayRouteFiltersOptions *PlanGatewayRouteFiltersOptions)`, func() {
+		var testServer *httptest.Server
+		var requests []string
+		var ifMatch string
+		var replaceHeader string
+		var replaceStatus int
+		var directLinkService *directlinkv1.DirectLinkV1
+
+		BeforeEach(func() {
+			requests = nil
+			replaceStatus = 200
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				requests = append(requests, req.Method+" "+req.URL.Path)
+				res.Header().Set("Content-type", "application/json")
+				switch {
+				case req.URL.Path == "/gateways/gw1":
+					res.WriteHeader(200)
+					fmt.Fprint(res, `{"id": "gw1", "name": "myGateway", "default_import_route_filter": "deny", "default_export_route_filter": "permit"}`)
+				case req.Method == "POST":
+					res.WriteHeader(201)
+					fmt.Fprintf(res, routeFilterReportBody, "pending")
+				case req.URL.Path == "/gateways/gw1/route_reports/report1":
+					res.WriteHeader(200)
+					fmt.Fprintf(res, routeFilterReportBody, "complete")
+				case req.Method == "GET":
+					res.Header().Set("ETag", `W/"abc"`)
+					res.WriteHeader(200)
+					fmt.Fprint(res, importRouteFiltersBody)
+				default:
+					ifMatch = req.Header.Get("If-Match")
+					replaceHeader = req.Header.Get("X-Test")
+					body, _ := io.ReadAll(req.Body)
+					Expect(string(body)).To(ContainSubstring(`"import_route_filters":[{"action":"permit","prefix":"10.10.0.0/16"}]`))
+					res.WriteHeader(replaceStatus)
+					if replaceStatus == 412 {
+						fmt.Fprint(res, `{"errors": [{"code": "precondition_failed", "message": "ETag mismatch"}], "trace": "abc"}`)
+						return
+					}
+					fmt.Fprint(res, `{"import_route_filters": [{"id": "f3", "action": "permit", "prefix": "10.10.0.0/16", "created_at": "2019-01-01T12:00:00.000Z", "updated_at": "2019-01-01T12:00:00.000Z"}]}`)
+				}
+			}))
+			var serviceErr error
+			directLinkService, serviceErr = directlinkv1.NewDirectLinkV1(&directlinkv1.DirectLinkV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+				Version:       core.StringPtr("2024-01-01"),
+			})
+			Expect(serviceErr).To(BeNil())
+			directLinkService.SetWaitPolicy(common.NewWaitPolicy().SetInterval(time.Millisecond, time.Millisecond))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It(`Plans a replacement and applies it with the ETag of the listed route filters`, func() {
+			options := directLinkService.NewPlanGatewayRouteFiltersOptions("gw1", directlinkv1.PlanGatewayRouteFiltersOptions_Direction_Import).
+				SetRouteFilters([]directlinkv1.GatewayTemplateRouteFilter{routeFilter("permit", "10.10.0.0/16")}).
+				SetHeaders(map[string]string{"X-Test": "plan"})
+			plan, err := directLinkService.PlanGatewayRouteFilters(options)
+			Expect(err).To(BeNil())
+			Expect(plan.Headers).To(Equal(map[string]string{"X-Test": "plan"}))
+			Expect(requests).To(Equal([]string{
+				"GET /gateways/gw1",
+				"GET /gateways/gw1/import_route_filters",
+				"POST /gateways/gw1/route_reports",
+				"GET /gateways/gw1/route_reports/report1",
+			}))
+			Expect(plan.ETag).To(Equal(`W/"abc"`))
+			Expect(plan.Current).To(HaveLen(2))
+			Expect(plan.Baseline.Dropped()).To(HaveLen(1))
+			Expect(plan.Simulation.Dropped()).To(HaveLen(2))
+			newlyDropped := plan.NewlyDropped()
+			Expect(newlyDropped).To(HaveLen(1))
+			Expect(newlyDropped[0].Prefix).To(Equal("10.20.1.0/24"))
+
+			result, err := directLinkService.ApplyGatewayRouteFiltersPlan(plan)
+			Expect(err).To(BeNil())
+			Expect(ifMatch).To(Equal(`W/"abc"`))
+			Expect(replaceHeader).To(Equal("plan"))
+			Expect(result).To(HaveLen(1))
+		})
+
+		It(`Checks the direction and the proposed route filters before calling the API`, func() {
+			options := directLinkService.NewPlanGatewayRouteFiltersOptions("gw1", "inbound").
+				SetRouteFilters([]directlinkv1.GatewayTemplateRouteFilter{routeFilter("allow", "10.10.0.0/16")})
+			_, err := directLinkService.PlanGatewayRouteFilters(options)
+			Expect(err).ToNot(BeNil())
+			validationErrs, ok := common.AsValidationErrors(err)
+			Expect(ok).To(BeTrue())
+			Expect(validationErrs).To(HaveLen(2))
+			Expect(validationErrs[0].Field).To(Equal("direction"))
+			Expect(validationErrs[1].Field).To(Equal("route_filters[0].action"))
+			Expect(requests).To(BeEmpty())
+		})
+
+		It(`Does not clobber route filters changed since the plan`, func() {
+			replaceStatus = 412
+			options := directLinkService.NewPlanGatewayRouteFiltersOptions("gw1", directlinkv1.PlanGatewayRouteFiltersOptions_Direction_Import).
+				SetRouteFilters([]directlinkv1.GatewayTemplateRouteFilter{routeFilter("permit", "10.10.0.0/16")}).
+				SetRouteReportID("report1")
+			plan, err := directLinkService.PlanGatewayRouteFilters(options)
+			Expect(err).To(BeNil())
+			Expect(requests).ToNot(ContainElement("POST /gateways/gw1/route_reports"))
+
+			_, err = directLinkService.ApplyGatewayRouteFiltersPlan(plan)
+			Expect(err).ToNot(BeNil())
+			Expect(common.IsPreconditionFailed(err)).To(BeTrue())
+
+			plan.ETag = ""
+			_, err = directLinkService.ApplyGatewayRouteFiltersPlan(plan)
+			Expect(err).ToNot(BeNil())
+			_, err = directLinkService.PlanGatewayRouteFilters(nil)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
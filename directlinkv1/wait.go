@@ -0,0 +1,168 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package directlinkv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+)
+
+// gatewayFailureStatuses are the gateway operational statuses that need
+// action before provisioning can go on.
+var gatewayFailureStatuses = []string{
+	Gateway_OperationalStatus_CompletionNoticeRejected,
+	Gateway_OperationalStatus_CreateRejected,
+	Gateway_OperationalStatus_Failed,
+	Gateway_OperationalStatus_LoaRejected,
+}
+
+// virtualConnectionFailureStatuses are the virtual connection statuses a
+// virtual connection does not leave on its own.
+var virtualConnectionFailureStatuses = []string{
+	GatewayVirtualConnection_Status_DetachedByNetwork,
+	GatewayVirtualConnection_Status_Expired,
+	GatewayVirtualConnection_Status_Rejected,
+}
+
+// SetWaitPolicy sets how the waiters of this service instance poll. Passing nil restores the defaults of
+// common.NewWaitPolicy.
+func (directLink *DirectLinkV1) SetWaitPolicy(policy *common.WaitPolicy) {
+	directLink.WaitPolicy = policy
+}
+
+// WaitForGatewayStatus : Wait for a gateway status
+// Poll the gateway until its operational status is one of the target statuses and return it. If the gateway reaches
+// a failure status (create_rejected, failed, loa_rejected or completion_notice_rejected) that is not a target, or the
+// context ends first, a *common.WaitError carrying the last status and its GatewayStatusReason details is returned
+// together with the last gateway polled.
+func (directLink *DirectLinkV1) WaitForGatewayStatus(ctx context.Context, id string, targetStatuses ...string) (result GetGatewayResponseIntf, err error) {
+	if len(targetStatuses) == 0 {
+		err = core.SDKErrorf(nil, "at least one target status must be given", "missing-target-status", common.GetComponentInfo())
+		return
+	}
+	getGatewayOptions := directLink.NewGetGatewayOptions(id)
+	err = common.Wait(ctx, directLink.WaitPolicy, "gateway "+id, func(ctx context.Context) (status common.WaitStatus, err error) {
+		result, _, err = directLink.GetGatewayWithContext(ctx, getGatewayOptions)
+		if err != nil {
+			return
+		}
+		operationalStatus, reasons := gatewayOperationalStatus(result)
+		status = waitStatus(operationalStatus, targetStatuses, gatewayFailureStatuses)
+		for _, reason := range reasons {
+			status.Reasons = append(status.Reasons, describeGatewayStatusReason(reason))
+		}
+		return
+	})
+	err = core.RepurposeSDKProblem(err, "wait-error")
+	return
+}
+
+// WaitForVirtualConnectionStatus : Wait for a virtual connection status
+// Poll the virtual connection until its status is one of the target statuses and return it. If the virtual
+// connection reaches a failure status (rejected, expired or detached_by_network) that is not a target, or the context
+// ends first, a *common.WaitError carrying the last status is returned together with the last virtual connection
+// polled.
+func (directLink *DirectLinkV1) WaitForVirtualConnectionStatus(ctx context.Context, gatewayID string, id string, targetStatuses ...string) (result *GatewayVirtualConnection, err error) {
+	if len(targetStatuses) == 0 {
+		err = core.SDKErrorf(nil, "at least one target status must be given", "missing-target-status", common.GetComponentInfo())
+		return
+	}
+	getGatewayVirtualConnectionOptions := directLink.NewGetGatewayVirtualConnectionOptions(gatewayID, id)
+	err = common.Wait(ctx, directLink.WaitPolicy, "virtual connection "+id+" of gateway "+gatewayID, func(ctx context.Context) (status common.WaitStatus, err error) {
+		result, _, err = directLink.GetGatewayVirtualConnectionWithContext(ctx, getGatewayVirtualConnectionOptions)
+		if err != nil {
+			return
+		}
+		virtualConnectionStatus := ""
+		if result.Status != nil {
+			virtualConnectionStatus = *result.Status
+		}
+		status = waitStatus(virtualConnectionStatus, targetStatuses, virtualConnectionFailureStatuses)
+		return
+	})
+	err = core.RepurposeSDKProblem(err, "wait-error")
+	return
+}
+
+// WaitForGatewayRouteReport : Wait for a route report
+// Poll the route report until it is complete and return it. If the context ends first, a *common.WaitError carrying
+// the last status is returned together with the last report polled.
+func (directLink *DirectLinkV1) WaitForGatewayRouteReport(ctx context.Context, gatewayID string, id string) (result *RouteReport, err error) {
+	getGatewayRouteReportOptions := directLink.NewGetGatewayRouteReportOptions(gatewayID, id)
+	err = common.Wait(ctx, directLink.WaitPolicy, "route report "+id+" of gateway "+gatewayID, func(ctx context.Context) (status common.WaitStatus, err error) {
+		result, _, err = directLink.GetGatewayRouteReportWithContext(ctx, getGatewayRouteReportOptions)
+		if err != nil {
+			return
+		}
+		reportStatus := ""
+		if result.Status != nil {
+			reportStatus = *result.Status
+		}
+		status = waitStatus(reportStatus, []string{RouteReport_Status_Complete}, nil)
+		return
+	})
+	err = core.RepurposeSDKProblem(err, "wait-error")
+	return
+}
+
+// gatewayOperationalStatus returns the operational status and status reasons
+// of any of the GetGateway response models.
+func gatewayOperationalStatus(gateway GetGatewayResponseIntf) (status string, reasons []GatewayStatusReason) {
+	var operationalStatus *string
+	switch gateway := gateway.(type) {
+	case *GetGatewayResponse:
+		operationalStatus, reasons = gateway.OperationalStatus, gateway.OperationalStatusReasons
+	case *GetGatewayResponseGateway:
+		operationalStatus, reasons = gateway.OperationalStatus, gateway.OperationalStatusReasons
+	case *GetGatewayResponseCrossAccountGateway:
+		operationalStatus = gateway.OperationalStatus
+	}
+	if operationalStatus != nil {
+		status = *operationalStatus
+	}
+	return
+}
+
+func describeGatewayStatusReason(reason GatewayStatusReason) string {
+	description := ""
+	if reason.Code != nil {
+		description = *reason.Code
+	}
+	if reason.Message != nil {
+		if description != "" {
+			description += ": "
+		}
+		description += *reason.Message
+	}
+	return description
+}
+
+func waitStatus(status string, targetStatuses []string, failureStatuses []string) common.WaitStatus {
+	for _, targetStatus := range targetStatuses {
+		if status == targetStatus {
+			return common.WaitStatus{Status: status, Done: true}
+		}
+	}
+	for _, failureStatus := range failureStatuses {
+		if status == failureStatus {
+			return common.WaitStatus{Status: status, Failed: true}
+		}
+	}
+	return common.WaitStatus{Status: status}
+}
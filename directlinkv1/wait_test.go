@@ -0,0 +1,132 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package directlinkv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DirectLinkV1 waiters`, func() {
+	var testServer *httptest.Server
+	var statuses []string
+	var polls int
+	var directLinkService *directlinkv1.DirectLinkV1
+
+	// serve answers each poll with the next of "statuses", repeating the last one.
+	serve := func(body string) {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			status := statuses[len(statuses)-1]
+			if polls < len(statuses) {
+				status = statuses[polls]
+			}
+			polls++
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprintf(res, body, status)
+		}))
+		var serviceErr error
+		directLinkService, serviceErr = directlinkv1.NewDirectLinkV1(&directlinkv1.DirectLinkV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			Version:       core.StringPtr("2024-01-01"),
+		})
+		Expect(serviceErr).To(BeNil())
+		directLinkService.SetWaitPolicy(common.NewWaitPolicy().SetInterval(time.Millisecond, 4*time.Millisecond))
+	}
+	BeforeEach(func() {
+		polls = 0
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	Describe(`WaitForGatewayStatus(ctx context.Context, id string, targetStatuses ...string)`, func() {
+		const gatewayBody = `{"id": "ef4dcb1a", "name": "myGateway", "operational_status": "%s", "operational_status_reasons": [{"code": "authentication_key_failed", "message": "The authentication_key failed configuration."}]}`
+
+		It(`Returns the gateway once it reaches a target status`, func() {
+			statuses = []string{"create_pending", "configuring", "provisioned"}
+			serve(gatewayBody)
+			result, err := directLinkService.WaitForGatewayStatus(context.Background(), "ef4dcb1a", directlinkv1.Gateway_OperationalStatus_Provisioned)
+			Expect(err).To(BeNil())
+			Expect(polls).To(Equal(3))
+			Expect(*result.(*directlinkv1.GetGatewayResponse).OperationalStatus).To(Equal("provisioned"))
+		})
+		It(`Reports failure statuses with their reasons`, func() {
+			statuses = []string{"create_pending", "failed"}
+			serve(gatewayBody)
+			result, err := directLinkService.WaitForGatewayStatus(context.Background(), "ef4dcb1a", directlinkv1.Gateway_OperationalStatus_Provisioned)
+			Expect(result).ToNot(BeNil())
+			var waitErr *common.WaitError
+			Expect(errors.As(err, &waitErr)).To(BeTrue())
+			Expect(waitErr.Failed).To(BeTrue())
+			Expect(waitErr.Status).To(Equal("failed"))
+			Expect(err.Error()).To(Equal(`gateway ef4dcb1a reached failure status "failed" (authentication_key_failed: The authentication_key failed configuration.)`))
+		})
+		It(`Stops when the context ends`, func() {
+			statuses = []string{"awaiting_loa"}
+			serve(gatewayBody)
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+			_, err := directLinkService.WaitForGatewayStatus(ctx, "ef4dcb1a", directlinkv1.Gateway_OperationalStatus_LoaAccepted)
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+			var waitErr *common.WaitError
+			Expect(errors.As(err, &waitErr)).To(BeTrue())
+			Expect(waitErr.Status).To(Equal("awaiting_loa"))
+			Expect(waitErr.Failed).To(BeFalse())
+		})
+		It(`Requires a target status`, func() {
+			statuses = []string{"provisioned"}
+			serve(gatewayBody)
+			_, err := directLinkService.WaitForGatewayStatus(context.Background(), "ef4dcb1a")
+			Expect(err).ToNot(BeNil())
+			Expect(polls).To(Equal(0))
+		})
+	})
+
+	Describe(`WaitForVirtualConnectionStatus(ctx context.Context, gatewayID string, id string, targetStatuses ...string)`, func() {
+		const virtualConnectionBody = `{"id": "ef4dcb1a", "name": "newVC", "status": "%s", "type": "vpc"}`
+
+		It(`Returns the virtual connection once it reaches a target status`, func() {
+			statuses = []string{"pending", "attached"}
+			serve(virtualConnectionBody)
+			result, err := directLinkService.WaitForVirtualConnectionStatus(context.Background(), "0a06fb9b", "ef4dcb1a", directlinkv1.GatewayVirtualConnection_Status_Attached)
+			Expect(err).To(BeNil())
+			Expect(*result.Status).To(Equal("attached"))
+		})
+		It(`Reports failure statuses`, func() {
+			statuses = []string{"pending", "rejected"}
+			serve(virtualConnectionBody)
+			_, err := directLinkService.WaitForVirtualConnectionStatus(context.Background(), "0a06fb9b", "ef4dcb1a", directlinkv1.GatewayVirtualConnection_Status_Attached)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(Equal(`virtual connection ef4dcb1a of gateway 0a06fb9b reached failure status "rejected"`))
+		})
+	})
+})
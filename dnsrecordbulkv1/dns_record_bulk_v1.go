@@ -179,6 +179,12 @@ func (dnsRecordBulk *DnsRecordBulkV1) DisableRetries() {
 	dnsRecordBulk.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (dnsRecordBulk *DnsRecordBulkV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(dnsRecordBulk.Service, policy)
+}
+
 // GetDnsRecordsBulk : Export zone file
 // Export zone file.
 func (dnsRecordBulk *DnsRecordBulkV1) GetDnsRecordsBulk(getDnsRecordsBulkOptions *GetDnsRecordsBulkOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
@@ -221,6 +227,7 @@ func (dnsRecordBulk *DnsRecordBulkV1) GetDnsRecordsBulkWithContext(ctx context.C
 	}
 
 	response, err = dnsRecordBulk.Service.Request(request, &result)
+	err = common.NewAPIError(err, response)
 
 	return
 }
@@ -282,6 +289,7 @@ func (dnsRecordBulk *DnsRecordBulkV1) PostDnsRecordsBulkWithContext(ctx context.
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsRecordBulk.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDnsRecordsObject)
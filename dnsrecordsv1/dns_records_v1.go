@@ -189,6 +189,12 @@ func (dnsRecords *DnsRecordsV1) DisableRetries() {
 	dnsRecords.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (dnsRecords *DnsRecordsV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(dnsRecords.Service, policy)
+}
+
 // ListAllDnsRecords : List all DNS records
 // List all DNS records for a given zone of a service instance.
 func (dnsRecords *DnsRecordsV1) ListAllDnsRecords(listAllDnsRecordsOptions *ListAllDnsRecordsOptions) (result *ListDnsrecordsResp, response *core.DetailedResponse, err error) {
@@ -263,6 +269,7 @@ func (dnsRecords *DnsRecordsV1) ListAllDnsRecordsWithContext(ctx context.Context
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsRecords.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_all_dns_records", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -357,6 +364,7 @@ func (dnsRecords *DnsRecordsV1) CreateDnsRecordWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsRecords.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_dns_record", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -428,6 +436,7 @@ func (dnsRecords *DnsRecordsV1) DeleteDnsRecordWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsRecords.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_dns_record", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -499,6 +508,7 @@ func (dnsRecords *DnsRecordsV1) GetDnsRecordWithContext(ctx context.Context, get
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsRecords.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_dns_record", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -599,6 +609,7 @@ func (dnsRecords *DnsRecordsV1) UpdateDnsRecordWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsRecords.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_dns_record", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -685,6 +696,7 @@ func (dnsRecords *DnsRecordsV1) BatchDnsRecordsWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsRecords.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "batch_dns_records", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1964,6 +1976,20 @@ func UnmarshalListDnsrecordsResp(m map[string]json.RawMessage, result interface{
 	return
 }
 
+// Retrieve the value to be passed to a request to access the next page of results
+func (resp *ListDnsrecordsResp) GetNextPage() (*int64, error) {
+	if core.IsNil(resp.ResultInfo) || resp.ResultInfo.Page == nil || resp.ResultInfo.PerPage == nil || resp.ResultInfo.TotalCount == nil {
+		return nil, nil
+	}
+	if resp.ResultInfo.Count != nil && *resp.ResultInfo.Count == 0 {
+		return nil, nil
+	}
+	if *resp.ResultInfo.Page**resp.ResultInfo.PerPage >= *resp.ResultInfo.TotalCount {
+		return nil, nil
+	}
+	return core.Int64Ptr(*resp.ResultInfo.Page + 1), nil
+}
+
 // ResultInfo : result information.
 type ResultInfo struct {
 	// page.
@@ -2005,3 +2031,89 @@ func UnmarshalResultInfo(m map[string]json.RawMessage, result interface{}) (err
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// DnsRecordsPager can be used to simplify the use of the "ListAllDnsRecords" method.
+type DnsRecordsPager struct {
+	hasNext     bool
+	options     *ListAllDnsRecordsOptions
+	client      *DnsRecordsV1
+	pageContext struct {
+		next *int64
+	}
+}
+
+// NewDnsRecordsPager returns a new DnsRecordsPager instance.
+func (dnsRecords *DnsRecordsV1) NewDnsRecordsPager(options *ListAllDnsRecordsOptions) (pager *DnsRecordsPager, err error) {
+	if options.Page != nil && *options.Page > 1 {
+		err = core.SDKErrorf(nil, "the 'options.Page' field should not be set", "no-query-setting", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy ListAllDnsRecordsOptions = *options
+	pager = &DnsRecordsPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  dnsRecords,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *DnsRecordsPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *DnsRecordsPager) GetNextWithContext(ctx context.Context) (page []DnsrecordDetails, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Page = pager.pageContext.next
+
+	result, _, err := pager.client.ListAllDnsRecordsWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	next, err := result.GetNextPage()
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Result
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *DnsRecordsPager) GetAllWithContext(ctx context.Context) (allItems []DnsrecordDetails, err error) {
+	for pager.HasNext() {
+		var nextPage []DnsrecordDetails
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *DnsRecordsPager) GetNext() (page []DnsrecordDetails, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *DnsRecordsPager) GetAll() (allItems []DnsrecordDetails, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
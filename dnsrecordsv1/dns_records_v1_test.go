@@ -29,6 +29,7 @@ import (
 	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/common"
 	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
 	"github.com/go-openapi/strfmt"
 	. "github.com/onsi/ginkgo"
@@ -190,6 +191,65 @@ var _ = Describe(`DnsRecordsV1`, func() {
 			fmt.Fprintf(GinkgoWriter, "Expected error: %s\n", err.Error())
 		})
 	})
+	Describe(`SetRetryPolicy(policy *common.RetryPolicy)`, func() {
+		crn := "testString"
+		zoneIdentifier := "testString"
+		Context(`Using mock server endpoint that throttles the first request`, func() {
+			var requestNumber int
+			BeforeEach(func() {
+				requestNumber = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					requestNumber++
+					res.Header().Set("Content-type", "application/json")
+					if requestNumber == 1 {
+						res.Header().Set("Retry-After", "0")
+						res.WriteHeader(429)
+						return
+					}
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `{"success": true, "errors": [["Errors"]], "messages": [["Messages"]], "result": [], "result_info": {"page": 1, "per_page": 20, "count": 0, "total_count": 0}}`)
+				}))
+			})
+			It(`Invoke ListAllDnsRecords successfully after a 429 response`, func() {
+				dnsRecordsService, serviceErr := dnsrecordsv1.NewDnsRecordsV1(&dnsrecordsv1.DnsRecordsV1Options{
+					URL:            testServer.URL,
+					Authenticator:  &core.NoAuthAuthenticator{},
+					Crn:            core.StringPtr(crn),
+					ZoneIdentifier: core.StringPtr(zoneIdentifier),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(dnsRecordsService).ToNot(BeNil())
+				dnsRecordsService.SetRetryPolicy(common.NewCISRetryPolicy())
+
+				result, response, operationErr := dnsRecordsService.ListAllDnsRecords(new(dnsrecordsv1.ListAllDnsRecordsOptions))
+				Expect(operationErr).To(BeNil())
+				Expect(response.StatusCode).To(Equal(200))
+				Expect(result).ToNot(BeNil())
+				Expect(requestNumber).To(Equal(2))
+			})
+			It(`Invoke ListAllDnsRecords with the policy removed`, func() {
+				dnsRecordsService, serviceErr := dnsrecordsv1.NewDnsRecordsV1(&dnsrecordsv1.DnsRecordsV1Options{
+					URL:            testServer.URL,
+					Authenticator:  &core.NoAuthAuthenticator{},
+					Crn:            core.StringPtr(crn),
+					ZoneIdentifier: core.StringPtr(zoneIdentifier),
+				})
+				Expect(serviceErr).To(BeNil())
+				dnsRecordsService.SetRetryPolicy(common.NewRetryPolicy())
+				dnsRecordsService.SetRetryPolicy(nil)
+
+				_, response, operationErr := dnsRecordsService.ListAllDnsRecords(new(dnsrecordsv1.ListAllDnsRecordsOptions))
+				Expect(operationErr).ToNot(BeNil())
+				Expect(response.StatusCode).To(Equal(429))
+				Expect(requestNumber).To(Equal(1))
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
 	Describe(`ListAllDnsRecords(listAllDnsRecordsOptions *ListAllDnsRecordsOptions) - Operation response error`, func() {
 		crn := "testString"
 		zoneIdentifier := "testString"
@@ -467,6 +527,119 @@ var _ = Describe(`DnsRecordsV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listAllDnsRecordsPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						Expect(req.URL.Query()["page"]).To(BeNil())
+						fmt.Fprintf(res, "%s", `{"success": true, "errors": [["Errors"]], "messages": [["Messages"]], "result": [{"id": "f1aba936b94213e5b8dca0c0dbf1f9cc", "created_on": "2014-01-01T05:20:00.12345Z", "modified_on": "2014-01-01T05:20:00.12345Z", "name": "host-1.test-example.com", "type": "A", "content": "169.154.10.10", "zone_id": "023e105f4ecef8ad9ca31a8372d0c353", "zone_name": "test-example.com", "proxiable": true, "proxied": false, "ttl": 120, "priority": 5, "data": {"anyKey": "anyValue"}}], "result_info": {"page": 1, "per_page": 1, "count": 1, "total_count": 2}}`)
+					} else if requestNumber == 2 {
+						Expect(req.URL.Query()["page"]).To(Equal([]string{fmt.Sprint(int64(2))}))
+						fmt.Fprintf(res, "%s", `{"success": true, "errors": [["Errors"]], "messages": [["Messages"]], "result": [{"id": "f1aba936b94213e5b8dca0c0dbf1f9cc", "created_on": "2014-01-01T05:20:00.12345Z", "modified_on": "2014-01-01T05:20:00.12345Z", "name": "host-1.test-example.com", "type": "A", "content": "169.154.10.10", "zone_id": "023e105f4ecef8ad9ca31a8372d0c353", "zone_name": "test-example.com", "proxiable": true, "proxied": false, "ttl": 120, "priority": 5, "data": {"anyKey": "anyValue"}}], "result_info": {"page": 2, "per_page": 1, "count": 1, "total_count": 2}}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use DnsRecordsPager.GetNext successfully`, func() {
+				dnsRecordsService, serviceErr := dnsrecordsv1.NewDnsRecordsV1(&dnsrecordsv1.DnsRecordsV1Options{
+					URL:            testServer.URL,
+					Authenticator:  &core.NoAuthAuthenticator{},
+					Crn:            core.StringPtr(crn),
+					ZoneIdentifier: core.StringPtr(zoneIdentifier),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(dnsRecordsService).ToNot(BeNil())
+
+				// Construct an instance of the ListAllDnsRecordsOptions model
+				listAllDnsRecordsOptionsModel := new(dnsrecordsv1.ListAllDnsRecordsOptions)
+				listAllDnsRecordsOptionsModel.Type = core.StringPtr("testString")
+				listAllDnsRecordsOptionsModel.Name = core.StringPtr("host1.test-example.com")
+				listAllDnsRecordsOptionsModel.Content = core.StringPtr("1.2.3.4")
+				listAllDnsRecordsOptionsModel.Order = core.StringPtr("type")
+				listAllDnsRecordsOptionsModel.Direction = core.StringPtr("asc")
+				listAllDnsRecordsOptionsModel.Match = core.StringPtr("any")
+
+				pager, err := dnsRecordsService.NewDnsRecordsPager(listAllDnsRecordsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []dnsrecordsv1.DnsrecordDetails
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use DnsRecordsPager.GetAll successfully`, func() {
+				dnsRecordsService, serviceErr := dnsrecordsv1.NewDnsRecordsV1(&dnsrecordsv1.DnsRecordsV1Options{
+					URL:            testServer.URL,
+					Authenticator:  &core.NoAuthAuthenticator{},
+					Crn:            core.StringPtr(crn),
+					ZoneIdentifier: core.StringPtr(zoneIdentifier),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(dnsRecordsService).ToNot(BeNil())
+
+				// Construct an instance of the ListAllDnsRecordsOptions model
+				listAllDnsRecordsOptionsModel := new(dnsrecordsv1.ListAllDnsRecordsOptions)
+				listAllDnsRecordsOptionsModel.Type = core.StringPtr("testString")
+				listAllDnsRecordsOptionsModel.Name = core.StringPtr("host1.test-example.com")
+				listAllDnsRecordsOptionsModel.Content = core.StringPtr("1.2.3.4")
+				listAllDnsRecordsOptionsModel.Order = core.StringPtr("type")
+				listAllDnsRecordsOptionsModel.Direction = core.StringPtr("asc")
+				listAllDnsRecordsOptionsModel.Match = core.StringPtr("any")
+
+				pager, err := dnsRecordsService.NewDnsRecordsPager(listAllDnsRecordsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Invoke NewDnsRecordsPager with a page already set`, func() {
+				dnsRecordsService, serviceErr := dnsrecordsv1.NewDnsRecordsV1(&dnsrecordsv1.DnsRecordsV1Options{
+					URL:            testServer.URL,
+					Authenticator:  &core.NoAuthAuthenticator{},
+					Crn:            core.StringPtr(crn),
+					ZoneIdentifier: core.StringPtr(zoneIdentifier),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(dnsRecordsService).ToNot(BeNil())
+
+				// Construct an instance of the ListAllDnsRecordsOptions model
+				listAllDnsRecordsOptionsModel := new(dnsrecordsv1.ListAllDnsRecordsOptions)
+				listAllDnsRecordsOptionsModel.Type = core.StringPtr("testString")
+				listAllDnsRecordsOptionsModel.Name = core.StringPtr("host1.test-example.com")
+				listAllDnsRecordsOptionsModel.Content = core.StringPtr("1.2.3.4")
+				listAllDnsRecordsOptionsModel.Order = core.StringPtr("type")
+				listAllDnsRecordsOptionsModel.Direction = core.StringPtr("asc")
+				listAllDnsRecordsOptionsModel.Match = core.StringPtr("any")
+				listAllDnsRecordsOptionsModel.Page = core.Int64Ptr(int64(3))
+
+				pager, err := dnsRecordsService.NewDnsRecordsPager(listAllDnsRecordsOptionsModel)
+				Expect(err).ToNot(BeNil())
+				Expect(pager).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
 	})
 	Describe(`CreateDnsRecord(createDnsRecordOptions *CreateDnsRecordOptions) - Operation response error`, func() {
 		crn := "testString"
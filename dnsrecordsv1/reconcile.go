@@ -0,0 +1,564 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnsrecordsv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+)
+
+// ReconcileDnsRecords : Reconcile DNS records
+// Bring the records of the zone to the desired state: list the current records, compute a plan of creates, updates
+// and deletes keyed on type, name and content, and apply the plan in a single BatchDnsRecords request so that it
+// either succeeds or fails as a whole. When DryRun is set the plan is returned without being applied.
+func (dnsRecords *DnsRecordsV1) ReconcileDnsRecords(reconcileDnsRecordsOptions *ReconcileDnsRecordsOptions) (plan *DnsRecordsPlan, result *BatchDnsRecordsResponse, response *core.DetailedResponse, err error) {
+	plan, result, response, err = dnsRecords.ReconcileDnsRecordsWithContext(context.Background(), reconcileDnsRecordsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ReconcileDnsRecordsWithContext is an alternate form of the ReconcileDnsRecords method which supports a Context parameter
+func (dnsRecords *DnsRecordsV1) ReconcileDnsRecordsWithContext(ctx context.Context, reconcileDnsRecordsOptions *ReconcileDnsRecordsOptions) (plan *DnsRecordsPlan, result *BatchDnsRecordsResponse, response *core.DetailedResponse, err error) {
+	plan, err = dnsRecords.PlanDnsRecordsWithContext(ctx, reconcileDnsRecordsOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "plan-error")
+		return
+	}
+	if (reconcileDnsRecordsOptions.DryRun != nil && *reconcileDnsRecordsOptions.DryRun) || !plan.HasChanges() {
+		return
+	}
+
+	result, response, err = dnsRecords.ApplyDnsRecordsPlanWithContext(ctx, plan)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "apply-error")
+	}
+	return
+}
+
+// PlanDnsRecords : Plan DNS record changes
+// List the current records of the zone and compute the changes needed to reach the desired state.
+func (dnsRecords *DnsRecordsV1) PlanDnsRecords(reconcileDnsRecordsOptions *ReconcileDnsRecordsOptions) (plan *DnsRecordsPlan, err error) {
+	plan, err = dnsRecords.PlanDnsRecordsWithContext(context.Background(), reconcileDnsRecordsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PlanDnsRecordsWithContext is an alternate form of the PlanDnsRecords method which supports a Context parameter
+func (dnsRecords *DnsRecordsV1) PlanDnsRecordsWithContext(ctx context.Context, reconcileDnsRecordsOptions *ReconcileDnsRecordsOptions) (plan *DnsRecordsPlan, err error) {
+	err = core.ValidateNotNil(reconcileDnsRecordsOptions, "reconcileDnsRecordsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+
+	pager, err := dnsRecords.NewDnsRecordsPager(&ListAllDnsRecordsOptions{
+		PerPage: core.Int64Ptr(int64(1000)),
+		Headers: reconcileDnsRecordsOptions.Headers,
+	})
+	if err != nil {
+		return
+	}
+	current, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-error")
+		return
+	}
+
+	plan, err = ComputeDnsRecordsPlan(current, reconcileDnsRecordsOptions)
+	return
+}
+
+// ApplyDnsRecordsPlan : Apply a DNS record plan
+// Apply all changes of the plan in a single BatchDnsRecords request.
+func (dnsRecords *DnsRecordsV1) ApplyDnsRecordsPlan(plan *DnsRecordsPlan) (result *BatchDnsRecordsResponse, response *core.DetailedResponse, err error) {
+	result, response, err = dnsRecords.ApplyDnsRecordsPlanWithContext(context.Background(), plan)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ApplyDnsRecordsPlanWithContext is an alternate form of the ApplyDnsRecordsPlan method which supports a Context parameter
+func (dnsRecords *DnsRecordsV1) ApplyDnsRecordsPlanWithContext(ctx context.Context, plan *DnsRecordsPlan) (result *BatchDnsRecordsResponse, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(plan, "plan cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+
+	result, response, err = dnsRecords.BatchDnsRecordsWithContext(ctx, plan.BatchDnsRecordsOptions())
+	return
+}
+
+// ComputeDnsRecordsPlan computes the changes that turn "current" into the desired state described by
+// "reconcileDnsRecordsOptions" without calling the API.
+func ComputeDnsRecordsPlan(current []DnsrecordDetails, reconcileDnsRecordsOptions *ReconcileDnsRecordsOptions) (plan *DnsRecordsPlan, err error) {
+	err = core.ValidateNotNil(reconcileDnsRecordsOptions, "reconcileDnsRecordsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	options := reconcileDnsRecordsOptions
+
+	zoneName := ""
+	if options.ZoneName != nil {
+		zoneName = normalizeDnsName(*options.ZoneName)
+	} else {
+		for _, record := range current {
+			if record.ZoneName != nil {
+				zoneName = normalizeDnsName(*record.ZoneName)
+				break
+			}
+		}
+	}
+
+	managedTypes := map[string]bool{}
+	for _, recordType := range options.Types {
+		managedTypes[strings.ToUpper(recordType)] = true
+	}
+	isManagedType := func(recordType string) bool {
+		return len(managedTypes) == 0 || managedTypes[recordType]
+	}
+
+	plan = &DnsRecordsPlan{Headers: options.Headers}
+
+	desired := map[string]DnsrecordInput{}
+	var desiredKeys []string
+	for i, input := range options.Desired {
+		if input.Type == nil || *input.Type == "" {
+			err = core.SDKErrorf(nil, fmt.Sprintf("desired record %d has no type", i), "missing-type", common.GetComponentInfo())
+			return
+		}
+		record := input
+		record.Type = core.StringPtr(strings.ToUpper(*input.Type))
+		name := ""
+		if input.Name != nil {
+			name = *input.Name
+		}
+		record.Name = core.StringPtr(qualifyDnsName(name, zoneName))
+		if !isManagedType(*record.Type) {
+			err = core.SDKErrorf(nil, fmt.Sprintf("desired record %s %s has a type that is not being reconciled", *record.Type, *record.Name), "unmanaged-type", common.GetComponentInfo())
+			return
+		}
+
+		key := recordKey(*record.Type, *record.Name, record.Content, record.Data)
+		if _, exists := desired[key]; exists {
+			err = core.SDKErrorf(nil, fmt.Sprintf("desired record %s is listed more than once", key), "duplicate-record", common.GetComponentInfo())
+			return
+		}
+		desired[key] = record
+		desiredKeys = append(desiredKeys, key)
+	}
+
+	matched := map[string]bool{}
+	var orphans []DnsrecordDetails
+	for _, record := range current {
+		if record.Type == nil || record.Name == nil || !isManagedType(*record.Type) {
+			continue
+		}
+		key := recordKey(*record.Type, normalizeDnsName(*record.Name), record.Content, record.Data)
+		input, isDesired := desired[key]
+		if !isDesired || matched[key] {
+			orphans = append(orphans, record)
+			continue
+		}
+		matched[key] = true
+
+		if recordMatches(record, input) {
+			plan.Unchanged = append(plan.Unchanged, record)
+		} else if reason := options.blockReason(record); reason != "" {
+			plan.Skipped = append(plan.Skipped, DnsRecordChangeSkipped{Record: record, Desired: &input, Reason: reason})
+		} else {
+			plan.Updates = append(plan.Updates, DnsRecordUpdate{Current: record, Desired: input})
+		}
+	}
+
+	// Records that only differ in content are updated in place rather than
+	// deleted and recreated, which keeps their IDs stable.
+	var creates []DnsrecordInput
+	for _, key := range desiredKeys {
+		if !matched[key] {
+			creates = append(creates, desired[key])
+		}
+	}
+	for _, record := range orphans {
+		replaced := false
+		for i, input := range creates {
+			if *input.Type != *record.Type || *input.Name != normalizeDnsName(*record.Name) {
+				continue
+			}
+			if options.blockReason(record) != "" {
+				break
+			}
+			plan.Updates = append(plan.Updates, DnsRecordUpdate{Current: record, Desired: input})
+			creates = append(creates[:i], creates[i+1:]...)
+			replaced = true
+			break
+		}
+		if replaced {
+			continue
+		}
+
+		if reason := options.blockReason(record); reason != "" {
+			if options.isOwned(record) {
+				plan.Skipped = append(plan.Skipped, DnsRecordChangeSkipped{Record: record, Reason: reason})
+			}
+			continue
+		}
+		plan.Deletes = append(plan.Deletes, record)
+	}
+	plan.Creates = creates
+
+	return
+}
+
+// ReconcileDnsRecordsOptions : The ReconcileDnsRecords options.
+type ReconcileDnsRecordsOptions struct {
+	// The complete set of records the zone should contain (within the managed types and ownership scope). Names may be
+	// fully qualified or relative to the zone, with "@" or "" standing for the apex.
+	Desired []DnsrecordInput
+
+	// The zone name used to qualify relative names. Defaults to the zone name reported with the current records.
+	ZoneName *string
+
+	// Only records of these types are created, updated or deleted. All types are managed when empty.
+	Types []string
+
+	// Protected reports records that must never be updated or deleted.
+	Protected func(record DnsrecordDetails) bool
+
+	// Owned reports records that were created by this reconciler. When set, records that are not owned are never
+	// updated or deleted.
+	Owned func(record DnsrecordDetails) bool
+
+	// Compute the plan without applying it.
+	DryRun *bool
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewReconcileDnsRecordsOptions : Instantiate ReconcileDnsRecordsOptions
+func (*DnsRecordsV1) NewReconcileDnsRecordsOptions(desired []DnsrecordInput) *ReconcileDnsRecordsOptions {
+	return &ReconcileDnsRecordsOptions{
+		Desired: desired,
+	}
+}
+
+// SetDesired : Allow user to set Desired
+func (_options *ReconcileDnsRecordsOptions) SetDesired(desired []DnsrecordInput) *ReconcileDnsRecordsOptions {
+	_options.Desired = desired
+	return _options
+}
+
+// SetZoneName : Allow user to set ZoneName
+func (_options *ReconcileDnsRecordsOptions) SetZoneName(zoneName string) *ReconcileDnsRecordsOptions {
+	_options.ZoneName = core.StringPtr(zoneName)
+	return _options
+}
+
+// SetTypes : Allow user to set Types
+func (_options *ReconcileDnsRecordsOptions) SetTypes(types []string) *ReconcileDnsRecordsOptions {
+	_options.Types = types
+	return _options
+}
+
+// SetProtected : Allow user to set Protected
+func (_options *ReconcileDnsRecordsOptions) SetProtected(protected func(record DnsrecordDetails) bool) *ReconcileDnsRecordsOptions {
+	_options.Protected = protected
+	return _options
+}
+
+// SetOwned : Allow user to set Owned
+func (_options *ReconcileDnsRecordsOptions) SetOwned(owned func(record DnsrecordDetails) bool) *ReconcileDnsRecordsOptions {
+	_options.Owned = owned
+	return _options
+}
+
+// SetDryRun : Allow user to set DryRun
+func (_options *ReconcileDnsRecordsOptions) SetDryRun(dryRun bool) *ReconcileDnsRecordsOptions {
+	_options.DryRun = core.BoolPtr(dryRun)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (_options *ReconcileDnsRecordsOptions) SetHeaders(param map[string]string) *ReconcileDnsRecordsOptions {
+	_options.Headers = param
+	return _options
+}
+
+func (_options *ReconcileDnsRecordsOptions) isOwned(record DnsrecordDetails) bool {
+	return _options.Owned == nil || _options.Owned(record)
+}
+
+// blockReason returns why "record" may not be changed, or "" if it may.
+func (_options *ReconcileDnsRecordsOptions) blockReason(record DnsrecordDetails) string {
+	if _options.Protected != nil && _options.Protected(record) {
+		return "protected"
+	}
+	if !_options.isOwned(record) {
+		return "not owned"
+	}
+	return ""
+}
+
+// ProtectDnsRecords returns a Protected function matching records of the given type and name. An empty type matches
+// every type.
+func ProtectDnsRecords(recordType string, name string) func(record DnsrecordDetails) bool {
+	recordType = strings.ToUpper(recordType)
+	name = normalizeDnsName(name)
+	return func(record DnsrecordDetails) bool {
+		if recordType != "" && (record.Type == nil || *record.Type != recordType) {
+			return false
+		}
+		return record.Name != nil && normalizeDnsName(*record.Name) == name
+	}
+}
+
+// DnsRecordsPlan : The changes that bring a zone to its desired state.
+type DnsRecordsPlan struct {
+	// Records to create.
+	Creates []DnsrecordInput
+
+	// Records to update in place.
+	Updates []DnsRecordUpdate
+
+	// Records to delete.
+	Deletes []DnsrecordDetails
+
+	// Records that already match the desired state.
+	Unchanged []DnsrecordDetails
+
+	// Changes that were not planned because the record is protected or not owned.
+	Skipped []DnsRecordChangeSkipped
+
+	// The headers of the ReconcileDnsRecordsOptions, sent again when the plan is applied.
+	Headers map[string]string
+}
+
+// DnsRecordUpdate : A record that is updated in place.
+type DnsRecordUpdate struct {
+	Current DnsrecordDetails
+	Desired DnsrecordInput
+}
+
+// DnsRecordChangeSkipped : A change that was left out of the plan.
+type DnsRecordChangeSkipped struct {
+	// The current record.
+	Record DnsrecordDetails
+
+	// The desired state of the record, if it is wanted at all.
+	Desired *DnsrecordInput
+
+	// Why the record was left alone.
+	Reason string
+}
+
+// HasChanges returns true if applying the plan would change the zone.
+func (plan *DnsRecordsPlan) HasChanges() bool {
+	return len(plan.Creates)+len(plan.Updates)+len(plan.Deletes) > 0
+}
+
+// BatchDnsRecordsOptions returns the BatchDnsRecords request that applies the plan.
+func (plan *DnsRecordsPlan) BatchDnsRecordsOptions() *BatchDnsRecordsOptions {
+	options := &BatchDnsRecordsOptions{Headers: plan.Headers}
+	for _, record := range plan.Deletes {
+		options.Deletes = append(options.Deletes, BatchDnsRecordsRequestDeletesItem{ID: record.ID})
+	}
+	for _, update := range plan.Updates {
+		// A PUT replaces the whole record, so the attributes left unset in the desired record keep their current
+		// values, the way recordMatches ignores them.
+		put := BatchDnsRecordsRequestPutsItem{
+			ID:       update.Current.ID,
+			Name:     update.Desired.Name,
+			Type:     update.Desired.Type,
+			TTL:      update.Desired.TTL,
+			Content:  update.Desired.Content,
+			Priority: update.Desired.Priority,
+			Proxied:  update.Desired.Proxied,
+			Data:     update.Desired.Data,
+		}
+		if put.TTL == nil {
+			put.TTL = update.Current.TTL
+		}
+		if put.TTL == nil {
+			put.TTL = core.Int64Ptr(1)
+		}
+		if put.Content == nil {
+			put.Content = update.Current.Content
+		}
+		if put.Priority == nil {
+			put.Priority = update.Current.Priority
+		}
+		if put.Proxied == nil {
+			put.Proxied = update.Current.Proxied
+		}
+		if put.Data == nil {
+			put.Data = update.Current.Data
+		}
+		options.Puts = append(options.Puts, put)
+	}
+	options.Posts = append(options.Posts, plan.Creates...)
+	return options
+}
+
+// String renders the plan as a diff, one record per line: "+" creates, "~" updates, "-" deletes and "!" skipped
+// changes.
+func (plan *DnsRecordsPlan) String() string {
+	var lines []string
+	for _, record := range plan.Creates {
+		lines = append(lines, "+ "+describeDnsrecordInput(record))
+	}
+	for _, update := range plan.Updates {
+		lines = append(lines, "~ "+describeDnsrecordDetails(update.Current)+" => "+describeDnsrecordInput(update.Desired))
+	}
+	for _, record := range plan.Deletes {
+		lines = append(lines, "- "+describeDnsrecordDetails(record))
+	}
+	for _, skipped := range plan.Skipped {
+		lines = append(lines, fmt.Sprintf("! %s (%s)", describeDnsrecordDetails(skipped.Record), skipped.Reason))
+	}
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i][2:] < lines[j][2:]
+	})
+	if len(lines) == 0 {
+		return "no changes\n"
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// normalizeDnsName lower-cases a DNS name and removes any trailing dot.
+func normalizeDnsName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+}
+
+// qualifyDnsName returns the fully qualified form of "name" within "zoneName".
+func qualifyDnsName(name string, zoneName string) string {
+	trimmed := strings.TrimSpace(name)
+	if trimmed == "" || trimmed == "@" {
+		return zoneName
+	}
+	normalized := normalizeDnsName(trimmed)
+	if zoneName == "" || strings.HasSuffix(trimmed, ".") || normalized == zoneName || strings.HasSuffix(normalized, "."+zoneName) {
+		return normalized
+	}
+	return normalized + "." + zoneName
+}
+
+// normalizeDnsContent puts record content in the form CIS reports it.
+func normalizeDnsContent(recordType string, content string) string {
+	switch recordType {
+	case DnsrecordDetails_Type_A, DnsrecordDetails_Type_Aaaa:
+		if ip := net.ParseIP(strings.TrimSpace(content)); ip != nil {
+			return ip.String()
+		}
+	case DnsrecordDetails_Type_Cname, DnsrecordDetails_Type_Mx, DnsrecordDetails_Type_Ns, DnsrecordDetails_Type_Ptr:
+		return normalizeDnsName(content)
+	}
+	return content
+}
+
+// recordKey identifies a record by type, name and content. Records whose value is carried in "data" (SRV, CAA, LOC
+// and DS) are keyed on the data when it is present.
+func recordKey(recordType string, name string, content *string, data map[string]interface{}) string {
+	value := ""
+	switch {
+	case data != nil && isDataRecordType(recordType):
+		value = canonicalData(data)
+	case content != nil:
+		value = normalizeDnsContent(recordType, *content)
+	}
+	return recordType + " " + name + " " + value
+}
+
+func isDataRecordType(recordType string) bool {
+	switch recordType {
+	case DnsrecordDetails_Type_Srv, DnsrecordDetails_Type_Caa, DnsrecordDetails_Type_Loc, DnsrecordDetails_Type_Ds:
+		return true
+	}
+	return false
+}
+
+func canonicalData(data map[string]interface{}) string {
+	if data == nil {
+		return ""
+	}
+	// encoding/json sorts map keys and prints integral numbers without a
+	// fraction, so equal data yields equal text.
+	b, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Sprint(data)
+	}
+	var normalized interface{}
+	if json.Unmarshal(b, &normalized) == nil {
+		b, _ = json.Marshal(normalized)
+	}
+	return string(b)
+}
+
+// recordMatches returns true if "record" already has every attribute "input" asks for. Attributes left unset in
+// "input" are not compared.
+func recordMatches(record DnsrecordDetails, input DnsrecordInput) bool {
+	if input.TTL != nil && (record.TTL == nil || *record.TTL != *input.TTL) {
+		return false
+	}
+	if input.Priority != nil && (record.Priority == nil || *record.Priority != *input.Priority) {
+		return false
+	}
+	if input.Proxied != nil && (record.Proxied == nil || *record.Proxied != *input.Proxied) {
+		return false
+	}
+	if input.Content != nil && (record.Content == nil || normalizeDnsContent(*input.Type, *record.Content) != normalizeDnsContent(*input.Type, *input.Content)) {
+		return false
+	}
+	if input.Data != nil && canonicalData(record.Data) != canonicalData(input.Data) {
+		return false
+	}
+	return true
+}
+
+func describeDnsrecordInput(record DnsrecordInput) string {
+	return describeDnsrecord(record.Type, record.Name, record.Content, record.Data, record.TTL, record.Priority, record.Proxied)
+}
+
+func describeDnsrecordDetails(record DnsrecordDetails) string {
+	return describeDnsrecord(record.Type, record.Name, record.Content, record.Data, record.TTL, record.Priority, record.Proxied)
+}
+
+func describeDnsrecord(recordType *string, name *string, content *string, data map[string]interface{}, ttl *int64, priority *int64, proxied *bool) string {
+	parts := []string{core.StringNilMapper(recordType), core.StringNilMapper(name)}
+	if content != nil {
+		parts = append(parts, *content)
+	} else if data != nil {
+		parts = append(parts, canonicalData(data))
+	}
+	if ttl != nil {
+		parts = append(parts, fmt.Sprintf("ttl=%d", *ttl))
+	}
+	if priority != nil {
+		parts = append(parts, fmt.Sprintf("priority=%d", *priority))
+	}
+	if proxied != nil {
+		parts = append(parts, fmt.Sprintf("proxied=%t", *proxied))
+	}
+	return strings.Join(parts, " ")
+}
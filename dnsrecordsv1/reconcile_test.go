@@ -0,0 +1,235 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnsrecordsv1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DnsRecordsV1 reconciliation`, func() {
+	currentRecords := func() []dnsrecordsv1.DnsrecordDetails {
+		return []dnsrecordsv1.DnsrecordDetails{
+			{ID: core.StringPtr("id-apex-ns"), Name: core.StringPtr("example.com"), Type: core.StringPtr("NS"), Content: core.StringPtr("ns1.example.net"), TTL: core.Int64Ptr(86400), ZoneName: core.StringPtr("example.com")},
+			{ID: core.StringPtr("id-www"), Name: core.StringPtr("www.example.com"), Type: core.StringPtr("A"), Content: core.StringPtr("192.0.2.10"), TTL: core.Int64Ptr(300), Proxied: core.BoolPtr(false), ZoneName: core.StringPtr("example.com")},
+			{ID: core.StringPtr("id-api"), Name: core.StringPtr("api.example.com"), Type: core.StringPtr("A"), Content: core.StringPtr("192.0.2.20"), TTL: core.Int64Ptr(300), ZoneName: core.StringPtr("example.com")},
+			{ID: core.StringPtr("id-old"), Name: core.StringPtr("old.example.com"), Type: core.StringPtr("CNAME"), Content: core.StringPtr("www.example.com"), TTL: core.Int64Ptr(1), ZoneName: core.StringPtr("example.com")},
+			{ID: core.StringPtr("id-mail"), Name: core.StringPtr("example.com"), Type: core.StringPtr("MX"), Content: core.StringPtr("mail.example.com"), Priority: core.Int64Ptr(10), TTL: core.Int64Ptr(300), ZoneName: core.StringPtr("example.com")},
+		}
+	}
+	desiredRecords := func() []dnsrecordsv1.DnsrecordInput {
+		return []dnsrecordsv1.DnsrecordInput{
+			{Name: core.StringPtr("@"), Type: core.StringPtr("NS"), Content: core.StringPtr("ns1.example.net.")},
+			{Name: core.StringPtr("www"), Type: core.StringPtr("A"), Content: core.StringPtr("192.0.2.10"), TTL: core.Int64Ptr(300)},
+			{Name: core.StringPtr("api"), Type: core.StringPtr("A"), Content: core.StringPtr("192.0.2.21"), TTL: core.Int64Ptr(300)},
+			{Name: core.StringPtr("new.example.com"), Type: core.StringPtr("TXT"), Content: core.StringPtr("hello"), TTL: core.Int64Ptr(120)},
+			{Name: core.StringPtr(""), Type: core.StringPtr("mx"), Content: core.StringPtr("mail.example.com"), Priority: core.Int64Ptr(20), TTL: core.Int64Ptr(300)},
+		}
+	}
+
+	Describe(`ComputeDnsRecordsPlan(current []DnsrecordDetails, reconcileDnsRecordsOptions *ReconcileDnsRecordsOptions)`, func() {
+		It(`Plan creates, updates and deletes`, func() {
+			options := new(dnsrecordsv1.ReconcileDnsRecordsOptions).SetDesired(desiredRecords()).SetHeaders(map[string]string{"Transaction-Id": "tx1"})
+			plan, err := dnsrecordsv1.ComputeDnsRecordsPlan(currentRecords(), options)
+			Expect(err).To(BeNil())
+			Expect(plan.HasChanges()).To(BeTrue())
+
+			Expect(plan.Creates).To(HaveLen(1))
+			Expect(*plan.Creates[0].Name).To(Equal("new.example.com"))
+
+			Expect(plan.Updates).To(HaveLen(2))
+			updated := map[string]string{}
+			for _, update := range plan.Updates {
+				updated[*update.Current.ID] = *update.Desired.Content
+			}
+			Expect(updated).To(Equal(map[string]string{"id-api": "192.0.2.21", "id-mail": "mail.example.com"}))
+
+			Expect(plan.Deletes).To(HaveLen(1))
+			Expect(*plan.Deletes[0].ID).To(Equal("id-old"))
+			Expect(plan.Unchanged).To(HaveLen(2))
+
+			batch := plan.BatchDnsRecordsOptions()
+			Expect(batch.Deletes).To(HaveLen(1))
+			Expect(batch.Puts).To(HaveLen(2))
+			Expect(batch.Posts).To(HaveLen(1))
+			Expect(batch.Patches).To(BeNil())
+			Expect(batch.Headers).To(Equal(map[string]string{"Transaction-Id": "tx1"}))
+		})
+		It(`Keep the current attributes the desired records leave unset`, func() {
+			current := []dnsrecordsv1.DnsrecordDetails{
+				{ID: core.StringPtr("id-www"), Name: core.StringPtr("www.example.com"), Type: core.StringPtr("A"), Content: core.StringPtr("192.0.2.10"), TTL: core.Int64Ptr(3600), Proxied: core.BoolPtr(true), ZoneName: core.StringPtr("example.com")},
+				{ID: core.StringPtr("id-mail"), Name: core.StringPtr("example.com"), Type: core.StringPtr("MX"), Content: core.StringPtr("mail.example.com"), Priority: core.Int64Ptr(10), TTL: core.Int64Ptr(300), ZoneName: core.StringPtr("example.com")},
+			}
+			options := new(dnsrecordsv1.ReconcileDnsRecordsOptions).SetDesired([]dnsrecordsv1.DnsrecordInput{
+				{Name: core.StringPtr("www"), Type: core.StringPtr("A"), Content: core.StringPtr("192.0.2.11")},
+				{Name: core.StringPtr("@"), Type: core.StringPtr("MX"), Content: core.StringPtr("mx.example.com")},
+			})
+			plan, err := dnsrecordsv1.ComputeDnsRecordsPlan(current, options)
+			Expect(err).To(BeNil())
+
+			batch := plan.BatchDnsRecordsOptions()
+			Expect(batch.Puts).To(HaveLen(2))
+			puts := map[string]dnsrecordsv1.BatchDnsRecordsRequestPutsItem{}
+			for _, put := range batch.Puts {
+				puts[*put.ID] = put
+			}
+			Expect(*puts["id-www"].Content).To(Equal("192.0.2.11"))
+			Expect(*puts["id-www"].TTL).To(Equal(int64(3600)))
+			Expect(*puts["id-www"].Proxied).To(BeTrue())
+			Expect(*puts["id-mail"].Content).To(Equal("mx.example.com"))
+			Expect(*puts["id-mail"].Priority).To(Equal(int64(10)))
+			Expect(*puts["id-mail"].TTL).To(Equal(int64(300)))
+		})
+		It(`Leave protected and unowned records alone`, func() {
+			options := new(dnsrecordsv1.ReconcileDnsRecordsOptions).
+				SetDesired(desiredRecords()[:2]).
+				SetProtected(dnsrecordsv1.ProtectDnsRecords("CNAME", "old.example.com.")).
+				SetOwned(func(record dnsrecordsv1.DnsrecordDetails) bool {
+					return *record.ID != "id-mail"
+				})
+			plan, err := dnsrecordsv1.ComputeDnsRecordsPlan(currentRecords(), options)
+			Expect(err).To(BeNil())
+
+			Expect(plan.Creates).To(BeEmpty())
+			Expect(plan.Updates).To(BeEmpty())
+			Expect(plan.Deletes).To(HaveLen(1))
+			Expect(*plan.Deletes[0].ID).To(Equal("id-api"))
+			Expect(plan.Skipped).To(HaveLen(1))
+			Expect(*plan.Skipped[0].Record.ID).To(Equal("id-old"))
+			Expect(plan.Skipped[0].Reason).To(Equal("protected"))
+		})
+		It(`Limit the plan to the managed types`, func() {
+			options := new(dnsrecordsv1.ReconcileDnsRecordsOptions).
+				SetDesired(desiredRecords()[1:3]).
+				SetTypes([]string{"A"})
+			plan, err := dnsrecordsv1.ComputeDnsRecordsPlan(currentRecords(), options)
+			Expect(err).To(BeNil())
+			Expect(plan.Deletes).To(BeEmpty())
+			Expect(plan.Updates).To(HaveLen(1))
+			Expect(plan.String()).To(Equal("~ A api.example.com 192.0.2.20 ttl=300 => A api.example.com 192.0.2.21 ttl=300\n"))
+		})
+		It(`Report an empty plan`, func() {
+			options := new(dnsrecordsv1.ReconcileDnsRecordsOptions).
+				SetDesired(desiredRecords()[1:2]).
+				SetTypes([]string{"A"}).
+				SetOwned(func(record dnsrecordsv1.DnsrecordDetails) bool {
+					return *record.ID == "id-www"
+				})
+			plan, err := dnsrecordsv1.ComputeDnsRecordsPlan(currentRecords(), options)
+			Expect(err).To(BeNil())
+			Expect(plan.HasChanges()).To(BeFalse())
+			Expect(plan.String()).To(Equal("no changes\n"))
+		})
+		It(`Invoke ComputeDnsRecordsPlan with invalid desired records`, func() {
+			_, err := dnsrecordsv1.ComputeDnsRecordsPlan(nil, nil)
+			Expect(err).ToNot(BeNil())
+
+			options := new(dnsrecordsv1.ReconcileDnsRecordsOptions).SetDesired([]dnsrecordsv1.DnsrecordInput{{Name: core.StringPtr("www")}})
+			_, err = dnsrecordsv1.ComputeDnsRecordsPlan(nil, options)
+			Expect(err).ToNot(BeNil())
+
+			duplicate := desiredRecords()[1]
+			options.SetDesired([]dnsrecordsv1.DnsrecordInput{duplicate, duplicate}).SetZoneName("example.com")
+			_, err = dnsrecordsv1.ComputeDnsRecordsPlan(nil, options)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("more than once"))
+		})
+	})
+	Describe(`ReconcileDnsRecords(reconcileDnsRecordsOptions *ReconcileDnsRecordsOptions)`, func() {
+		var testServer *httptest.Server
+		var batchBody map[string]interface{}
+		var batchRequests int
+		BeforeEach(func() {
+			batchBody = nil
+			batchRequests = 0
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				res.Header().Set("Content-type", "application/json")
+				if req.Method == "GET" {
+					Expect(req.URL.EscapedPath()).To(Equal("/v1/testString/zones/testString/dns_records"))
+					records, _ := json.Marshal(currentRecords())
+					res.WriteHeader(200)
+					fmt.Fprintf(res, `{"success": true, "errors": [], "messages": [], "result": %s, "result_info": {"page": 1, "per_page": 1000, "count": 5, "total_count": 5}}`, records)
+					return
+				}
+
+				Expect(req.URL.EscapedPath()).To(Equal("/v1/testString/zones/testString/dns_records/batch"))
+				Expect(req.Method).To(Equal("POST"))
+				batchRequests++
+				body, _ := io.ReadAll(req.Body)
+				Expect(json.Unmarshal(body, &batchBody)).To(BeNil())
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"success": true, "errors": [], "messages": [], "result": {"deletes": [{"id": "id-old"}], "puts": [], "posts": []}}`)
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+		newService := func() *dnsrecordsv1.DnsRecordsV1 {
+			dnsRecordsService, serviceErr := dnsrecordsv1.NewDnsRecordsV1(&dnsrecordsv1.DnsRecordsV1Options{
+				URL:            testServer.URL,
+				Authenticator:  &core.NoAuthAuthenticator{},
+				Crn:            core.StringPtr("testString"),
+				ZoneIdentifier: core.StringPtr("testString"),
+			})
+			Expect(serviceErr).To(BeNil())
+			return dnsRecordsService
+		}
+		It(`Invoke ReconcileDnsRecords in dry-run mode`, func() {
+			dnsRecordsService := newService()
+			options := dnsRecordsService.NewReconcileDnsRecordsOptions(desiredRecords()).SetDryRun(true)
+
+			plan, result, response, err := dnsRecordsService.ReconcileDnsRecords(options)
+			Expect(err).To(BeNil())
+			Expect(result).To(BeNil())
+			Expect(response).To(BeNil())
+			Expect(plan.HasChanges()).To(BeTrue())
+			Expect(strings.Count(plan.String(), "\n")).To(Equal(4))
+			Expect(batchRequests).To(Equal(0))
+		})
+		It(`Invoke ReconcileDnsRecords successfully`, func() {
+			dnsRecordsService := newService()
+			options := dnsRecordsService.NewReconcileDnsRecordsOptions(desiredRecords())
+
+			plan, result, response, err := dnsRecordsService.ReconcileDnsRecords(options)
+			Expect(err).To(BeNil())
+			Expect(response).ToNot(BeNil())
+			Expect(result).ToNot(BeNil())
+			Expect(plan).ToNot(BeNil())
+			Expect(batchRequests).To(Equal(1))
+			Expect(batchBody["deletes"]).To(HaveLen(1))
+			Expect(batchBody["puts"]).To(HaveLen(2))
+			Expect(batchBody["posts"]).To(HaveLen(1))
+		})
+		It(`Invoke ReconcileDnsRecords with nil options`, func() {
+			dnsRecordsService := newService()
+			plan, _, _, err := dnsRecordsService.ReconcileDnsRecords(nil)
+			Expect(err).ToNot(BeNil())
+			Expect(plan).To(BeNil())
+		})
+	})
+})
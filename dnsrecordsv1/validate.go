@@ -0,0 +1,323 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnsrecordsv1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	common "github.com/IBM/networking-go-sdk/common"
+)
+
+// The limits the validator checks record fields against.
+const (
+	dnsRecordMaxTTL      = 86400
+	dnsRecordMaxTxtChars = 255
+)
+
+// dnsRecordFields are the fields shared by every DNS record request model.
+type dnsRecordFields struct {
+	name     *string
+	typeVar  *string
+	ttl      *int64
+	content  *string
+	priority *int64
+	proxied  *bool
+	data     map[string]interface{}
+}
+
+// ValidateRecord checks the record described by the options against the rules of its type, before it is sent, and
+// returns a common.ValidationErrors listing every invalid field. "zoneName" is used to recognize records at the
+// zone apex and may be empty, in which case only "@" names the apex.
+func (_options *CreateDnsRecordOptions) ValidateRecord(zoneName string) error {
+	if _options == nil {
+		return fmt.Errorf("no options were provided")
+	}
+	return validateDnsRecord(zoneName, dnsRecordFields{_options.Name, _options.Type, _options.TTL, _options.Content, _options.Priority, _options.Proxied, _options.Data}, true).Err()
+}
+
+// ValidateRecord checks the record described by the options against the rules of its type. Only the type-independent
+// checks are made when the options do not set the type. See CreateDnsRecordOptions.ValidateRecord.
+func (_options *UpdateDnsRecordOptions) ValidateRecord(zoneName string) error {
+	if _options == nil {
+		return fmt.Errorf("no options were provided")
+	}
+	return validateDnsRecord(zoneName, dnsRecordFields{_options.Name, _options.Type, _options.TTL, _options.Content, _options.Priority, _options.Proxied, _options.Data}, false).Err()
+}
+
+// ValidateRecord checks the record against the rules of its type. See CreateDnsRecordOptions.ValidateRecord.
+func (_options *DnsrecordInput) ValidateRecord(zoneName string) error {
+	if _options == nil {
+		return fmt.Errorf("no record was provided")
+	}
+	return validateDnsRecord(zoneName, dnsRecordFields{_options.Name, _options.Type, _options.TTL, _options.Content, _options.Priority, _options.Proxied, _options.Data}, true).Err()
+}
+
+// ValidateRecords checks every posted, put and patched record of the batch, so that a batch that would be rejected
+// is not sent at all. Field names are prefixed with the record's position, e.g. "posts[2].content".
+func (_options *BatchDnsRecordsOptions) ValidateRecords(zoneName string) error {
+	if _options == nil {
+		return fmt.Errorf("no options were provided")
+	}
+	var validationErrs common.ValidationErrors
+	for i, post := range _options.Posts {
+		validationErrs.Append(fmt.Sprintf("posts[%d]", i), validateDnsRecord(zoneName, dnsRecordFields{post.Name, post.Type, post.TTL, post.Content, post.Priority, post.Proxied, post.Data}, true))
+	}
+	for i, put := range _options.Puts {
+		validationErrs.Append(fmt.Sprintf("puts[%d]", i), validateDnsRecord(zoneName, dnsRecordFields{put.Name, put.Type, put.TTL, put.Content, put.Priority, put.Proxied, put.Data}, true))
+	}
+	for i, patch := range _options.Patches {
+		validationErrs.Append(fmt.Sprintf("patches[%d]", i), validateDnsRecord(zoneName, dnsRecordFields{patch.Name, patch.Type, patch.TTL, patch.Content, patch.Priority, patch.Proxied, patch.Data}, false))
+	}
+	return validationErrs.Err()
+}
+
+func validateDnsRecord(zoneName string, record dnsRecordFields, typeRequired bool) (validationErrs common.ValidationErrors) {
+	if record.name != nil && *record.name != "" && *record.name != "@" && !common.IsHostname(*record.name) {
+		validationErrs.Add("name", "%q is not a valid DNS name", *record.name)
+	}
+	if record.ttl != nil && (*record.ttl < 1 || *record.ttl > dnsRecordMaxTTL) {
+		validationErrs.Add("ttl", "must be between 1 (automatic) and %d, got %d", dnsRecordMaxTTL, *record.ttl)
+	}
+
+	if record.typeVar == nil || *record.typeVar == "" {
+		if typeRequired {
+			validationErrs.Add("type", "is required")
+		}
+		return
+	}
+	recordType := strings.ToUpper(*record.typeVar)
+
+	if record.proxied != nil && *record.proxied {
+		switch recordType {
+		case CreateDnsRecordOptions_Type_A, CreateDnsRecordOptions_Type_Aaaa, CreateDnsRecordOptions_Type_Cname:
+		default:
+			validationErrs.Add("proxied", "is only supported for A, AAAA and CNAME records")
+		}
+	}
+
+	content := func() (string, bool) {
+		if record.content == nil || *record.content == "" {
+			validationErrs.Add("content", "is required for %s records", recordType)
+			return "", false
+		}
+		return *record.content, true
+	}
+	hostnameContent := func() {
+		if value, ok := content(); ok && !common.IsHostname(value) {
+			validationErrs.Add("content", "%q is not a valid host name", value)
+		}
+	}
+	data := dnsRecordData{fields: record.data, validationErrs: &validationErrs}
+
+	switch recordType {
+	case CreateDnsRecordOptions_Type_A:
+		if value, ok := content(); ok && !common.IsIPv4Address(value) {
+			validationErrs.Add("content", "%q is not an IPv4 address", value)
+		}
+	case CreateDnsRecordOptions_Type_Aaaa:
+		if value, ok := content(); ok && !common.IsIPv6Address(value) {
+			validationErrs.Add("content", "%q is not an IPv6 address", value)
+		}
+	case CreateDnsRecordOptions_Type_Cname:
+		hostnameContent()
+		if isApexName(record.name, zoneName) {
+			validationErrs.Add("name", "CNAME records are not allowed at the zone apex")
+		}
+	case CreateDnsRecordOptions_Type_Ns, CreateDnsRecordOptions_Type_Ptr:
+		hostnameContent()
+	case CreateDnsRecordOptions_Type_Mx:
+		hostnameContent()
+		if record.priority == nil {
+			validationErrs.Add("priority", "is required for MX records")
+		} else if *record.priority < 0 || *record.priority > math.MaxUint16 {
+			validationErrs.Add("priority", "must be between 0 and %d, got %d", math.MaxUint16, *record.priority)
+		}
+	case CreateDnsRecordOptions_Type_Txt:
+		if value, ok := content(); ok {
+			for _, text := range common.TXTStrings(value) {
+				if len(text) > dnsRecordMaxTxtChars {
+					validationErrs.Add("content", "character strings must not be longer than %d characters, got %d", dnsRecordMaxTxtChars, len(text))
+				}
+			}
+		}
+	case CreateDnsRecordOptions_Type_Srv:
+		if data.required(recordType) {
+			if service := data.string("service"); service != nil && !strings.HasPrefix(*service, "_") {
+				validationErrs.Add("data.service", "%q must start with an underscore", *service)
+			}
+			if proto := data.string("proto"); proto != nil {
+				switch strings.ToLower(*proto) {
+				case "_tcp", "_udp", "_tls":
+				default:
+					validationErrs.Add("data.proto", "must be one of _tcp, _udp or _tls, got %q", *proto)
+				}
+			}
+			if name := data.string("name"); name != nil && *name != "@" && !common.IsHostname(*name) {
+				validationErrs.Add("data.name", "%q is not a valid DNS name", *name)
+			}
+			data.integer("priority", 0, math.MaxUint16)
+			data.integer("weight", 0, math.MaxUint16)
+			data.integer("port", 1, math.MaxUint16)
+			if target := data.string("target"); target != nil && *target != "." && !common.IsHostname(*target) {
+				validationErrs.Add("data.target", "%q is not a valid host name", *target)
+			}
+		}
+	case CreateDnsRecordOptions_Type_Caa:
+		if data.required(recordType) {
+			data.integer("flags", 0, math.MaxUint8)
+			if tag := data.string("tag"); tag != nil {
+				switch *tag {
+				case "issue", "issuewild", "iodef":
+				default:
+					validationErrs.Add("data.tag", "must be one of issue, issuewild or iodef, got %q", *tag)
+				}
+			}
+			data.string("value")
+		}
+	case CreateDnsRecordOptions_Type_Ds:
+		if data.required(recordType) {
+			data.integer("key_tag", 0, math.MaxUint16)
+			data.integer("algorithm", 0, math.MaxUint8)
+			data.integer("digest_type", 0, math.MaxUint8)
+			if digest := data.string("digest"); digest != nil {
+				if _, err := hex.DecodeString(*digest); err != nil {
+					validationErrs.Add("data.digest", "must be hexadecimal")
+				}
+			}
+		}
+	case CreateDnsRecordOptions_Type_Loc:
+		if data.required(recordType) {
+			data.integer("lat_degrees", 0, 90)
+			data.integer("lat_minutes", 0, 59)
+			data.number("lat_seconds", 0, 59.999)
+			data.oneOf("lat_direction", "N", "S")
+			data.integer("long_degrees", 0, 180)
+			data.integer("long_minutes", 0, 59)
+			data.number("long_seconds", 0, 59.999)
+			data.oneOf("long_direction", "E", "W")
+			data.number("altitude", -100000, 42849672.95)
+			data.number("size", 0, 90000000)
+			data.number("precision_horz", 0, 90000000)
+			data.number("precision_vert", 0, 90000000)
+		}
+	default:
+		validationErrs.Add("type", "unsupported record type %q", *record.typeVar)
+	}
+	return
+}
+
+// isApexName returns true if "name" is the apex of the zone "zoneName".
+func isApexName(name *string, zoneName string) bool {
+	if name == nil {
+		return false
+	}
+	trimmed := strings.TrimSpace(*name)
+	if trimmed == "@" {
+		return true
+	}
+	return zoneName != "" && normalizeDnsName(trimmed) == normalizeDnsName(zoneName)
+}
+
+// dnsRecordData checks the "data" field of SRV, CAA, DS and LOC records.
+type dnsRecordData struct {
+	fields         map[string]interface{}
+	validationErrs *common.ValidationErrors
+}
+
+func (data dnsRecordData) required(recordType string) bool {
+	if len(data.fields) == 0 {
+		data.validationErrs.Add("data", "is required for %s records", recordType)
+		return false
+	}
+	return true
+}
+
+func (data dnsRecordData) string(key string) *string {
+	value, ok := data.fields[key].(string)
+	if !ok || value == "" {
+		data.validationErrs.Add("data."+key, "is required")
+		return nil
+	}
+	return &value
+}
+
+func (data dnsRecordData) oneOf(key string, values ...string) {
+	if value := data.string(key); value != nil {
+		for _, allowed := range values {
+			if strings.EqualFold(*value, allowed) {
+				return
+			}
+		}
+		data.validationErrs.Add("data."+key, "must be one of %s, got %q", strings.Join(values, ", "), *value)
+	}
+}
+
+func (data dnsRecordData) number(key string, minValue float64, maxValue float64) (float64, bool) {
+	var value float64
+	switch v := data.fields[key].(type) {
+	case nil:
+		data.validationErrs.Add("data."+key, "is required")
+		return 0, false
+	case int:
+		value = float64(v)
+	case int32:
+		value = float64(v)
+	case int64:
+		value = float64(v)
+	case float32:
+		value = float64(v)
+	case float64:
+		value = v
+	case json.Number:
+		parsed, err := v.Float64()
+		if err != nil {
+			data.validationErrs.Add("data."+key, "must be a number")
+			return 0, false
+		}
+		value = parsed
+	case string:
+		parsed, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			data.validationErrs.Add("data."+key, "must be a number")
+			return 0, false
+		}
+		value = parsed
+	default:
+		data.validationErrs.Add("data."+key, "must be a number")
+		return 0, false
+	}
+	if value < minValue || value > maxValue {
+		data.validationErrs.Add("data."+key, "must be between %s and %s, got %s", formatNumber(minValue), formatNumber(maxValue), formatNumber(value))
+		return value, false
+	}
+	return value, true
+}
+
+func (data dnsRecordData) integer(key string, minValue int64, maxValue int64) {
+	if value, ok := data.number(key, float64(minValue), float64(maxValue)); ok && value != math.Trunc(value) {
+		data.validationErrs.Add("data."+key, "must be an integer, got %s", formatNumber(value))
+	}
+}
+
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,128 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnsrecordsv1_test
+
+import (
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DnsRecordsV1 record validation`, func() {
+	fields := func(err error) map[string]string {
+		validationErrs, ok := common.AsValidationErrors(err)
+		Expect(ok).To(BeTrue())
+		result := map[string]string{}
+		for _, validationErr := range validationErrs {
+			result[validationErr.Field] = validationErr.Message
+		}
+		return result
+	}
+
+	Describe(`ValidateRecord(zoneName string)`, func() {
+		It(`Accept valid records of every type`, func() {
+			records := []dnsrecordsv1.DnsrecordInput{
+				{Name: core.StringPtr("www"), Type: core.StringPtr("A"), Content: core.StringPtr("192.0.2.1"), Proxied: core.BoolPtr(true), TTL: core.Int64Ptr(1)},
+				{Name: core.StringPtr("www"), Type: core.StringPtr("AAAA"), Content: core.StringPtr("2001:db8::1")},
+				{Name: core.StringPtr("app"), Type: core.StringPtr("CNAME"), Content: core.StringPtr("www.example.com")},
+				{Name: core.StringPtr("@"), Type: core.StringPtr("MX"), Content: core.StringPtr("mail.example.com"), Priority: core.Int64Ptr(10)},
+				{Name: core.StringPtr("sub"), Type: core.StringPtr("NS"), Content: core.StringPtr("ns1.example.net")},
+				{Name: core.StringPtr("1.2.0.192.in-addr.arpa"), Type: core.StringPtr("PTR"), Content: core.StringPtr("www.example.com")},
+				{Name: core.StringPtr("@"), Type: core.StringPtr("TXT"), Content: core.StringPtr(`"` + strings.Repeat("a", 255) + `" "b"`)},
+				{Type: core.StringPtr("SRV"), Data: map[string]interface{}{"service": "_sip", "proto": "_udp", "name": "example.com", "priority": 1, "weight": 1, "port": 5060, "target": "sip.example.com"}},
+				{Name: core.StringPtr("@"), Type: core.StringPtr("CAA"), Data: map[string]interface{}{"flags": 0, "tag": "issue", "value": "letsencrypt.org"}},
+				{Name: core.StringPtr("sub"), Type: core.StringPtr("DS"), Data: map[string]interface{}{"key_tag": 12345, "algorithm": 13, "digest_type": 2, "digest": "49FD46E6C4B45C55"}},
+				{Name: core.StringPtr("office"), Type: core.StringPtr("LOC"), Data: map[string]interface{}{
+					"lat_degrees": 52, "lat_minutes": 22, "lat_seconds": 23.0, "lat_direction": "N",
+					"long_degrees": 4, "long_minutes": 53, "long_seconds": 32.0, "long_direction": "E",
+					"altitude": -2.0, "size": 0, "precision_horz": 10000, "precision_vert": 10,
+				}},
+			}
+			for _, record := range records {
+				Expect(record.ValidateRecord("example.com")).To(BeNil(), *record.Type)
+			}
+		})
+		It(`Report field-level errors`, func() {
+			options := new(dnsrecordsv1.CreateDnsRecordOptions).
+				SetName("www").
+				SetType("AAAA").
+				SetContent("192.0.2.1").
+				SetTTL(100000)
+			Expect(fields(options.ValidateRecord(""))).To(Equal(map[string]string{
+				"content": `"192.0.2.1" is not an IPv6 address`,
+				"ttl":     "must be between 1 (automatic) and 86400, got 100000",
+			}))
+
+			options = new(dnsrecordsv1.CreateDnsRecordOptions).SetName("example.com").SetType("CNAME").SetContent("www.example.net")
+			Expect(fields(options.ValidateRecord("example.com"))).To(Equal(map[string]string{"name": "CNAME records are not allowed at the zone apex"}))
+			Expect(options.ValidateRecord("")).To(BeNil())
+
+			options = new(dnsrecordsv1.CreateDnsRecordOptions).SetName("@").SetType("MX").SetContent("mail.example.com").SetPriority(65536).SetProxied(true)
+			Expect(fields(options.ValidateRecord(""))).To(Equal(map[string]string{
+				"priority": "must be between 0 and 65535, got 65536",
+				"proxied":  "is only supported for A, AAAA and CNAME records",
+			}))
+
+			options = new(dnsrecordsv1.CreateDnsRecordOptions).SetType("SRV").SetData(map[string]interface{}{"service": "sip", "proto": "_udp", "name": "example.com", "priority": 1, "weight": 1, "target": "sip.example.com"})
+			Expect(fields(options.ValidateRecord(""))).To(Equal(map[string]string{
+				"data.service": `"sip" must start with an underscore`,
+				"data.port":    "is required",
+			}))
+
+			options = new(dnsrecordsv1.CreateDnsRecordOptions).SetName("@").SetType("TXT").SetContent(strings.Repeat("a", 256))
+			Expect(fields(options.ValidateRecord(""))).To(Equal(map[string]string{"content": "character strings must not be longer than 255 characters, got 256"}))
+
+			options = new(dnsrecordsv1.CreateDnsRecordOptions).SetName("@").SetType("CAA").SetData(map[string]interface{}{"flags": 0, "tag": "issues", "value": "ca.example.net"})
+			Expect(fields(options.ValidateRecord(""))).To(Equal(map[string]string{"data.tag": `must be one of issue, issuewild or iodef, got "issues"`}))
+
+			options = new(dnsrecordsv1.CreateDnsRecordOptions).SetName("www").SetType("HINFO")
+			Expect(fields(options.ValidateRecord(""))).To(Equal(map[string]string{"type": `unsupported record type "HINFO"`}))
+
+			Expect(fields(new(dnsrecordsv1.CreateDnsRecordOptions).ValidateRecord(""))).To(Equal(map[string]string{"type": "is required"}))
+		})
+		It(`Only check type-independent fields of untyped updates`, func() {
+			options := new(dnsrecordsv1.UpdateDnsRecordOptions).SetDnsrecordIdentifier("id").SetTTL(0)
+			Expect(fields(options.ValidateRecord(""))).To(Equal(map[string]string{"ttl": "must be between 1 (automatic) and 86400, got 0"}))
+			Expect(options.SetTTL(120).ValidateRecord("")).To(BeNil())
+		})
+	})
+	Describe(`ValidateRecords(zoneName string)`, func() {
+		It(`Prefix errors with the position of the record in the batch`, func() {
+			options := new(dnsrecordsv1.BatchDnsRecordsOptions).
+				SetPosts([]dnsrecordsv1.DnsrecordInput{
+					{Name: core.StringPtr("www"), Type: core.StringPtr("A"), Content: core.StringPtr("192.0.2.1")},
+					{Name: core.StringPtr("api"), Type: core.StringPtr("A"), Content: core.StringPtr("2001:db8::1")},
+				}).
+				SetPuts([]dnsrecordsv1.BatchDnsRecordsRequestPutsItem{
+					{ID: core.StringPtr("id"), Name: core.StringPtr("@"), Type: core.StringPtr("MX"), TTL: core.Int64Ptr(300), Content: core.StringPtr("mail.example.com")},
+				}).
+				SetPatches([]dnsrecordsv1.BatchDnsRecordsRequestPatchesItem{
+					{ID: core.StringPtr("id"), Content: core.StringPtr("anything")},
+				})
+			err := options.ValidateRecords("example.com")
+			Expect(fields(err)).To(Equal(map[string]string{
+				"posts[1].content": `"2001:db8::1" is not an IPv4 address`,
+				"puts[0].priority": "is required for MX records",
+			}))
+			Expect(err.Error()).To(Equal(`posts[1].content: "2001:db8::1" is not an IPv4 address; puts[0].priority: is required for MX records`))
+		})
+	})
+})
@@ -0,0 +1,474 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnsregistry
+
+import (
+	"context"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+)
+
+// DnsRecordOwners returns the owners recorded by the ownership records among
+// the CIS records "records".
+func (registry *Registry) DnsRecordOwners(records []dnsrecordsv1.DnsrecordDetails) Owners {
+	owners := Owners{}
+	for _, record := range records {
+		if record.Type != nil && *record.Type == dnsrecordsv1.DnsrecordDetails_Type_Txt && record.Name != nil && record.Content != nil {
+			registry.addOwnershipRecord(owners, *record.Name, *record.Content)
+		}
+	}
+	return owners
+}
+
+// OwnedDnsRecords returns an Owned function for ReconcileDnsRecordsOptions
+// that claims the managed records owned by the registry, according to the
+// ownership records among "current", and the registry's own ownership
+// records. Other records are claimed only if "owned" claims them; "owned" may
+// be nil.
+func (registry *Registry) OwnedDnsRecords(current []dnsrecordsv1.DnsrecordDetails, owned func(record dnsrecordsv1.DnsrecordDetails) bool) func(record dnsrecordsv1.DnsrecordDetails) bool {
+	owners := registry.DnsRecordOwners(current)
+	return func(record dnsrecordsv1.DnsrecordDetails) bool {
+		if record.Type == nil || record.Name == nil {
+			return false
+		}
+		if registry.IsManagedType(*record.Type) {
+			owner, ok := owners.Owner(*record.Type, *record.Name)
+			return ok && owner == registry.OwnerID
+		}
+		if *record.Type == dnsrecordsv1.DnsrecordDetails_Type_Txt {
+			if _, _, ok := registry.parseOwnershipName(*record.Name); ok {
+				return record.Content != nil && registry.isOwnOwnershipRecord(*record.Name, *record.Content)
+			}
+		}
+		return owned != nil && owned(record)
+	}
+}
+
+// DnsOwnershipRecords returns the ownership records for the managed records
+// among "desired", one for each type and name. Relative names are qualified
+// with "zoneName".
+func (registry *Registry) DnsOwnershipRecords(desired []dnsrecordsv1.DnsrecordInput, zoneName string) (ownershipRecords []dnsrecordsv1.DnsrecordInput) {
+	seen := map[string]bool{}
+	for _, record := range desired {
+		if record.Type == nil || !registry.IsManagedType(*record.Type) {
+			continue
+		}
+		name := ""
+		if record.Name != nil {
+			name = *record.Name
+		}
+		name = qualifyName(name, zoneName)
+		key := ownersKey(*record.Type, name)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		ownershipRecords = append(ownershipRecords, registry.dnsOwnershipRecord(*record.Type, name))
+	}
+	return
+}
+
+func (registry *Registry) dnsOwnershipRecord(recordType string, name string) dnsrecordsv1.DnsrecordInput {
+	return dnsrecordsv1.DnsrecordInput{
+		Name:    core.StringPtr(registry.OwnershipName(recordType, name)),
+		Type:    core.StringPtr(dnsrecordsv1.DnsrecordInput_Type_Txt),
+		TTL:     core.Int64Ptr(1),
+		Content: core.StringPtr(registry.OwnershipContent()),
+	}
+}
+
+// PlanDnsRecords computes the changes that turn "current" into the desired
+// state described by "reconcileDnsRecordsOptions", as
+// dnsrecordsv1.ComputeDnsRecordsPlan does, while keeping the ownership records
+// of the desired managed records in step and leaving records owned by others
+// alone. It returns an OwnershipError if a desired managed record has a type
+// and name that another owner holds, or that existing records without an
+// owner use.
+func (registry *Registry) PlanDnsRecords(current []dnsrecordsv1.DnsrecordDetails, reconcileDnsRecordsOptions *dnsrecordsv1.ReconcileDnsRecordsOptions) (plan *dnsrecordsv1.DnsRecordsPlan, err error) {
+	err = core.ValidateNotNil(reconcileDnsRecordsOptions, "reconcileDnsRecordsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+
+	zoneName := ""
+	if reconcileDnsRecordsOptions.ZoneName != nil {
+		zoneName = *reconcileDnsRecordsOptions.ZoneName
+	} else {
+		for _, record := range current {
+			if record.ZoneName != nil {
+				zoneName = *record.ZoneName
+				break
+			}
+		}
+	}
+
+	owners := registry.DnsRecordOwners(current)
+	exists := map[string]bool{}
+	for _, record := range current {
+		if record.Type != nil && record.Name != nil {
+			exists[ownersKey(*record.Type, *record.Name)] = true
+		}
+	}
+	for _, record := range reconcileDnsRecordsOptions.Desired {
+		if record.Type == nil {
+			continue
+		}
+		name := ""
+		if record.Name != nil {
+			name = *record.Name
+		}
+		name = qualifyName(name, zoneName)
+		if err = registry.check(owners, *record.Type, name, exists[ownersKey(*record.Type, name)]); err != nil {
+			return
+		}
+	}
+
+	options := *reconcileDnsRecordsOptions
+	options.Desired = append(append([]dnsrecordsv1.DnsrecordInput{}, reconcileDnsRecordsOptions.Desired...), registry.DnsOwnershipRecords(reconcileDnsRecordsOptions.Desired, zoneName)...)
+	options.Owned = registry.OwnedDnsRecords(current, reconcileDnsRecordsOptions.Owned)
+	if len(options.Types) > 0 {
+		options.Types = append(append([]string{}, options.Types...), dnsrecordsv1.DnsrecordInput_Type_Txt)
+	}
+
+	plan, err = dnsrecordsv1.ComputeDnsRecordsPlan(current, &options)
+	return
+}
+
+// ReconcileDnsRecords : Reconcile owned DNS records
+// Bring the records of the zone owned by the registry to the desired state, like DnsRecordsV1.ReconcileDnsRecords,
+// creating and deleting the ownership records alongside the managed records in the same BatchDnsRecords request.
+func (registry *Registry) ReconcileDnsRecords(dnsRecords *dnsrecordsv1.DnsRecordsV1, reconcileDnsRecordsOptions *dnsrecordsv1.ReconcileDnsRecordsOptions) (plan *dnsrecordsv1.DnsRecordsPlan, result *dnsrecordsv1.BatchDnsRecordsResponse, response *core.DetailedResponse, err error) {
+	plan, result, response, err = registry.ReconcileDnsRecordsWithContext(context.Background(), dnsRecords, reconcileDnsRecordsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ReconcileDnsRecordsWithContext is an alternate form of the ReconcileDnsRecords method which supports a Context parameter
+func (registry *Registry) ReconcileDnsRecordsWithContext(ctx context.Context, dnsRecords *dnsrecordsv1.DnsRecordsV1, reconcileDnsRecordsOptions *dnsrecordsv1.ReconcileDnsRecordsOptions) (plan *dnsrecordsv1.DnsRecordsPlan, result *dnsrecordsv1.BatchDnsRecordsResponse, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(reconcileDnsRecordsOptions, "reconcileDnsRecordsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+
+	current, err := listDnsRecords(ctx, dnsRecords, "", "", reconcileDnsRecordsOptions.Headers)
+	if err != nil {
+		return
+	}
+	plan, err = registry.PlanDnsRecords(current, reconcileDnsRecordsOptions)
+	if err != nil {
+		return
+	}
+	if (reconcileDnsRecordsOptions.DryRun != nil && *reconcileDnsRecordsOptions.DryRun) || !plan.HasChanges() {
+		return
+	}
+
+	batchOptions := plan.BatchDnsRecordsOptions()
+	batchOptions.Headers = reconcileDnsRecordsOptions.Headers
+	result, response, err = dnsRecords.BatchDnsRecordsWithContext(ctx, batchOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "apply-error")
+	}
+	return
+}
+
+// CreateDnsRecord : Create an owned DNS record
+// Create a DNS record, together with its ownership record if the registry does not hold one yet. Records of a managed
+// type are only created if the registry owns their type and name, or if no record of that type and name exists. The
+// name of the record must be fully qualified.
+func (registry *Registry) CreateDnsRecord(dnsRecords *dnsrecordsv1.DnsRecordsV1, createDnsRecordOptions *dnsrecordsv1.CreateDnsRecordOptions) (result *dnsrecordsv1.DnsrecordDetails, response *core.DetailedResponse, err error) {
+	result, response, err = registry.CreateDnsRecordWithContext(context.Background(), dnsRecords, createDnsRecordOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateDnsRecordWithContext is an alternate form of the CreateDnsRecord method which supports a Context parameter
+func (registry *Registry) CreateDnsRecordWithContext(ctx context.Context, dnsRecords *dnsrecordsv1.DnsRecordsV1, createDnsRecordOptions *dnsrecordsv1.CreateDnsRecordOptions) (result *dnsrecordsv1.DnsrecordDetails, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createDnsRecordOptions, "createDnsRecordOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if createDnsRecordOptions.Type == nil || createDnsRecordOptions.Name == nil || !registry.IsManagedType(*createDnsRecordOptions.Type) {
+		var resp *dnsrecordsv1.DnsrecordResp
+		resp, response, err = dnsRecords.CreateDnsRecordWithContext(ctx, createDnsRecordOptions)
+		if resp != nil {
+			result = resp.Result
+		}
+		return
+	}
+
+	claim, err := registry.claimDnsRecords(ctx, dnsRecords, *createDnsRecordOptions.Type, *createDnsRecordOptions.Name, createDnsRecordOptions.Headers)
+	if err != nil {
+		return
+	}
+	batchOptions := &dnsrecordsv1.BatchDnsRecordsOptions{
+		Posts: []dnsrecordsv1.DnsrecordInput{{
+			Name:     createDnsRecordOptions.Name,
+			Type:     createDnsRecordOptions.Type,
+			TTL:      createDnsRecordOptions.TTL,
+			Content:  createDnsRecordOptions.Content,
+			Priority: createDnsRecordOptions.Priority,
+			Proxied:  createDnsRecordOptions.Proxied,
+			Data:     createDnsRecordOptions.Data,
+		}},
+		Headers: createDnsRecordOptions.Headers,
+	}
+	if claim.ownershipRecord == nil {
+		batchOptions.Posts = append(batchOptions.Posts, registry.dnsOwnershipRecord(*createDnsRecordOptions.Type, *createDnsRecordOptions.Name))
+	}
+	batchResult, response, err := dnsRecords.BatchDnsRecordsWithContext(ctx, batchOptions)
+	if err != nil {
+		return
+	}
+	if batchResult != nil && batchResult.Result != nil && len(batchResult.Result.Posts) > 0 {
+		result = batchDnsRecordDetails(batchResult.Result.Posts[0])
+	}
+	return
+}
+
+// UpdateDnsRecord : Update an owned DNS record
+// Update a DNS record owned by the registry. When the type or name of a managed record changes, the new type and name
+// are claimed and the old ownership record is deleted once no record of the old type and name is left. The name of
+// the record must be fully qualified.
+func (registry *Registry) UpdateDnsRecord(dnsRecords *dnsrecordsv1.DnsRecordsV1, updateDnsRecordOptions *dnsrecordsv1.UpdateDnsRecordOptions) (result *dnsrecordsv1.DnsrecordDetails, response *core.DetailedResponse, err error) {
+	result, response, err = registry.UpdateDnsRecordWithContext(context.Background(), dnsRecords, updateDnsRecordOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpdateDnsRecordWithContext is an alternate form of the UpdateDnsRecord method which supports a Context parameter
+func (registry *Registry) UpdateDnsRecordWithContext(ctx context.Context, dnsRecords *dnsrecordsv1.DnsRecordsV1, updateDnsRecordOptions *dnsrecordsv1.UpdateDnsRecordOptions) (result *dnsrecordsv1.DnsrecordDetails, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(updateDnsRecordOptions, "updateDnsRecordOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(updateDnsRecordOptions, "updateDnsRecordOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	current, response, err := getDnsRecord(ctx, dnsRecords, *updateDnsRecordOptions.DnsrecordIdentifier, updateDnsRecordOptions.Headers)
+	if err != nil {
+		return
+	}
+	currentType, currentName := *current.Type, *current.Name
+	newType, newName := currentType, currentName
+	if updateDnsRecordOptions.Type != nil {
+		newType = *updateDnsRecordOptions.Type
+	}
+	if updateDnsRecordOptions.Name != nil {
+		newName = *updateDnsRecordOptions.Name
+	}
+
+	batchOptions := &dnsrecordsv1.BatchDnsRecordsOptions{
+		Puts: []dnsrecordsv1.BatchDnsRecordsRequestPutsItem{{
+			ID:       current.ID,
+			Name:     core.StringPtr(newName),
+			Type:     core.StringPtr(newType),
+			TTL:      updateDnsRecordOptions.TTL,
+			Content:  updateDnsRecordOptions.Content,
+			Priority: updateDnsRecordOptions.Priority,
+			Proxied:  updateDnsRecordOptions.Proxied,
+			Data:     updateDnsRecordOptions.Data,
+		}},
+		Headers: updateDnsRecordOptions.Headers,
+	}
+	if batchOptions.Puts[0].TTL == nil {
+		batchOptions.Puts[0].TTL = current.TTL
+	}
+	if batchOptions.Puts[0].Content == nil {
+		batchOptions.Puts[0].Content = current.Content
+	}
+	if batchOptions.Puts[0].Priority == nil {
+		batchOptions.Puts[0].Priority = current.Priority
+	}
+	if batchOptions.Puts[0].Proxied == nil {
+		batchOptions.Puts[0].Proxied = current.Proxied
+	}
+	if batchOptions.Puts[0].Data == nil {
+		batchOptions.Puts[0].Data = current.Data
+	}
+
+	if registry.IsManagedType(currentType) {
+		var claim *dnsRecordsClaim
+		claim, err = registry.claimDnsRecords(ctx, dnsRecords, currentType, currentName, updateDnsRecordOptions.Headers)
+		if err != nil {
+			return
+		}
+		if ownersKey(currentType, currentName) != ownersKey(newType, newName) && claim.ownershipRecord != nil && len(claim.records) == 1 {
+			batchOptions.Deletes = append(batchOptions.Deletes, dnsrecordsv1.BatchDnsRecordsRequestDeletesItem{ID: claim.ownershipRecord.ID})
+		}
+	}
+	if registry.IsManagedType(newType) && ownersKey(currentType, currentName) != ownersKey(newType, newName) {
+		var claim *dnsRecordsClaim
+		claim, err = registry.claimDnsRecords(ctx, dnsRecords, newType, newName, updateDnsRecordOptions.Headers)
+		if err != nil {
+			return
+		}
+		if claim.ownershipRecord == nil {
+			batchOptions.Posts = append(batchOptions.Posts, registry.dnsOwnershipRecord(newType, newName))
+		}
+	}
+
+	batchResult, response, err := dnsRecords.BatchDnsRecordsWithContext(ctx, batchOptions)
+	if err != nil {
+		return
+	}
+	if batchResult != nil && batchResult.Result != nil && len(batchResult.Result.Puts) > 0 {
+		result = batchDnsRecordDetails(batchResult.Result.Puts[0])
+	}
+	return
+}
+
+// DeleteDnsRecord : Delete an owned DNS record
+// Delete a DNS record owned by the registry, together with its ownership record when it is the last record of its
+// type and name.
+func (registry *Registry) DeleteDnsRecord(dnsRecords *dnsrecordsv1.DnsRecordsV1, deleteDnsRecordOptions *dnsrecordsv1.DeleteDnsRecordOptions) (response *core.DetailedResponse, err error) {
+	response, err = registry.DeleteDnsRecordWithContext(context.Background(), dnsRecords, deleteDnsRecordOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteDnsRecordWithContext is an alternate form of the DeleteDnsRecord method which supports a Context parameter
+func (registry *Registry) DeleteDnsRecordWithContext(ctx context.Context, dnsRecords *dnsrecordsv1.DnsRecordsV1, deleteDnsRecordOptions *dnsrecordsv1.DeleteDnsRecordOptions) (response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(deleteDnsRecordOptions, "deleteDnsRecordOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(deleteDnsRecordOptions, "deleteDnsRecordOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	current, response, err := getDnsRecord(ctx, dnsRecords, *deleteDnsRecordOptions.DnsrecordIdentifier, deleteDnsRecordOptions.Headers)
+	if err != nil {
+		return
+	}
+	batchOptions := &dnsrecordsv1.BatchDnsRecordsOptions{
+		Deletes: []dnsrecordsv1.BatchDnsRecordsRequestDeletesItem{{ID: current.ID}},
+		Headers: deleteDnsRecordOptions.Headers,
+	}
+	if registry.IsManagedType(*current.Type) {
+		var claim *dnsRecordsClaim
+		claim, err = registry.claimDnsRecords(ctx, dnsRecords, *current.Type, *current.Name, deleteDnsRecordOptions.Headers)
+		if err != nil {
+			return
+		}
+		if claim.ownershipRecord != nil && len(claim.records) == 1 {
+			batchOptions.Deletes = append(batchOptions.Deletes, dnsrecordsv1.BatchDnsRecordsRequestDeletesItem{ID: claim.ownershipRecord.ID})
+		}
+	}
+
+	_, response, err = dnsRecords.BatchDnsRecordsWithContext(ctx, batchOptions)
+	return
+}
+
+// dnsRecordsClaim holds the records of one type and name, and the registry's
+// ownership record for them.
+type dnsRecordsClaim struct {
+	records         []dnsrecordsv1.DnsrecordDetails
+	ownershipRecord *dnsrecordsv1.DnsrecordDetails
+}
+
+// claimDnsRecords lists the records of type "recordType" named "name" and
+// their ownership records, and returns an OwnershipError unless the registry
+// may change them.
+func (registry *Registry) claimDnsRecords(ctx context.Context, dnsRecords *dnsrecordsv1.DnsRecordsV1, recordType string, name string, headers map[string]string) (claim *dnsRecordsClaim, err error) {
+	claim = &dnsRecordsClaim{}
+	claim.records, err = listDnsRecords(ctx, dnsRecords, strings.ToUpper(recordType), normalizeName(name), headers)
+	if err != nil {
+		return
+	}
+	ownershipRecords, err := listDnsRecords(ctx, dnsRecords, dnsrecordsv1.DnsrecordDetails_Type_Txt, registry.OwnershipName(recordType, name), headers)
+	if err != nil {
+		return
+	}
+	if err = registry.check(registry.DnsRecordOwners(ownershipRecords), recordType, name, len(claim.records) > 0); err != nil {
+		return
+	}
+	for i, record := range ownershipRecords {
+		if record.Content != nil && registry.isOwnOwnershipRecord(*record.Name, *record.Content) {
+			claim.ownershipRecord = &ownershipRecords[i]
+			break
+		}
+	}
+	return
+}
+
+func listDnsRecords(ctx context.Context, dnsRecords *dnsrecordsv1.DnsRecordsV1, recordType string, name string, headers map[string]string) (records []dnsrecordsv1.DnsrecordDetails, err error) {
+	listOptions := &dnsrecordsv1.ListAllDnsRecordsOptions{
+		PerPage: core.Int64Ptr(int64(1000)),
+		Headers: headers,
+	}
+	if recordType != "" {
+		listOptions.Type = core.StringPtr(recordType)
+	}
+	if name != "" {
+		listOptions.Name = core.StringPtr(name)
+	}
+	pager, err := dnsRecords.NewDnsRecordsPager(listOptions)
+	if err != nil {
+		return
+	}
+	records, err = pager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-error")
+	}
+	return
+}
+
+func getDnsRecord(ctx context.Context, dnsRecords *dnsrecordsv1.DnsRecordsV1, id string, headers map[string]string) (record *dnsrecordsv1.DnsrecordDetails, response *core.DetailedResponse, err error) {
+	result, response, err := dnsRecords.GetDnsRecordWithContext(ctx, &dnsrecordsv1.GetDnsRecordOptions{
+		DnsrecordIdentifier: core.StringPtr(id),
+		Headers:             headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-error")
+		return
+	}
+	if result == nil || result.Result == nil || result.Result.Type == nil || result.Result.Name == nil {
+		err = core.SDKErrorf(nil, "the DNS record "+id+" has no type or name", "missing-record", common.GetComponentInfo())
+		return
+	}
+	record = result.Result
+	return
+}
+
+func batchDnsRecordDetails(record dnsrecordsv1.BatchDnsRecordDetails) *dnsrecordsv1.DnsrecordDetails {
+	return &dnsrecordsv1.DnsrecordDetails{
+		ID:         record.ID,
+		CreatedOn:  record.CreatedOn,
+		ModifiedOn: record.ModifiedOn,
+		Name:       record.Name,
+		Type:       record.Type,
+		Content:    record.Content,
+		Proxiable:  record.Proxiable,
+		Proxied:    record.Proxied,
+		TTL:        record.TTL,
+		Priority:   record.Priority,
+		Data:       record.Data,
+	}
+}
@@ -0,0 +1,349 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnsregistry
+
+import (
+	"context"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+)
+
+// ownershipResourceRecordTTL is the TTL of the ownership records written to
+// DNS Services zones, which have no automatic TTL.
+const ownershipResourceRecordTTL = 300
+
+// ResourceRecordOwners returns the owners recorded by the ownership records
+// among the DNS Services resource records "records".
+func (registry *Registry) ResourceRecordOwners(records []dnssvcsv1.ResourceRecord) Owners {
+	owners := Owners{}
+	for _, record := range records {
+		if record.Type != nil && *record.Type == dnssvcsv1.ResourceRecord_Type_Txt && record.Name != nil {
+			registry.addOwnershipRecord(owners, *record.Name, resourceRecordText(record))
+		}
+	}
+	return owners
+}
+
+// OwnedResourceRecords returns an Owned function, e.g. for
+// dnssync.SyncOptions, that claims the managed resource records owned by the
+// registry, according to the ownership records among "current", and the
+// registry's own ownership records. Other records are claimed only if "owned"
+// claims them; "owned" may be nil.
+func (registry *Registry) OwnedResourceRecords(current []dnssvcsv1.ResourceRecord, owned func(record dnssvcsv1.ResourceRecord) bool) func(record dnssvcsv1.ResourceRecord) bool {
+	owners := registry.ResourceRecordOwners(current)
+	return func(record dnssvcsv1.ResourceRecord) bool {
+		if record.Type == nil || record.Name == nil {
+			return false
+		}
+		if registry.IsManagedType(*record.Type) {
+			owner, ok := owners.Owner(*record.Type, *record.Name)
+			return ok && owner == registry.OwnerID
+		}
+		if *record.Type == dnssvcsv1.ResourceRecord_Type_Txt {
+			if _, _, ok := registry.parseOwnershipName(*record.Name); ok {
+				return registry.isOwnOwnershipRecord(*record.Name, resourceRecordText(record))
+			}
+		}
+		return owned != nil && owned(record)
+	}
+}
+
+// CreateResourceRecord : Create an owned resource record
+// Create a resource record, after creating its ownership record if the registry does not hold one yet. Records of a
+// managed type are only created if the registry owns their type and name, or if no record of that type and name
+// exists. The name of the record must be fully qualified.
+func (registry *Registry) CreateResourceRecord(dnsSvcs *dnssvcsv1.DnsSvcsV1, createResourceRecordOptions *dnssvcsv1.CreateResourceRecordOptions) (result *dnssvcsv1.ResourceRecord, response *core.DetailedResponse, err error) {
+	result, response, err = registry.CreateResourceRecordWithContext(context.Background(), dnsSvcs, createResourceRecordOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateResourceRecordWithContext is an alternate form of the CreateResourceRecord method which supports a Context parameter
+func (registry *Registry) CreateResourceRecordWithContext(ctx context.Context, dnsSvcs *dnssvcsv1.DnsSvcsV1, createResourceRecordOptions *dnssvcsv1.CreateResourceRecordOptions) (result *dnssvcsv1.ResourceRecord, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createResourceRecordOptions, "createResourceRecordOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(createResourceRecordOptions, "createResourceRecordOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	if createResourceRecordOptions.Name == nil || !registry.IsManagedType(*createResourceRecordOptions.Type) {
+		return dnsSvcs.CreateResourceRecordWithContext(ctx, createResourceRecordOptions)
+	}
+
+	zone := resourceRecordZone{
+		instanceID:     *createResourceRecordOptions.InstanceID,
+		dnszoneID:      *createResourceRecordOptions.DnszoneID,
+		xCorrelationID: createResourceRecordOptions.XCorrelationID,
+		headers:        createResourceRecordOptions.Headers,
+	}
+	claim, err := registry.claimResourceRecords(ctx, dnsSvcs, zone, *createResourceRecordOptions.Type, *createResourceRecordOptions.Name)
+	if err != nil {
+		return
+	}
+	var ownershipRecord *dnssvcsv1.ResourceRecord
+	if claim.ownershipRecord == nil {
+		ownershipRecord, response, err = registry.createOwnershipResourceRecord(ctx, dnsSvcs, zone, *createResourceRecordOptions.Type, *createResourceRecordOptions.Name)
+		if err != nil {
+			return
+		}
+	}
+
+	result, response, err = dnsSvcs.CreateResourceRecordWithContext(ctx, createResourceRecordOptions)
+	if err != nil && ownershipRecord != nil {
+		// Give the type and name up again rather than hold on to it without
+		// any record.
+		_, _ = dnsSvcs.DeleteResourceRecordWithContext(ctx, zone.deleteOptions(*ownershipRecord.ID))
+	}
+	return
+}
+
+// UpdateResourceRecord : Update an owned resource record
+// Update a resource record owned by the registry. When the name of a managed record changes, the new name is claimed
+// and the old ownership record is deleted once no record of the old type and name is left. The name of the record
+// must be fully qualified.
+func (registry *Registry) UpdateResourceRecord(dnsSvcs *dnssvcsv1.DnsSvcsV1, updateResourceRecordOptions *dnssvcsv1.UpdateResourceRecordOptions) (result *dnssvcsv1.ResourceRecord, response *core.DetailedResponse, err error) {
+	result, response, err = registry.UpdateResourceRecordWithContext(context.Background(), dnsSvcs, updateResourceRecordOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpdateResourceRecordWithContext is an alternate form of the UpdateResourceRecord method which supports a Context parameter
+func (registry *Registry) UpdateResourceRecordWithContext(ctx context.Context, dnsSvcs *dnssvcsv1.DnsSvcsV1, updateResourceRecordOptions *dnssvcsv1.UpdateResourceRecordOptions) (result *dnssvcsv1.ResourceRecord, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(updateResourceRecordOptions, "updateResourceRecordOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(updateResourceRecordOptions, "updateResourceRecordOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	zone := resourceRecordZone{
+		instanceID:     *updateResourceRecordOptions.InstanceID,
+		dnszoneID:      *updateResourceRecordOptions.DnszoneID,
+		xCorrelationID: updateResourceRecordOptions.XCorrelationID,
+		headers:        updateResourceRecordOptions.Headers,
+	}
+	current, response, err := zone.get(ctx, dnsSvcs, *updateResourceRecordOptions.RecordID)
+	if err != nil || !registry.IsManagedType(*current.Type) {
+		if err == nil {
+			result, response, err = dnsSvcs.UpdateResourceRecordWithContext(ctx, updateResourceRecordOptions)
+		}
+		return
+	}
+
+	recordType := *current.Type
+	claim, err := registry.claimResourceRecords(ctx, dnsSvcs, zone, recordType, *current.Name)
+	if err != nil {
+		return
+	}
+	renamed := ownersKey(recordType, *current.Name) != ownersKey(recordType, *updateResourceRecordOptions.Name)
+	if renamed {
+		var newClaim *resourceRecordsClaim
+		newClaim, err = registry.claimResourceRecords(ctx, dnsSvcs, zone, recordType, *updateResourceRecordOptions.Name)
+		if err != nil {
+			return
+		}
+		if newClaim.ownershipRecord == nil {
+			_, response, err = registry.createOwnershipResourceRecord(ctx, dnsSvcs, zone, recordType, *updateResourceRecordOptions.Name)
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	result, response, err = dnsSvcs.UpdateResourceRecordWithContext(ctx, updateResourceRecordOptions)
+	if err != nil {
+		return
+	}
+	if renamed && claim.ownershipRecord != nil && len(claim.records) == 1 {
+		response, err = dnsSvcs.DeleteResourceRecordWithContext(ctx, zone.deleteOptions(*claim.ownershipRecord.ID))
+	}
+	return
+}
+
+// DeleteResourceRecord : Delete an owned resource record
+// Delete a resource record owned by the registry, and then its ownership record when it was the last record of its
+// type and name.
+func (registry *Registry) DeleteResourceRecord(dnsSvcs *dnssvcsv1.DnsSvcsV1, deleteResourceRecordOptions *dnssvcsv1.DeleteResourceRecordOptions) (response *core.DetailedResponse, err error) {
+	response, err = registry.DeleteResourceRecordWithContext(context.Background(), dnsSvcs, deleteResourceRecordOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteResourceRecordWithContext is an alternate form of the DeleteResourceRecord method which supports a Context parameter
+func (registry *Registry) DeleteResourceRecordWithContext(ctx context.Context, dnsSvcs *dnssvcsv1.DnsSvcsV1, deleteResourceRecordOptions *dnssvcsv1.DeleteResourceRecordOptions) (response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(deleteResourceRecordOptions, "deleteResourceRecordOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(deleteResourceRecordOptions, "deleteResourceRecordOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	zone := resourceRecordZone{
+		instanceID:     *deleteResourceRecordOptions.InstanceID,
+		dnszoneID:      *deleteResourceRecordOptions.DnszoneID,
+		xCorrelationID: deleteResourceRecordOptions.XCorrelationID,
+		headers:        deleteResourceRecordOptions.Headers,
+	}
+	current, response, err := zone.get(ctx, dnsSvcs, *deleteResourceRecordOptions.RecordID)
+	if err != nil || !registry.IsManagedType(*current.Type) {
+		if err == nil {
+			response, err = dnsSvcs.DeleteResourceRecordWithContext(ctx, deleteResourceRecordOptions)
+		}
+		return
+	}
+
+	claim, err := registry.claimResourceRecords(ctx, dnsSvcs, zone, *current.Type, *current.Name)
+	if err != nil {
+		return
+	}
+	response, err = dnsSvcs.DeleteResourceRecordWithContext(ctx, deleteResourceRecordOptions)
+	if err != nil {
+		return
+	}
+	if claim.ownershipRecord != nil && len(claim.records) == 1 {
+		response, err = dnsSvcs.DeleteResourceRecordWithContext(ctx, zone.deleteOptions(*claim.ownershipRecord.ID))
+	}
+	return
+}
+
+// resourceRecordZone holds the zone and request headers shared by the
+// requests of one guarded operation.
+type resourceRecordZone struct {
+	instanceID     string
+	dnszoneID      string
+	xCorrelationID *string
+	headers        map[string]string
+}
+
+func (zone resourceRecordZone) list(ctx context.Context, dnsSvcs *dnssvcsv1.DnsSvcsV1, recordType string, name string) (records []dnssvcsv1.ResourceRecord, err error) {
+	pager, err := dnsSvcs.NewResourceRecordsPager(&dnssvcsv1.ListResourceRecordsOptions{
+		InstanceID:     core.StringPtr(zone.instanceID),
+		DnszoneID:      core.StringPtr(zone.dnszoneID),
+		XCorrelationID: zone.xCorrelationID,
+		Type:           core.StringPtr(recordType),
+		Name:           core.StringPtr(name),
+		Headers:        zone.headers,
+	})
+	if err != nil {
+		return
+	}
+	all, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-error")
+		return
+	}
+	// Keep to the exact type and name, whatever the filters match.
+	for _, record := range all {
+		if record.Type != nil && record.Name != nil && ownersKey(*record.Type, *record.Name) == ownersKey(recordType, name) {
+			records = append(records, record)
+		}
+	}
+	return
+}
+
+func (zone resourceRecordZone) get(ctx context.Context, dnsSvcs *dnssvcsv1.DnsSvcsV1, recordID string) (record *dnssvcsv1.ResourceRecord, response *core.DetailedResponse, err error) {
+	record, response, err = dnsSvcs.GetResourceRecordWithContext(ctx, &dnssvcsv1.GetResourceRecordOptions{
+		InstanceID:     core.StringPtr(zone.instanceID),
+		DnszoneID:      core.StringPtr(zone.dnszoneID),
+		RecordID:       core.StringPtr(recordID),
+		XCorrelationID: zone.xCorrelationID,
+		Headers:        zone.headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-error")
+		return
+	}
+	if record == nil || record.Type == nil || record.Name == nil {
+		err = core.SDKErrorf(nil, "the resource record "+recordID+" has no type or name", "missing-record", common.GetComponentInfo())
+	}
+	return
+}
+
+func (zone resourceRecordZone) deleteOptions(recordID string) *dnssvcsv1.DeleteResourceRecordOptions {
+	return &dnssvcsv1.DeleteResourceRecordOptions{
+		InstanceID:     core.StringPtr(zone.instanceID),
+		DnszoneID:      core.StringPtr(zone.dnszoneID),
+		RecordID:       core.StringPtr(recordID),
+		XCorrelationID: zone.xCorrelationID,
+		Headers:        zone.headers,
+	}
+}
+
+// resourceRecordsClaim holds the resource records of one type and name, and
+// the registry's ownership record for them.
+type resourceRecordsClaim struct {
+	records         []dnssvcsv1.ResourceRecord
+	ownershipRecord *dnssvcsv1.ResourceRecord
+}
+
+// claimResourceRecords lists the resource records of type "recordType" named
+// "name" and their ownership records, and returns an OwnershipError unless the
+// registry may change them.
+func (registry *Registry) claimResourceRecords(ctx context.Context, dnsSvcs *dnssvcsv1.DnsSvcsV1, zone resourceRecordZone, recordType string, name string) (claim *resourceRecordsClaim, err error) {
+	claim = &resourceRecordsClaim{}
+	claim.records, err = zone.list(ctx, dnsSvcs, strings.ToUpper(recordType), normalizeName(name))
+	if err != nil {
+		return
+	}
+	ownershipRecords, err := zone.list(ctx, dnsSvcs, dnssvcsv1.ResourceRecord_Type_Txt, registry.OwnershipName(recordType, name))
+	if err != nil {
+		return
+	}
+	if err = registry.check(registry.ResourceRecordOwners(ownershipRecords), recordType, name, len(claim.records) > 0); err != nil {
+		return
+	}
+	for i, record := range ownershipRecords {
+		if registry.isOwnOwnershipRecord(*record.Name, resourceRecordText(record)) {
+			claim.ownershipRecord = &ownershipRecords[i]
+			break
+		}
+	}
+	return
+}
+
+func (registry *Registry) createOwnershipResourceRecord(ctx context.Context, dnsSvcs *dnssvcsv1.DnsSvcsV1, zone resourceRecordZone, recordType string, name string) (record *dnssvcsv1.ResourceRecord, response *core.DetailedResponse, err error) {
+	return dnsSvcs.CreateResourceRecordWithContext(ctx, &dnssvcsv1.CreateResourceRecordOptions{
+		InstanceID:     core.StringPtr(zone.instanceID),
+		DnszoneID:      core.StringPtr(zone.dnszoneID),
+		Type:           core.StringPtr(dnssvcsv1.CreateResourceRecordOptions_Type_Txt),
+		Name:           core.StringPtr(registry.OwnershipName(recordType, name)),
+		Rdata:          &dnssvcsv1.ResourceRecordInputRdataRdataTxtRecord{Text: core.StringPtr(registry.OwnershipContent())},
+		TTL:            core.Int64Ptr(ownershipResourceRecordTTL),
+		XCorrelationID: zone.xCorrelationID,
+		Headers:        zone.headers,
+	})
+}
+
+func resourceRecordText(record dnssvcsv1.ResourceRecord) string {
+	text, _ := record.Rdata["text"].(string)
+	return text
+}
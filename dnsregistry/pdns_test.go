@@ -0,0 +1,152 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnsregistry
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/stretchr/testify/assert"
+)
+
+// privateZone is an in-memory DNS Services zone.
+type privateZone struct {
+	sync.Mutex
+	records map[string]map[string]interface{}
+	nextID  int
+}
+
+func (zone *privateZone) add(record map[string]interface{}) string {
+	zone.nextID++
+	id := fmt.Sprintf("r%d", zone.nextID)
+	record["id"] = id
+	zone.records[id] = record
+	return id
+}
+
+// names returns the type and name of every record of the zone.
+func (zone *privateZone) names() (names []string) {
+	for _, record := range zone.records {
+		names = append(names, fmt.Sprintf("%s %s", record["type"], record["name"]))
+	}
+	sort.Strings(names)
+	return
+}
+
+func (zone *privateZone) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	zone.Lock()
+	defer zone.Unlock()
+
+	res.Header().Set("Content-type", "application/json")
+	const prefix = "/instances/instance-id/dnszones/zone-id/resource_records"
+	id := strings.TrimPrefix(strings.TrimPrefix(req.URL.Path, prefix), "/")
+	var body map[string]interface{}
+	_ = json.NewDecoder(req.Body).Decode(&body)
+
+	var b []byte
+	switch {
+	case req.Method == http.MethodGet && id == "":
+		records := []map[string]interface{}{}
+		for _, record := range zone.records {
+			if (req.URL.Query().Get("type") == "" || req.URL.Query().Get("type") == record["type"]) &&
+				(req.URL.Query().Get("name") == "" || req.URL.Query().Get("name") == record["name"]) {
+				records = append(records, record)
+			}
+		}
+		b, _ = json.Marshal(map[string]interface{}{
+			"resource_records": records, "offset": 0, "limit": 200, "count": len(records), "total_count": len(records),
+			"first": map[string]interface{}{"href": "http://example.com?offset=0"}, "last": map[string]interface{}{"href": "http://example.com?offset=0"},
+		})
+	case req.Method == http.MethodGet:
+		b, _ = json.Marshal(zone.records[id])
+	case req.Method == http.MethodPost:
+		b, _ = json.Marshal(zone.records[zone.add(body)])
+	case req.Method == http.MethodPut:
+		for key, value := range body {
+			zone.records[id][key] = value
+		}
+		b, _ = json.Marshal(zone.records[id])
+	case req.Method == http.MethodDelete:
+		delete(zone.records, id)
+		res.WriteHeader(204)
+		return
+	}
+	res.WriteHeader(200)
+	_, _ = res.Write(b)
+}
+
+func TestGuardedResourceRecordChanges(t *testing.T) {
+	registry, _ := NewRegistry("team-a")
+	other, _ := NewRegistry("team-b")
+	zone := &privateZone{records: map[string]map[string]interface{}{}}
+	shop := zone.add(map[string]interface{}{"type": "A", "name": "shop.example.com", "rdata": map[string]interface{}{"ip": "10.0.0.3"}})
+	zone.add(map[string]interface{}{"type": "TXT", "name": "_owner.a.shop.example.com", "rdata": map[string]interface{}{"text": other.OwnershipContent()}})
+	server := httptest.NewServer(zone)
+	defer server.Close()
+	service, err := dnssvcsv1.NewDnsSvcsV1(&dnssvcsv1.DnsSvcsV1Options{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+
+	create := service.NewCreateResourceRecordOptions("instance-id", "zone-id", "A").
+		SetName("api.example.com").
+		SetRdata(&dnssvcsv1.ResourceRecordInputRdataRdataARecord{Ip: core.StringPtr("10.0.0.1")})
+	created, _, err := registry.CreateResourceRecord(service, create)
+	assert.Nil(t, err)
+	create.SetRdata(&dnssvcsv1.ResourceRecordInputRdataRdataARecord{Ip: core.StringPtr("10.0.0.2")})
+	second, _, err := registry.CreateResourceRecord(service, create)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"A api.example.com", "A api.example.com", "A shop.example.com", "TXT _owner.a.api.example.com", "TXT _owner.a.shop.example.com"}, zone.names())
+
+	owned := registry.OwnedResourceRecords(nil, nil)
+	assert.False(t, owned(dnssvcsv1.ResourceRecord{Type: core.StringPtr("A"), Name: core.StringPtr("api.example.com")}))
+
+	// Records owned by another owner are left alone.
+	_, err = registry.DeleteResourceRecord(service, service.NewDeleteResourceRecordOptions("instance-id", "zone-id", shop))
+	assert.True(t, IsOwnershipError(err))
+	_, _, err = registry.UpdateResourceRecord(service, service.NewUpdateResourceRecordOptions("instance-id", "zone-id", *created.ID, "shop.example.com",
+		&dnssvcsv1.ResourceRecordUpdateInputRdataRdataARecord{Ip: core.StringPtr("10.0.0.1")}))
+	assert.True(t, IsOwnershipError(err))
+
+	// Renaming the last record of a name moves its ownership record along.
+	_, err = registry.DeleteResourceRecord(service, service.NewDeleteResourceRecordOptions("instance-id", "zone-id", *second.ID))
+	assert.Nil(t, err)
+	assert.Contains(t, zone.names(), "TXT _owner.a.api.example.com")
+	_, _, err = registry.UpdateResourceRecord(service, service.NewUpdateResourceRecordOptions("instance-id", "zone-id", *created.ID, "app.example.com",
+		&dnssvcsv1.ResourceRecordUpdateInputRdataRdataARecord{Ip: core.StringPtr("10.0.0.1")}))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"A app.example.com", "A shop.example.com", "TXT _owner.a.app.example.com", "TXT _owner.a.shop.example.com"}, zone.names())
+
+	var records []dnssvcsv1.ResourceRecord
+	for _, record := range zone.records {
+		b, _ := json.Marshal(record)
+		var resourceRecord dnssvcsv1.ResourceRecord
+		_ = json.Unmarshal(b, &resourceRecord)
+		records = append(records, resourceRecord)
+	}
+	owned = registry.OwnedResourceRecords(records, nil)
+	assert.True(t, owned(dnssvcsv1.ResourceRecord{Type: core.StringPtr("A"), Name: core.StringPtr("app.example.com")}))
+	assert.False(t, owned(dnssvcsv1.ResourceRecord{Type: core.StringPtr("A"), Name: core.StringPtr("shop.example.com")}))
+	assert.True(t, owned(dnssvcsv1.ResourceRecord{Type: core.StringPtr("TXT"), Name: core.StringPtr("_owner.a.app.example.com"), Rdata: map[string]interface{}{"text": registry.OwnershipContent()}}))
+	assert.False(t, owned(dnssvcsv1.ResourceRecord{Type: core.StringPtr("TXT"), Name: core.StringPtr("example.com")}))
+}
@@ -0,0 +1,230 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package dnsregistry records who owns the DNS records of a zone, so that
+// several controllers can write into the same CIS zone or DNS Services zone
+// without overwriting each other's records.
+//
+// Ownership is kept in companion TXT records, in the style of external-dns:
+// for every type and name of managed record (A, AAAA and CNAME by default)
+// the owner writes a TXT record named
+//
+//	<prefix><type>.<name>   e.g. _owner.a.www.example.com
+//
+// with the content
+//
+//	heritage=networking-go-sdk,owner=<owner ID>
+//
+// A registry only changes records of a type and name whose ownership record
+// carries its own owner ID, and claims a type and name only when no record of
+// that type and name exists yet.
+package dnsregistry
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DefaultPrefix is the default prefix of ownership record names.
+const DefaultPrefix = "_owner."
+
+// Heritage identifies the ownership records written by this package.
+const Heritage = "networking-go-sdk"
+
+// Registry writes and reads the ownership records of one owner.
+type Registry struct {
+	// The ID of the owner, e.g. the name of the controller instance.
+	OwnerID string
+
+	// The prefix of ownership record names.
+	Prefix string
+
+	// The record types whose ownership is recorded.
+	ManagedTypes []string
+}
+
+// NewRegistry returns a registry for the owner "ownerID", with the default
+// prefix and managed types.
+func NewRegistry(ownerID string) (*Registry, error) {
+	if ownerID == "" || strings.ContainsAny(ownerID, ",=\" ") {
+		return nil, fmt.Errorf("owner ID %q must be non-empty and must not contain commas, equal signs, quotes or spaces", ownerID)
+	}
+	return &Registry{
+		OwnerID:      ownerID,
+		Prefix:       DefaultPrefix,
+		ManagedTypes: []string{"A", "AAAA", "CNAME"},
+	}, nil
+}
+
+// SetPrefix : Allow user to set Prefix
+func (registry *Registry) SetPrefix(prefix string) *Registry {
+	registry.Prefix = prefix
+	return registry
+}
+
+// SetManagedTypes : Allow user to set ManagedTypes
+func (registry *Registry) SetManagedTypes(managedTypes []string) *Registry {
+	registry.ManagedTypes = managedTypes
+	return registry
+}
+
+// IsManagedType returns true if the ownership of records of type
+// "recordType" is recorded.
+func (registry *Registry) IsManagedType(recordType string) bool {
+	for _, managedType := range registry.ManagedTypes {
+		if strings.EqualFold(managedType, recordType) {
+			return true
+		}
+	}
+	return false
+}
+
+// OwnershipName returns the name of the ownership record of the records of
+// type "recordType" named "name".
+func (registry *Registry) OwnershipName(recordType string, name string) string {
+	return registry.Prefix + strings.ToLower(recordType) + "." + normalizeName(name)
+}
+
+// OwnershipContent returns the content of the registry's ownership records.
+func (registry *Registry) OwnershipContent() string {
+	return "heritage=" + Heritage + ",owner=" + registry.OwnerID
+}
+
+// ParseOwnershipContent returns the owner recorded in the content of an
+// ownership record. It returns false if "content" is not the content of an
+// ownership record.
+func ParseOwnershipContent(content string) (owner string, ok bool) {
+	content = strings.Trim(strings.TrimSpace(content), `"`)
+	heritage := false
+	for _, pair := range strings.Split(content, ",") {
+		key, value, found := strings.Cut(pair, "=")
+		if !found {
+			return "", false
+		}
+		switch key {
+		case "heritage":
+			heritage = value == Heritage
+		case "owner":
+			owner = value
+		}
+	}
+	return owner, heritage && owner != ""
+}
+
+// parseOwnershipName returns the type and name of the records that the
+// ownership record named "ownershipName" describes.
+func (registry *Registry) parseOwnershipName(ownershipName string) (recordType string, name string, ok bool) {
+	ownershipName = normalizeName(ownershipName)
+	prefix := strings.ToLower(registry.Prefix)
+	if !strings.HasPrefix(ownershipName, prefix) {
+		return "", "", false
+	}
+	recordType, name, ok = strings.Cut(strings.TrimPrefix(ownershipName, prefix), ".")
+	if !ok || !registry.IsManagedType(recordType) || name == "" {
+		return "", "", false
+	}
+	return strings.ToUpper(recordType), name, true
+}
+
+// Owners maps the types and names of managed records to their owners.
+type Owners map[string]string
+
+// Owner returns the owner of the records of type "recordType" named "name".
+func (owners Owners) Owner(recordType string, name string) (owner string, ok bool) {
+	owner, ok = owners[ownersKey(recordType, name)]
+	return
+}
+
+// addOwnershipRecord records the owner of the TXT record "name" with content
+// "content", if it is an ownership record.
+func (registry *Registry) addOwnershipRecord(owners Owners, name string, content string) {
+	recordType, recordName, ok := registry.parseOwnershipName(name)
+	if !ok {
+		return
+	}
+	if owner, ok := ParseOwnershipContent(content); ok {
+		owners[ownersKey(recordType, recordName)] = owner
+	}
+}
+
+// isOwnOwnershipRecord returns true if the TXT record "name" with content
+// "content" is one of the registry's ownership records.
+func (registry *Registry) isOwnOwnershipRecord(name string, content string) bool {
+	if _, _, ok := registry.parseOwnershipName(name); !ok {
+		return false
+	}
+	owner, ok := ParseOwnershipContent(content)
+	return ok && owner == registry.OwnerID
+}
+
+// check returns an OwnershipError unless the registry may change the records
+// of type "recordType" named "name". "exists" tells whether any such record
+// exists; a type and name without records can be claimed.
+func (registry *Registry) check(owners Owners, recordType string, name string, exists bool) error {
+	if !registry.IsManagedType(recordType) {
+		return nil
+	}
+	owner, owned := owners.Owner(recordType, name)
+	if (owned && owner == registry.OwnerID) || (!owned && !exists) {
+		return nil
+	}
+	return &OwnershipError{Type: strings.ToUpper(recordType), Name: normalizeName(name), Owner: owner}
+}
+
+// OwnershipError reports an attempt to change records owned by another
+// owner, or existing records that have no owner.
+type OwnershipError struct {
+	Type  string
+	Name  string
+	Owner string
+}
+
+func (ownershipErr *OwnershipError) Error() string {
+	if ownershipErr.Owner == "" {
+		return fmt.Sprintf("%s records named %s have no owner and are left alone", ownershipErr.Type, ownershipErr.Name)
+	}
+	return fmt.Sprintf("%s records named %s are owned by %q", ownershipErr.Type, ownershipErr.Name, ownershipErr.Owner)
+}
+
+// IsOwnershipError returns true if "err" reports a record the registry may
+// not change.
+func IsOwnershipError(err error) bool {
+	var ownershipErr *OwnershipError
+	return errors.As(err, &ownershipErr)
+}
+
+func ownersKey(recordType string, name string) string {
+	return strings.ToUpper(recordType) + " " + normalizeName(name)
+}
+
+func normalizeName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+}
+
+// qualifyName returns the fully qualified form of "name" within "zoneName".
+func qualifyName(name string, zoneName string) string {
+	trimmed := strings.TrimSpace(name)
+	if trimmed == "" || trimmed == "@" {
+		return normalizeName(zoneName)
+	}
+	normalized := normalizeName(trimmed)
+	zoneName = normalizeName(zoneName)
+	if zoneName == "" || strings.HasSuffix(trimmed, ".") || normalized == zoneName || strings.HasSuffix(normalized, "."+zoneName) {
+		return normalized
+	}
+	return normalized + "." + zoneName
+}
@@ -0,0 +1,203 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnsregistry
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/stretchr/testify/assert"
+)
+
+func cisRecord(id string, recordType string, name string, content string) dnsrecordsv1.DnsrecordDetails {
+	return dnsrecordsv1.DnsrecordDetails{
+		ID:       core.StringPtr(id),
+		Type:     core.StringPtr(recordType),
+		Name:     core.StringPtr(name),
+		Content:  core.StringPtr(content),
+		TTL:      core.Int64Ptr(1),
+		ZoneName: core.StringPtr("example.com"),
+	}
+}
+
+func TestNewRegistry(t *testing.T) {
+	registry, err := NewRegistry("team-a")
+	assert.Nil(t, err)
+	assert.Equal(t, "_owner.a.www.example.com", registry.OwnershipName("A", "WWW.example.com."))
+	assert.Equal(t, "heritage=networking-go-sdk,owner=team-a", registry.OwnershipContent())
+	assert.True(t, registry.IsManagedType("cname"))
+	assert.False(t, registry.IsManagedType("MX"))
+
+	registry.SetPrefix("txt-").SetManagedTypes([]string{"A"})
+	assert.Equal(t, "txt-a.www.example.com", registry.OwnershipName("A", "www.example.com"))
+	recordType, name, ok := registry.parseOwnershipName("txt-a.www.example.com")
+	assert.True(t, ok)
+	assert.Equal(t, "A", recordType)
+	assert.Equal(t, "www.example.com", name)
+	_, _, ok = registry.parseOwnershipName("txt-cname.www.example.com")
+	assert.False(t, ok)
+
+	for _, ownerID := range []string{"", "a,b", "a=b", "a b"} {
+		_, err = NewRegistry(ownerID)
+		assert.NotNil(t, err, ownerID)
+	}
+}
+
+func TestParseOwnershipContent(t *testing.T) {
+	owner, ok := ParseOwnershipContent(`"heritage=networking-go-sdk,owner=team-a"`)
+	assert.True(t, ok)
+	assert.Equal(t, "team-a", owner)
+
+	for _, content := range []string{"", "v=spf1 -all", "heritage=external-dns,owner=team-a", "heritage=networking-go-sdk", "owner=team-a"} {
+		_, ok = ParseOwnershipContent(content)
+		assert.False(t, ok, content)
+	}
+}
+
+func TestPlanDnsRecordsKeepsOwnershipRecords(t *testing.T) {
+	registry, _ := NewRegistry("team-a")
+	other, _ := NewRegistry("team-b")
+	current := []dnsrecordsv1.DnsrecordDetails{
+		cisRecord("1", "A", "api.example.com", "203.0.113.1"),
+		cisRecord("2", "TXT", "_owner.a.api.example.com", registry.OwnershipContent()),
+		cisRecord("3", "A", "old.example.com", "203.0.113.2"),
+		cisRecord("4", "TXT", "_owner.a.old.example.com", registry.OwnershipContent()),
+		cisRecord("5", "A", "shop.example.com", "203.0.113.3"),
+		cisRecord("6", "TXT", "_owner.a.shop.example.com", other.OwnershipContent()),
+		cisRecord("7", "A", "legacy.example.com", "203.0.113.4"),
+		cisRecord("8", "TXT", "example.com", "v=spf1 -all"),
+	}
+	options := &dnsrecordsv1.ReconcileDnsRecordsOptions{
+		Desired: []dnsrecordsv1.DnsrecordInput{
+			{Type: core.StringPtr("A"), Name: core.StringPtr("api"), Content: core.StringPtr("203.0.113.1"), TTL: core.Int64Ptr(1)},
+			{Type: core.StringPtr("CNAME"), Name: core.StringPtr("www"), Content: core.StringPtr("api.example.com"), TTL: core.Int64Ptr(1)},
+		},
+	}
+
+	plan, err := registry.PlanDnsRecords(current, options)
+	assert.Nil(t, err)
+	var creates []string
+	for _, record := range plan.Creates {
+		creates = append(creates, *record.Type+" "+*record.Name)
+	}
+	assert.Equal(t, []string{"CNAME www.example.com", "TXT _owner.cname.www.example.com"}, creates)
+	var deletes []string
+	for _, record := range plan.Deletes {
+		deletes = append(deletes, *record.ID)
+	}
+	assert.Equal(t, []string{"3", "4"}, deletes)
+	assert.Len(t, plan.Unchanged, 2)
+	assert.Nil(t, options.Owned)
+
+	// Another owner's records and records without an owner are not taken over.
+	options.Desired = append(options.Desired, dnsrecordsv1.DnsrecordInput{Type: core.StringPtr("A"), Name: core.StringPtr("shop"), Content: core.StringPtr("203.0.113.9")})
+	_, err = registry.PlanDnsRecords(current, options)
+	assert.True(t, IsOwnershipError(err))
+	assert.Equal(t, `A records named shop.example.com are owned by "team-b"`, err.Error())
+
+	options.Desired[2].Name = core.StringPtr("legacy.example.com")
+	_, err = registry.PlanDnsRecords(current, options)
+	assert.Equal(t, &OwnershipError{Type: "A", Name: "legacy.example.com"}, err)
+}
+
+// cisZone serves the list, get and batch requests of a CIS zone holding
+// "records", and records the batch requests it receives.
+func cisZone(t *testing.T, records []dnsrecordsv1.DnsrecordDetails) (*dnsrecordsv1.DnsRecordsV1, *[]map[string]interface{}, func()) {
+	var batches []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-type", "application/json")
+		const prefix = "/v1/crn/zones/zone/dns_records"
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == prefix:
+			var matching []dnsrecordsv1.DnsrecordDetails
+			for _, record := range records {
+				if (req.URL.Query().Get("type") == "" || req.URL.Query().Get("type") == *record.Type) &&
+					(req.URL.Query().Get("name") == "" || req.URL.Query().Get("name") == *record.Name) {
+					matching = append(matching, record)
+				}
+			}
+			b, _ := json.Marshal(matching)
+			fmt.Fprintf(res, `{"success": true, "errors": [], "messages": [], "result": %s, "result_info": {"page": 1, "per_page": 1000, "count": %d, "total_count": %d}}`, b, len(matching), len(matching))
+		case req.Method == http.MethodGet:
+			for _, record := range records {
+				if prefix+"/"+*record.ID == req.URL.Path {
+					b, _ := json.Marshal(record)
+					fmt.Fprintf(res, `{"success": true, "errors": [], "messages": [], "result": %s}`, b)
+					return
+				}
+			}
+			res.WriteHeader(404)
+		case req.Method == http.MethodPost && req.URL.Path == prefix+"/batch":
+			body, _ := io.ReadAll(req.Body)
+			var batch map[string]interface{}
+			_ = json.Unmarshal(body, &batch)
+			batches = append(batches, batch)
+			fmt.Fprint(res, `{"success": true, "errors": [], "messages": [], "result": {"posts": [{"id": "new", "type": "A", "name": "www.example.com"}]}}`)
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			res.WriteHeader(400)
+		}
+	}))
+	service, err := dnsrecordsv1.NewDnsRecordsV1(&dnsrecordsv1.DnsRecordsV1Options{
+		URL:            server.URL,
+		Authenticator:  &core.NoAuthAuthenticator{},
+		Crn:            core.StringPtr("crn"),
+		ZoneIdentifier: core.StringPtr("zone"),
+	})
+	assert.Nil(t, err)
+	return service, &batches, server.Close
+}
+
+func TestGuardedDnsRecordChanges(t *testing.T) {
+	registry, _ := NewRegistry("team-a")
+	other, _ := NewRegistry("team-b")
+	service, batches, closeServer := cisZone(t, []dnsrecordsv1.DnsrecordDetails{
+		cisRecord("1", "A", "api.example.com", "203.0.113.1"),
+		cisRecord("2", "TXT", "_owner.a.api.example.com", registry.OwnershipContent()),
+		cisRecord("3", "A", "shop.example.com", "203.0.113.3"),
+		cisRecord("4", "TXT", "_owner.a.shop.example.com", other.OwnershipContent()),
+	})
+	defer closeServer()
+
+	result, _, err := registry.CreateDnsRecord(service, &dnsrecordsv1.CreateDnsRecordOptions{
+		Type: core.StringPtr("A"), Name: core.StringPtr("www.example.com"), Content: core.StringPtr("203.0.113.5"),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "new", *result.ID)
+	posts := (*batches)[0]["posts"].([]interface{})
+	assert.Len(t, posts, 2)
+	assert.Equal(t, "_owner.a.www.example.com", posts[1].(map[string]interface{})["name"])
+
+	_, err = registry.DeleteDnsRecord(service, &dnsrecordsv1.DeleteDnsRecordOptions{DnsrecordIdentifier: core.StringPtr("3")})
+	assert.True(t, IsOwnershipError(err))
+	_, _, err = registry.CreateDnsRecord(service, &dnsrecordsv1.CreateDnsRecordOptions{
+		Type: core.StringPtr("A"), Name: core.StringPtr("shop.example.com"), Content: core.StringPtr("203.0.113.6"),
+	})
+	assert.True(t, IsOwnershipError(err))
+	assert.Len(t, *batches, 1)
+
+	// Deleting the last record of a type and name deletes its ownership record.
+	_, err = registry.DeleteDnsRecord(service, &dnsrecordsv1.DeleteDnsRecordOptions{DnsrecordIdentifier: core.StringPtr("1")})
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{map[string]interface{}{"id": "1"}, map[string]interface{}{"id": "2"}}, (*batches)[1]["deletes"])
+}
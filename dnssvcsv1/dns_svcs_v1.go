@@ -172,6 +172,12 @@ func (dnsSvcs *DnsSvcsV1) DisableRetries() {
 	dnsSvcs.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (dnsSvcs *DnsSvcsV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(dnsSvcs.Service, policy)
+}
+
 // ListDnszones : List DNS zones
 // List the DNS zones for a given service instance.
 func (dnsSvcs *DnsSvcsV1) ListDnszones(listDnszonesOptions *ListDnszonesOptions) (result *ListDnszones, response *core.DetailedResponse, err error) {
@@ -238,6 +244,7 @@ func (dnsSvcs *DnsSvcsV1) ListDnszonesWithContext(ctx context.Context, listDnszo
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_dnszones", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -327,6 +334,7 @@ func (dnsSvcs *DnsSvcsV1) CreateDnszoneWithContext(ctx context.Context, createDn
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_dnszone", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -398,6 +406,7 @@ func (dnsSvcs *DnsSvcsV1) DeleteDnszoneWithContext(ctx context.Context, deleteDn
 
 	response, err = dnsSvcs.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_dnszone", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -463,6 +472,7 @@ func (dnsSvcs *DnsSvcsV1) GetDnszoneWithContext(ctx context.Context, getDnszoneO
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_dnszone", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -550,6 +560,7 @@ func (dnsSvcs *DnsSvcsV1) UpdateDnszoneWithContext(ctx context.Context, updateDn
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_dnszone", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -636,6 +647,7 @@ func (dnsSvcs *DnsSvcsV1) ListResourceRecordsWithContext(ctx context.Context, li
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_resource_records", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -735,6 +747,7 @@ func (dnsSvcs *DnsSvcsV1) CreateResourceRecordWithContext(ctx context.Context, c
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_resource_record", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -807,6 +820,7 @@ func (dnsSvcs *DnsSvcsV1) DeleteResourceRecordWithContext(ctx context.Context, d
 
 	response, err = dnsSvcs.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_resource_record", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -873,6 +887,7 @@ func (dnsSvcs *DnsSvcsV1) GetResourceRecordWithContext(ctx context.Context, getR
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_resource_record", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -970,6 +985,7 @@ func (dnsSvcs *DnsSvcsV1) UpdateResourceRecordWithContext(ctx context.Context, u
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_resource_record", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1042,6 +1058,7 @@ func (dnsSvcs *DnsSvcsV1) ExportResourceRecordsWithContext(ctx context.Context,
 
 	response, err = dnsSvcs.Service.Request(request, &result)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "export_resource_records", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1116,6 +1133,7 @@ func (dnsSvcs *DnsSvcsV1) ImportResourceRecordsWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "import_resource_records", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1193,6 +1211,7 @@ func (dnsSvcs *DnsSvcsV1) ListPermittedNetworksWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_permitted_networks", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1284,6 +1303,7 @@ func (dnsSvcs *DnsSvcsV1) CreatePermittedNetworkWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_permitted_network", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1358,6 +1378,7 @@ func (dnsSvcs *DnsSvcsV1) DeletePermittedNetworkWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_permitted_network", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1432,6 +1453,7 @@ func (dnsSvcs *DnsSvcsV1) GetPermittedNetworkWithContext(ctx context.Context, ge
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_permitted_network", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1512,6 +1534,7 @@ func (dnsSvcs *DnsSvcsV1) ListLoadBalancersWithContext(ctx context.Context, list
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_load_balancers", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1614,6 +1637,7 @@ func (dnsSvcs *DnsSvcsV1) CreateLoadBalancerWithContext(ctx context.Context, cre
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_load_balancer", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1686,6 +1710,7 @@ func (dnsSvcs *DnsSvcsV1) DeleteLoadBalancerWithContext(ctx context.Context, del
 
 	response, err = dnsSvcs.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_load_balancer", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1752,6 +1777,7 @@ func (dnsSvcs *DnsSvcsV1) GetLoadBalancerWithContext(ctx context.Context, getLoa
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_load_balancer", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1855,6 +1881,7 @@ func (dnsSvcs *DnsSvcsV1) UpdateLoadBalancerWithContext(ctx context.Context, upd
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_load_balancer", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1934,6 +1961,7 @@ func (dnsSvcs *DnsSvcsV1) ListPoolsWithContext(ctx context.Context, listPoolsOpt
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_pools", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2041,6 +2069,7 @@ func (dnsSvcs *DnsSvcsV1) CreatePoolWithContext(ctx context.Context, createPoolO
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_pool", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2112,6 +2141,7 @@ func (dnsSvcs *DnsSvcsV1) DeletePoolWithContext(ctx context.Context, deletePoolO
 
 	response, err = dnsSvcs.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_pool", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2177,6 +2207,7 @@ func (dnsSvcs *DnsSvcsV1) GetPoolWithContext(ctx context.Context, getPoolOptions
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_pool", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2285,6 +2316,7 @@ func (dnsSvcs *DnsSvcsV1) UpdatePoolWithContext(ctx context.Context, updatePoolO
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_pool", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2364,6 +2396,7 @@ func (dnsSvcs *DnsSvcsV1) ListMonitorsWithContext(ctx context.Context, listMonit
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_monitors", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2483,6 +2516,7 @@ func (dnsSvcs *DnsSvcsV1) CreateMonitorWithContext(ctx context.Context, createMo
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_monitor", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2554,6 +2588,7 @@ func (dnsSvcs *DnsSvcsV1) DeleteMonitorWithContext(ctx context.Context, deleteMo
 
 	response, err = dnsSvcs.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_monitor", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2619,6 +2654,7 @@ func (dnsSvcs *DnsSvcsV1) GetMonitorWithContext(ctx context.Context, getMonitorO
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_monitor", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2739,6 +2775,7 @@ func (dnsSvcs *DnsSvcsV1) UpdateMonitorWithContext(ctx context.Context, updateMo
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_monitor", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2811,6 +2848,7 @@ func (dnsSvcs *DnsSvcsV1) ListCustomResolversWithContext(ctx context.Context, li
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_custom_resolvers", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2903,6 +2941,7 @@ func (dnsSvcs *DnsSvcsV1) CreateCustomResolverWithContext(ctx context.Context, c
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_custom_resolver", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2974,6 +3013,7 @@ func (dnsSvcs *DnsSvcsV1) DeleteCustomResolverWithContext(ctx context.Context, d
 
 	response, err = dnsSvcs.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_custom_resolver", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3039,6 +3079,7 @@ func (dnsSvcs *DnsSvcsV1) GetCustomResolverWithContext(ctx context.Context, getC
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_custom_resolver", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3135,6 +3176,7 @@ func (dnsSvcs *DnsSvcsV1) UpdateCustomResolverWithContext(ctx context.Context, u
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_custom_resolver", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3219,6 +3261,7 @@ func (dnsSvcs *DnsSvcsV1) UpdateCrLocationsOrderWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_cr_locations_order", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3306,6 +3349,7 @@ func (dnsSvcs *DnsSvcsV1) AddCustomResolverLocationWithContext(ctx context.Conte
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "add_custom_resolver_location", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3394,6 +3438,7 @@ func (dnsSvcs *DnsSvcsV1) UpdateCustomResolverLocationWithContext(ctx context.Co
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_custom_resolver_location", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3466,6 +3511,7 @@ func (dnsSvcs *DnsSvcsV1) DeleteCustomResolverLocationWithContext(ctx context.Co
 
 	response, err = dnsSvcs.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_custom_resolver_location", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3538,6 +3584,7 @@ func (dnsSvcs *DnsSvcsV1) ListForwardingRulesWithContext(ctx context.Context, li
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_forwarding_rules", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3623,6 +3670,7 @@ func (dnsSvcs *DnsSvcsV1) CreateForwardingRuleWithContext(ctx context.Context, c
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_forwarding_rule", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3695,6 +3743,7 @@ func (dnsSvcs *DnsSvcsV1) DeleteForwardingRuleWithContext(ctx context.Context, d
 
 	response, err = dnsSvcs.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_forwarding_rule", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3761,6 +3810,7 @@ func (dnsSvcs *DnsSvcsV1) GetForwardingRuleWithContext(ctx context.Context, getF
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_forwarding_rule", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3855,6 +3905,7 @@ func (dnsSvcs *DnsSvcsV1) UpdateForwardingRuleWithContext(ctx context.Context, u
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_forwarding_rule", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3953,6 +4004,7 @@ func (dnsSvcs *DnsSvcsV1) CreateSecondaryZoneWithContext(ctx context.Context, cr
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_secondary_zone", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4033,6 +4085,7 @@ func (dnsSvcs *DnsSvcsV1) ListSecondaryZonesWithContext(ctx context.Context, lis
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_secondary_zones", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4107,6 +4160,7 @@ func (dnsSvcs *DnsSvcsV1) GetSecondaryZoneWithContext(ctx context.Context, getSe
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_secondary_zone", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4198,6 +4252,7 @@ func (dnsSvcs *DnsSvcsV1) UpdateSecondaryZoneWithContext(ctx context.Context, up
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_secondary_zone", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4270,6 +4325,7 @@ func (dnsSvcs *DnsSvcsV1) DeleteSecondaryZoneWithContext(ctx context.Context, de
 
 	response, err = dnsSvcs.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_secondary_zone", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4341,6 +4397,7 @@ func (dnsSvcs *DnsSvcsV1) ListLinkedZonesWithContext(ctx context.Context, listLi
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_linked_zones", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4433,6 +4490,7 @@ func (dnsSvcs *DnsSvcsV1) CreateLinkedZoneWithContext(ctx context.Context, creat
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_linked_zone", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4506,6 +4564,7 @@ func (dnsSvcs *DnsSvcsV1) GetLinkedZoneWithContext(ctx context.Context, getLinke
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_linked_zone", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4593,6 +4652,7 @@ func (dnsSvcs *DnsSvcsV1) UpdateLinkedZoneWithContext(ctx context.Context, updat
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_linked_zone", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4664,6 +4724,7 @@ func (dnsSvcs *DnsSvcsV1) DeleteLinkedZoneWithContext(ctx context.Context, delet
 
 	response, err = dnsSvcs.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_linked_zone", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4736,6 +4797,7 @@ func (dnsSvcs *DnsSvcsV1) ListDnszoneAccessRequestsWithContext(ctx context.Conte
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_dnszone_access_requests", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4810,6 +4872,7 @@ func (dnsSvcs *DnsSvcsV1) GetDnszoneAccessRequestWithContext(ctx context.Context
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_dnszone_access_request", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4895,6 +4958,7 @@ func (dnsSvcs *DnsSvcsV1) UpdateDnszoneAccessRequestWithContext(ctx context.Cont
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_dnszone_access_request", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4968,6 +5032,7 @@ func (dnsSvcs *DnsSvcsV1) ListLinkedPermittedNetworksWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_linked_permitted_networks", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -5055,6 +5120,7 @@ func (dnsSvcs *DnsSvcsV1) CreateLzPermittedNetworkWithContext(ctx context.Contex
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_lz_permitted_network", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -5129,6 +5195,7 @@ func (dnsSvcs *DnsSvcsV1) DeleteLzPermittedNetworkWithContext(ctx context.Contex
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_lz_permitted_network", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -5203,6 +5270,7 @@ func (dnsSvcs *DnsSvcsV1) GetLinkedPermittedNetworkWithContext(ctx context.Conte
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_linked_permitted_network", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
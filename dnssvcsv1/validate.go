@@ -0,0 +1,219 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnssvcsv1
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	common "github.com/IBM/networking-go-sdk/common"
+)
+
+// The limits the validator checks resource record fields against.
+const (
+	resourceRecordMaxTTL      = 2147483647
+	resourceRecordMaxTxtChars = 255
+)
+
+// resourceRecordFields are the fields shared by the resource record request models.
+type resourceRecordFields struct {
+	name     *string
+	typeVar  string
+	ttl      *int64
+	service  *string
+	protocol *string
+	rdata    interface{}
+}
+
+// ValidateRecord checks the resource record described by the options against the rules of its type, before it is
+// sent, and returns a common.ValidationErrors listing every invalid field. "zoneName" is used to recognize records
+// at the zone apex and may be empty, in which case only "@" names the apex.
+func (_options *CreateResourceRecordOptions) ValidateRecord(zoneName string) error {
+	if _options == nil {
+		return fmt.Errorf("no options were provided")
+	}
+	record := resourceRecordFields{name: _options.Name, ttl: _options.TTL, service: _options.Service, protocol: _options.Protocol, rdata: _options.Rdata}
+	if _options.Type != nil {
+		record.typeVar = strings.ToUpper(*_options.Type)
+	}
+	var validationErrs common.ValidationErrors
+	if record.typeVar == "" {
+		validationErrs.Add("type", "is required")
+	} else if rdataType := resourceRecordRdataType(_options.Rdata); rdataType != "" && rdataType != record.typeVar {
+		validationErrs.Add("rdata", "holds %s record data, but the record type is %s", rdataType, record.typeVar)
+		return validationErrs.Err()
+	}
+	validationErrs = append(validationErrs, validateResourceRecord(zoneName, record)...)
+	return validationErrs.Err()
+}
+
+// ValidateRecord checks the resource record described by the options against the rules of its type, which is taken
+// from the rdata. See CreateResourceRecordOptions.ValidateRecord.
+func (_options *UpdateResourceRecordOptions) ValidateRecord(zoneName string) error {
+	if _options == nil {
+		return fmt.Errorf("no options were provided")
+	}
+	record := resourceRecordFields{name: _options.Name, ttl: _options.TTL, service: _options.Service, protocol: _options.Protocol, rdata: _options.Rdata}
+	record.typeVar = resourceRecordRdataType(_options.Rdata)
+	return validateResourceRecord(zoneName, record).Err()
+}
+
+// resourceRecordRdataType returns the record type of a typed rdata model, or infers it from the fields set in the
+// generic one.
+func resourceRecordRdataType(rdata interface{}) string {
+	switch rdata := rdata.(type) {
+	case *ResourceRecordInputRdataRdataARecord, *ResourceRecordUpdateInputRdataRdataARecord:
+		return CreateResourceRecordOptions_Type_A
+	case *ResourceRecordInputRdataRdataAaaaRecord, *ResourceRecordUpdateInputRdataRdataAaaaRecord:
+		return CreateResourceRecordOptions_Type_Aaaa
+	case *ResourceRecordInputRdataRdataCnameRecord, *ResourceRecordUpdateInputRdataRdataCnameRecord:
+		return CreateResourceRecordOptions_Type_Cname
+	case *ResourceRecordInputRdataRdataMxRecord, *ResourceRecordUpdateInputRdataRdataMxRecord:
+		return CreateResourceRecordOptions_Type_Mx
+	case *ResourceRecordInputRdataRdataPtrRecord, *ResourceRecordUpdateInputRdataRdataPtrRecord:
+		return CreateResourceRecordOptions_Type_Ptr
+	case *ResourceRecordInputRdataRdataSrvRecord, *ResourceRecordUpdateInputRdataRdataSrvRecord:
+		return CreateResourceRecordOptions_Type_Srv
+	case *ResourceRecordInputRdataRdataTxtRecord, *ResourceRecordUpdateInputRdataRdataTxtRecord:
+		return CreateResourceRecordOptions_Type_Txt
+	case *ResourceRecordUpdateInputRdata:
+		if rdata != nil {
+			return inferResourceRecordType(rdata.Ip, rdata.Cname, rdata.Exchange, rdata.Ptrdname, rdata.Target, rdata.Text)
+		}
+	}
+	return ""
+}
+
+func inferResourceRecordType(ip *string, cname *string, exchange *string, ptrdname *string, target *string, text *string) string {
+	switch {
+	case ip != nil && common.IsIPv4Address(*ip):
+		return CreateResourceRecordOptions_Type_A
+	case ip != nil:
+		return CreateResourceRecordOptions_Type_Aaaa
+	case cname != nil:
+		return CreateResourceRecordOptions_Type_Cname
+	case exchange != nil:
+		return CreateResourceRecordOptions_Type_Mx
+	case ptrdname != nil:
+		return CreateResourceRecordOptions_Type_Ptr
+	case target != nil:
+		return CreateResourceRecordOptions_Type_Srv
+	case text != nil:
+		return CreateResourceRecordOptions_Type_Txt
+	}
+	return ""
+}
+
+func validateResourceRecord(zoneName string, record resourceRecordFields) (validationErrs common.ValidationErrors) {
+	if record.name != nil && *record.name != "" && *record.name != "@" && !common.IsHostname(*record.name) {
+		validationErrs.Add("name", "%q is not a valid DNS name", *record.name)
+	}
+	if record.ttl != nil && (*record.ttl < 1 || *record.ttl > resourceRecordMaxTTL) {
+		validationErrs.Add("ttl", "must be between 1 and %d, got %d", resourceRecordMaxTTL, *record.ttl)
+	}
+	if record.typeVar == "" {
+		return
+	}
+
+	rdata := map[string]interface{}{}
+	if record.rdata != nil {
+		if b, err := json.Marshal(record.rdata); err == nil {
+			_ = json.Unmarshal(b, &rdata)
+		}
+	}
+	if len(rdata) == 0 {
+		validationErrs.Add("rdata", "is required for %s records", record.typeVar)
+		return
+	}
+	field := func(key string) (string, bool) {
+		value, ok := rdata[key].(string)
+		if !ok || value == "" {
+			validationErrs.Add("rdata."+key, "is required for %s records", record.typeVar)
+			return "", false
+		}
+		return value, true
+	}
+	hostname := func(key string) {
+		if value, ok := field(key); ok && !common.IsHostname(value) {
+			validationErrs.Add("rdata."+key, "%q is not a valid host name", value)
+		}
+	}
+	integer := func(key string, minValue int64, maxValue int64) {
+		value, ok := rdata[key].(float64)
+		if !ok {
+			validationErrs.Add("rdata."+key, "is required for %s records", record.typeVar)
+		} else if value < float64(minValue) || value > float64(maxValue) {
+			validationErrs.Add("rdata."+key, "must be between %d and %d, got %v", minValue, maxValue, value)
+		}
+	}
+
+	switch record.typeVar {
+	case CreateResourceRecordOptions_Type_A:
+		if value, ok := field("ip"); ok && !common.IsIPv4Address(value) {
+			validationErrs.Add("rdata.ip", "%q is not an IPv4 address", value)
+		}
+	case CreateResourceRecordOptions_Type_Aaaa:
+		if value, ok := field("ip"); ok && !common.IsIPv6Address(value) {
+			validationErrs.Add("rdata.ip", "%q is not an IPv6 address", value)
+		}
+	case CreateResourceRecordOptions_Type_Cname:
+		hostname("cname")
+		if record.name != nil && (strings.TrimSpace(*record.name) == "@" ||
+			(zoneName != "" && strings.EqualFold(strings.TrimSuffix(*record.name, "."), strings.TrimSuffix(zoneName, ".")))) {
+			validationErrs.Add("name", "CNAME records are not allowed at the zone apex")
+		}
+	case CreateResourceRecordOptions_Type_Mx:
+		hostname("exchange")
+		integer("preference", 0, math.MaxUint16)
+	case CreateResourceRecordOptions_Type_Ptr:
+		hostname("ptrdname")
+	case CreateResourceRecordOptions_Type_Srv:
+		integer("priority", 0, math.MaxUint16)
+		integer("weight", 0, math.MaxUint16)
+		integer("port", 1, math.MaxUint16)
+		if value, ok := field("target"); ok && value != "." && !common.IsHostname(value) {
+			validationErrs.Add("rdata.target", "%q is not a valid host name", value)
+		}
+		if record.service == nil || *record.service == "" {
+			validationErrs.Add("service", "is required for SRV records")
+		} else if !strings.HasPrefix(*record.service, "_") {
+			validationErrs.Add("service", "%q must start with an underscore", *record.service)
+		}
+		if record.protocol == nil || *record.protocol == "" {
+			validationErrs.Add("protocol", "is required for SRV records")
+		} else {
+			switch strings.ToLower(strings.TrimPrefix(*record.protocol, "_")) {
+			case "tcp", "udp", "tls":
+			default:
+				validationErrs.Add("protocol", "must be one of tcp, udp or tls, got %q", *record.protocol)
+			}
+		}
+	case CreateResourceRecordOptions_Type_Txt:
+		if value, ok := field("text"); ok {
+			for _, text := range common.TXTStrings(value) {
+				if len(text) > resourceRecordMaxTxtChars {
+					validationErrs.Add("rdata.text", "character strings must not be longer than %d characters, got %d", resourceRecordMaxTxtChars, len(text))
+				}
+			}
+		}
+	default:
+		validationErrs.Add("type", "unsupported record type %q", record.typeVar)
+	}
+	return
+}
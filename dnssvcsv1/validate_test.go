@@ -0,0 +1,105 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnssvcsv1_test
+
+import (
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DnsSvcsV1 resource record validation`, func() {
+	service, _ := dnssvcsv1.NewDnsSvcsV1(&dnssvcsv1.DnsSvcsV1Options{
+		URL:           "http://dnssvcsv1modelgenerator.com",
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	fields := func(err error) map[string]string {
+		validationErrs, ok := common.AsValidationErrors(err)
+		Expect(ok).To(BeTrue())
+		result := map[string]string{}
+		for _, validationErr := range validationErrs {
+			result[validationErr.Field] = validationErr.Message
+		}
+		return result
+	}
+	newOptions := func(recordType string, name string, rdata dnssvcsv1.ResourceRecordInputRdataIntf) *dnssvcsv1.CreateResourceRecordOptions {
+		return service.NewCreateResourceRecordOptions("instance-id", "zone-id", recordType).SetName(name).SetRdata(rdata)
+	}
+
+	Describe(`ValidateRecord(zoneName string)`, func() {
+		It(`Accept valid records of every type`, func() {
+			a, _ := service.NewResourceRecordInputRdataRdataARecord("10.0.0.5")
+			aaaa, _ := service.NewResourceRecordInputRdataRdataAaaaRecord("fd00::5")
+			cname, _ := service.NewResourceRecordInputRdataRdataCnameRecord("app.internal.example")
+			mx, _ := service.NewResourceRecordInputRdataRdataMxRecord("mail.internal.example", 10)
+			ptr, _ := service.NewResourceRecordInputRdataRdataPtrRecord("app.internal.example")
+			srv, _ := service.NewResourceRecordInputRdataRdataSrvRecord(389, 0, "dc1.internal.example", 100)
+			txt, _ := service.NewResourceRecordInputRdataRdataTxtRecord("hello")
+			optionsList := []*dnssvcsv1.CreateResourceRecordOptions{
+				newOptions("A", "app", a).SetTTL(300),
+				newOptions("AAAA", "app", aaaa),
+				newOptions("CNAME", "db", cname),
+				newOptions("MX", "internal.example", mx),
+				newOptions("PTR", "5", ptr),
+				newOptions("SRV", "ldap", srv).SetService("_ldap").SetProtocol("tcp"),
+				newOptions("TXT", "note", txt),
+				newOptions("A", "app", &dnssvcsv1.ResourceRecordInputRdata{Ip: core.StringPtr("10.0.0.6")}),
+			}
+			for _, options := range optionsList {
+				Expect(options.ValidateRecord("internal.example")).To(BeNil(), *options.Type)
+			}
+		})
+		It(`Report field-level errors`, func() {
+			aaaa, _ := service.NewResourceRecordInputRdataRdataAaaaRecord("10.0.0.5")
+			Expect(fields(newOptions("AAAA", "app", aaaa).ValidateRecord(""))).To(Equal(map[string]string{"rdata.ip": `"10.0.0.5" is not an IPv6 address`}))
+
+			a, _ := service.NewResourceRecordInputRdataRdataARecord("10.0.0.5")
+			Expect(fields(newOptions("AAAA", "app", a).ValidateRecord(""))).To(Equal(map[string]string{"rdata": "holds A record data, but the record type is AAAA"}))
+
+			cname, _ := service.NewResourceRecordInputRdataRdataCnameRecord("app.internal.example")
+			Expect(fields(newOptions("CNAME", "internal.example.", cname).ValidateRecord("internal.example"))).To(Equal(map[string]string{"name": "CNAME records are not allowed at the zone apex"}))
+
+			mx := &dnssvcsv1.ResourceRecordInputRdataRdataMxRecord{Exchange: core.StringPtr("mail.internal.example"), Preference: core.Int64Ptr(70000)}
+			Expect(fields(newOptions("MX", "@", mx).ValidateRecord(""))).To(Equal(map[string]string{"rdata.preference": "must be between 0 and 65535, got 70000"}))
+
+			srv := &dnssvcsv1.ResourceRecordInputRdataRdataSrvRecord{Priority: core.Int64Ptr(0), Weight: core.Int64Ptr(100), Target: core.StringPtr("dc1.internal.example")}
+			Expect(fields(newOptions("SRV", "ldap", srv).SetProtocol("sctp").ValidateRecord(""))).To(Equal(map[string]string{
+				"rdata.port": "is required for SRV records",
+				"service":    "is required for SRV records",
+				"protocol":   `must be one of tcp, udp or tls, got "sctp"`,
+			}))
+
+			txt, _ := service.NewResourceRecordInputRdataRdataTxtRecord(strings.Repeat("t", 300))
+			Expect(fields(newOptions("TXT", "note", txt).ValidateRecord(""))).To(Equal(map[string]string{"rdata.text": "character strings must not be longer than 255 characters, got 300"}))
+
+			Expect(fields(newOptions("NS", "sub", a).ValidateRecord(""))).To(Equal(map[string]string{"rdata": "holds A record data, but the record type is NS"}))
+			Expect(fields(newOptions("NS", "sub", &dnssvcsv1.ResourceRecordInputRdata{Cname: core.StringPtr("x")}).ValidateRecord(""))).To(Equal(map[string]string{"type": `unsupported record type "NS"`}))
+			Expect(fields(newOptions("A", "app", nil).ValidateRecord(""))).To(Equal(map[string]string{"rdata": "is required for A records"}))
+		})
+		It(`Take the type of an update from its rdata`, func() {
+			options := service.NewUpdateResourceRecordOptions("instance-id", "zone-id", "record-id", "app", &dnssvcsv1.ResourceRecordUpdateInputRdataRdataAaaaRecord{Ip: core.StringPtr("10.0.0.5")})
+			Expect(fields(options.ValidateRecord(""))).To(Equal(map[string]string{"rdata.ip": `"10.0.0.5" is not an IPv6 address`}))
+
+			options.SetRdata(&dnssvcsv1.ResourceRecordUpdateInputRdata{Exchange: core.StringPtr("mail.internal.example")})
+			Expect(fields(options.ValidateRecord(""))).To(Equal(map[string]string{"rdata.preference": "is required for MX records"}))
+		})
+	})
+})
@@ -0,0 +1,623 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package dnssync mirrors the public records of a CIS zone into an IBM Cloud
+// DNS Services private zone, so that a name can resolve to private addresses
+// inside VPCs and to public ones outside (split-horizon DNS).
+//
+// A sync lists the CIS zone with dnsrecordsv1.DnsRecordsPager, passes every
+// record through a user mapping function, lists the private zone with
+// dnssvcsv1.ResourceRecordsPager and creates, updates and deletes private
+// records until they match. Every run recomputes the plan from the current
+// state of both zones, so running it again after a success or a partial
+// failure is safe.
+package dnssync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/IBM/networking-go-sdk/zonefile"
+)
+
+// AutomaticTTL is the TTL given to private records mirrored from CIS records
+// with an automatic TTL, which CIS reports as 1.
+const AutomaticTTL int64 = 300
+
+// MapFunc maps a CIS record, already renamed into the private zone, to the
+// private records that mirror it. It returns no records to leave the CIS
+// record out of the private zone, or records with other content, such as a
+// private address, to override it.
+type MapFunc func(record zonefile.Record) ([]zonefile.Record, error)
+
+// Syncer mirrors a CIS zone into a DNS Services private zone.
+type Syncer struct {
+	Source  *dnsrecordsv1.DnsRecordsV1
+	Target  *dnssvcsv1.DnsSvcsV1
+	Options *SyncOptions
+}
+
+// SyncOptions : The options of a Syncer.
+type SyncOptions struct {
+	// The ID of the DNS Services instance.
+	InstanceID *string `validate:"required,ne="`
+
+	// The ID of the private zone.
+	DnszoneID *string `validate:"required,ne="`
+
+	// The name of the CIS zone. Taken from the listed records when not set.
+	SourceZone *string
+
+	// The name of the private zone, when it differs from the CIS zone. Names
+	// within the CIS zone, including those in CNAME, MX, PTR and SRV targets,
+	// are moved into it.
+	TargetZone *string
+
+	// Maps each CIS record to its private records. By default records are
+	// mirrored unchanged, except types DNS Services does not support.
+	Map MapFunc
+
+	// Returns true for the private records the sync may update or delete. By
+	// default every record is owned.
+	Owned func(record dnssvcsv1.ResourceRecord) bool
+
+	// Delete owned private records that no longer mirror a CIS record. When
+	// not set they are reported in Plan.Extra.
+	Prune *bool
+
+	// Compute the plan without applying it.
+	DryRun *bool
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewSyncOptions : Instantiate SyncOptions
+func NewSyncOptions(instanceID string, dnszoneID string) *SyncOptions {
+	return &SyncOptions{
+		InstanceID: core.StringPtr(instanceID),
+		DnszoneID:  core.StringPtr(dnszoneID),
+	}
+}
+
+// SetInstanceID : Allow user to set InstanceID
+func (_options *SyncOptions) SetInstanceID(instanceID string) *SyncOptions {
+	_options.InstanceID = core.StringPtr(instanceID)
+	return _options
+}
+
+// SetDnszoneID : Allow user to set DnszoneID
+func (_options *SyncOptions) SetDnszoneID(dnszoneID string) *SyncOptions {
+	_options.DnszoneID = core.StringPtr(dnszoneID)
+	return _options
+}
+
+// SetSourceZone : Allow user to set SourceZone
+func (_options *SyncOptions) SetSourceZone(sourceZone string) *SyncOptions {
+	_options.SourceZone = core.StringPtr(sourceZone)
+	return _options
+}
+
+// SetTargetZone : Allow user to set TargetZone
+func (_options *SyncOptions) SetTargetZone(targetZone string) *SyncOptions {
+	_options.TargetZone = core.StringPtr(targetZone)
+	return _options
+}
+
+// SetMap : Allow user to set Map
+func (_options *SyncOptions) SetMap(mapFunc MapFunc) *SyncOptions {
+	_options.Map = mapFunc
+	return _options
+}
+
+// SetOwned : Allow user to set Owned
+func (_options *SyncOptions) SetOwned(owned func(record dnssvcsv1.ResourceRecord) bool) *SyncOptions {
+	_options.Owned = owned
+	return _options
+}
+
+// SetPrune : Allow user to set Prune
+func (_options *SyncOptions) SetPrune(prune bool) *SyncOptions {
+	_options.Prune = core.BoolPtr(prune)
+	return _options
+}
+
+// SetDryRun : Allow user to set DryRun
+func (_options *SyncOptions) SetDryRun(dryRun bool) *SyncOptions {
+	_options.DryRun = core.BoolPtr(dryRun)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (_options *SyncOptions) SetHeaders(param map[string]string) *SyncOptions {
+	_options.Headers = param
+	return _options
+}
+
+// NewSyncer returns a Syncer that mirrors the zone of "source" into the private zone of "target" described by
+// "options".
+func NewSyncer(source *dnsrecordsv1.DnsRecordsV1, target *dnssvcsv1.DnsSvcsV1, options *SyncOptions) (syncer *Syncer, err error) {
+	if source == nil || target == nil {
+		err = core.SDKErrorf(nil, "both the CIS and the DNS Services clients are required", "missing-client", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	syncer = &Syncer{Source: source, Target: target, Options: options}
+	return
+}
+
+// Sync : Mirror the CIS zone into the private zone
+// Compute the plan and, unless DryRun is set, apply it. The plan doubles as the drift report: it lists every private
+// record that does not mirror the CIS zone.
+func (syncer *Syncer) Sync() (plan *Plan, result *Result, err error) {
+	plan, result, err = syncer.SyncWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SyncWithContext is an alternate form of the Sync method which supports a Context parameter
+func (syncer *Syncer) SyncWithContext(ctx context.Context) (plan *Plan, result *Result, err error) {
+	plan, err = syncer.PlanWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "plan-error")
+		return
+	}
+	if syncer.Options.DryRun != nil && *syncer.Options.DryRun {
+		return
+	}
+	result, err = syncer.ApplyWithContext(ctx, plan)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "apply-error")
+	}
+	return
+}
+
+// Plan : Compute the changes that mirror the CIS zone
+// List both zones and compute the changes to the private zone, without applying them.
+func (syncer *Syncer) Plan() (plan *Plan, err error) {
+	plan, err = syncer.PlanWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PlanWithContext is an alternate form of the Plan method which supports a Context parameter
+func (syncer *Syncer) PlanWithContext(ctx context.Context) (plan *Plan, err error) {
+	sourcePager, err := syncer.Source.NewDnsRecordsPager(&dnsrecordsv1.ListAllDnsRecordsOptions{
+		PerPage: core.Int64Ptr(int64(1000)),
+		Headers: syncer.Options.Headers,
+	})
+	if err != nil {
+		return
+	}
+	source, err := sourcePager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-source-error")
+		return
+	}
+
+	targetPager, err := syncer.Target.NewResourceRecordsPager(&dnssvcsv1.ListResourceRecordsOptions{
+		InstanceID: syncer.Options.InstanceID,
+		DnszoneID:  syncer.Options.DnszoneID,
+		Headers:    syncer.Options.Headers,
+	})
+	if err != nil {
+		return
+	}
+	target, err := targetPager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-target-error")
+		return
+	}
+
+	plan, err = ComputePlan(source, target, syncer.Options)
+	return
+}
+
+// Apply : Apply a sync plan
+// Delete, update and create private records as planned, in that order, so that a record that replaces another of a
+// conflicting type can be created. Apply stops at the first failure and returns what was done so far; running the
+// sync again picks up from there.
+func (syncer *Syncer) Apply(plan *Plan) (result *Result, err error) {
+	result, err = syncer.ApplyWithContext(context.Background(), plan)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ApplyWithContext is an alternate form of the Apply method which supports a Context parameter
+func (syncer *Syncer) ApplyWithContext(ctx context.Context, plan *Plan) (result *Result, err error) {
+	err = core.ValidateNotNil(plan, "plan cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+
+	options := syncer.Options
+	result = &Result{}
+	for _, record := range plan.Deletes {
+		deleteOptions := syncer.Target.NewDeleteResourceRecordOptions(*options.InstanceID, *options.DnszoneID, *record.ID)
+		deleteOptions.Headers = options.Headers
+		_, err = syncer.Target.DeleteResourceRecordWithContext(ctx, deleteOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "delete-error")
+			return
+		}
+		result.Deleted = append(result.Deleted, record)
+	}
+
+	for _, update := range plan.Updates {
+		var updateOptions *dnssvcsv1.UpdateResourceRecordOptions
+		updateOptions, err = updateResourceRecordOptions(*options.InstanceID, *options.DnszoneID, *update.Current.ID, update.Desired)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "update-options-error", common.GetComponentInfo())
+			return
+		}
+		updateOptions.Headers = options.Headers
+		var updated *dnssvcsv1.ResourceRecord
+		updated, _, err = syncer.Target.UpdateResourceRecordWithContext(ctx, updateOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "update-error")
+			return
+		}
+		result.Updated = append(result.Updated, *updated)
+	}
+
+	for _, record := range plan.Creates {
+		var createOptions *dnssvcsv1.CreateResourceRecordOptions
+		createOptions, err = zonefile.CreateResourceRecordOptions(record, *options.InstanceID, *options.DnszoneID)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "create-options-error", common.GetComponentInfo())
+			return
+		}
+		createOptions.Headers = options.Headers
+		var created *dnssvcsv1.ResourceRecord
+		created, _, err = syncer.Target.CreateResourceRecordWithContext(ctx, createOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "create-error")
+			return
+		}
+		result.Created = append(result.Created, *created)
+	}
+	return
+}
+
+// updateResourceRecordOptions returns the options that turn the record "recordID" into "record".
+func updateResourceRecordOptions(instanceID string, dnszoneID string, recordID string, record zonefile.Record) (*dnssvcsv1.UpdateResourceRecordOptions, error) {
+	createOptions, err := zonefile.CreateResourceRecordOptions(record, instanceID, dnszoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The update rdata has the same fields as the create rdata.
+	rdata := new(dnssvcsv1.ResourceRecordUpdateInputRdata)
+	b, err := json.Marshal(createOptions.Rdata)
+	if err == nil {
+		err = json.Unmarshal(b, rdata)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &dnssvcsv1.UpdateResourceRecordOptions{
+		InstanceID: core.StringPtr(instanceID),
+		DnszoneID:  core.StringPtr(dnszoneID),
+		RecordID:   core.StringPtr(recordID),
+		Name:       createOptions.Name,
+		Rdata:      rdata,
+		TTL:        createOptions.TTL,
+		Service:    createOptions.Service,
+		Protocol:   createOptions.Protocol,
+	}, nil
+}
+
+// Result : The private records changed by Apply.
+type Result struct {
+	Created []dnssvcsv1.ResourceRecord
+	Updated []dnssvcsv1.ResourceRecord
+	Deleted []dnssvcsv1.ResourceRecord
+}
+
+// Plan : The changes that bring the private zone in line with the CIS zone. Everything but Unchanged is drift.
+type Plan struct {
+	// Private records to create.
+	Creates []zonefile.Record
+
+	// Private records to change in place.
+	Updates []Update
+
+	// Owned private records that no longer mirror a CIS record.
+	Deletes []dnssvcsv1.ResourceRecord
+
+	// Private records that mirror a CIS record.
+	Unchanged []dnssvcsv1.ResourceRecord
+
+	// Private records that do not mirror a CIS record but are left alone,
+	// because Prune is not set or the records are not owned.
+	Extra []dnssvcsv1.ResourceRecord
+
+	// CIS records that are not mirrored.
+	Skipped []Skipped
+}
+
+// Update : A private record that is changed in place.
+type Update struct {
+	Current dnssvcsv1.ResourceRecord
+	Desired zonefile.Record
+}
+
+// Skipped : A CIS record that is not mirrored.
+type Skipped struct {
+	Record dnsrecordsv1.DnsrecordDetails
+	Reason string
+}
+
+// HasDrift returns true if the private zone does not mirror the CIS zone.
+func (plan *Plan) HasDrift() bool {
+	return len(plan.Creates)+len(plan.Updates)+len(plan.Deletes)+len(plan.Extra) > 0
+}
+
+// HasChanges returns true if applying the plan would change the private zone.
+func (plan *Plan) HasChanges() bool {
+	return len(plan.Creates)+len(plan.Updates)+len(plan.Deletes) > 0
+}
+
+// String renders the plan as a drift report, one record per line: "+" missing records, "~" records that differ, "-"
+// records to delete and "?" extra records that are left alone.
+func (plan *Plan) String() string {
+	var b strings.Builder
+	for _, record := range plan.Creates {
+		fmt.Fprintf(&b, "+ %s\n", describeRecord(record))
+	}
+	for _, update := range plan.Updates {
+		fmt.Fprintf(&b, "~ %s => %s\n", describeResourceRecord(update.Current), describeRecord(update.Desired))
+	}
+	for _, record := range plan.Deletes {
+		fmt.Fprintf(&b, "- %s\n", describeResourceRecord(record))
+	}
+	for _, record := range plan.Extra {
+		fmt.Fprintf(&b, "? %s\n", describeResourceRecord(record))
+	}
+	if b.Len() == 0 {
+		return "no drift\n"
+	}
+	return b.String()
+}
+
+// ComputePlan computes the changes that make the private records "target" mirror the CIS records "source".
+//
+// CIS records are converted, renamed into the private zone and passed to the Map function. Private records match a
+// mapped record on type, name and content; a matching record with another TTL is updated, and the remaining owned
+// records of the same type and name are updated in place before any is created or deleted.
+func ComputePlan(source []dnsrecordsv1.DnsrecordDetails, target []dnssvcsv1.ResourceRecord, options *SyncOptions) (plan *Plan, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+
+	sourceZone := ""
+	if options.SourceZone != nil {
+		sourceZone = normalizeName(*options.SourceZone)
+	}
+	for _, record := range source {
+		if sourceZone == "" && record.ZoneName != nil {
+			sourceZone = normalizeName(*record.ZoneName)
+		}
+	}
+	targetZone := sourceZone
+	if options.TargetZone != nil {
+		targetZone = normalizeName(*options.TargetZone)
+	}
+	mapFunc := options.Map
+	if mapFunc == nil {
+		mapFunc = DefaultMap
+	}
+
+	plan = &Plan{}
+
+	// Work out the desired private records.
+	var desired []zonefile.Record
+	seen := map[string]bool{}
+	for _, details := range source {
+		record, convertErr := zonefile.FromDnsrecordDetails(details)
+		if convertErr != nil {
+			plan.Skipped = append(plan.Skipped, Skipped{Record: details, Reason: convertErr.Error()})
+			continue
+		}
+		if record.TTL == 1 {
+			record.TTL = AutomaticTTL
+		}
+		record = renameRecord(record, sourceZone, targetZone)
+
+		var mapped []zonefile.Record
+		mapped, err = mapFunc(record)
+		if err != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("mapping %s %s failed: %s", record.Type, record.Name, err.Error()), "map-error", common.GetComponentInfo())
+			return
+		}
+		if len(mapped) == 0 {
+			plan.Skipped = append(plan.Skipped, Skipped{Record: details, Reason: "left out by the mapping"})
+			continue
+		}
+		for _, mappedRecord := range mapped {
+			if _, rdataErr := zonefile.ResourceRecordRdata(mappedRecord); rdataErr != nil {
+				err = core.SDKErrorf(rdataErr, fmt.Sprintf("the mapping of %s %s returned an invalid record: %s", record.Type, record.Name, rdataErr.Error()), "map-error", common.GetComponentInfo())
+				return
+			}
+			key := recordKey(mappedRecord)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			desired = append(desired, mappedRecord)
+		}
+	}
+
+	// Match the private records against them.
+	desiredByKey := map[string]int{}
+	for i, record := range desired {
+		desiredByKey[recordKey(record)] = i
+	}
+	matched := make([]bool, len(desired))
+	var unmatched []dnssvcsv1.ResourceRecord
+	for _, resourceRecord := range target {
+		current, convertErr := zonefile.FromResourceRecord(resourceRecord)
+		owned := convertErr == nil && (options.Owned == nil || options.Owned(resourceRecord))
+		if i, ok := desiredByKey[recordKey(current)]; ok && convertErr == nil && !matched[i] {
+			matched[i] = true
+			if current.TTL == desired[i].TTL || !owned {
+				plan.Unchanged = append(plan.Unchanged, resourceRecord)
+			} else {
+				plan.Updates = append(plan.Updates, Update{Current: resourceRecord, Desired: desired[i]})
+			}
+			continue
+		}
+		if !owned {
+			plan.Extra = append(plan.Extra, resourceRecord)
+			continue
+		}
+		unmatched = append(unmatched, resourceRecord)
+	}
+
+	// Reuse leftover records of the same type and name instead of deleting and creating.
+	pending := map[string][]int{}
+	for i, record := range desired {
+		if !matched[i] {
+			pending[record.Type+" "+record.Name] = append(pending[record.Type+" "+record.Name], i)
+		}
+	}
+	for _, resourceRecord := range unmatched {
+		current, _ := zonefile.FromResourceRecord(resourceRecord)
+		typeName := current.Type + " " + current.Name
+		if indexes := pending[typeName]; len(indexes) > 0 {
+			matched[indexes[0]] = true
+			pending[typeName] = indexes[1:]
+			plan.Updates = append(plan.Updates, Update{Current: resourceRecord, Desired: desired[indexes[0]]})
+			continue
+		}
+		if options.Prune != nil && *options.Prune {
+			plan.Deletes = append(plan.Deletes, resourceRecord)
+		} else {
+			plan.Extra = append(plan.Extra, resourceRecord)
+		}
+	}
+	for i, record := range desired {
+		if !matched[i] {
+			plan.Creates = append(plan.Creates, record)
+		}
+	}
+	return
+}
+
+// DefaultMap mirrors a record unchanged, leaving out the types DNS Services does not support.
+func DefaultMap(record zonefile.Record) ([]zonefile.Record, error) {
+	switch record.Type {
+	case zonefile.TypeA, zonefile.TypeAaaa, zonefile.TypeCname, zonefile.TypeMx, zonefile.TypePtr, zonefile.TypeSrv, zonefile.TypeTxt:
+		return []zonefile.Record{record}, nil
+	}
+	return nil, nil
+}
+
+// OverrideAddresses returns a MapFunc that replaces the address of A and AAAA records by the private addresses given
+// for their name, and mirrors other records with DefaultMap. Names are fully qualified.
+func OverrideAddresses(addresses map[string][]string) MapFunc {
+	overrides := map[string][]string{}
+	for name, ips := range addresses {
+		overrides[normalizeName(name)] = ips
+	}
+	return func(record zonefile.Record) ([]zonefile.Record, error) {
+		ips, ok := overrides[record.Name]
+		if !ok || (record.Type != zonefile.TypeA && record.Type != zonefile.TypeAaaa) {
+			return DefaultMap(record)
+		}
+		var records []zonefile.Record
+		for _, ip := range ips {
+			recordType := zonefile.TypeA
+			if strings.Contains(ip, ":") {
+				recordType = zonefile.TypeAaaa
+			}
+			if recordType == record.Type {
+				records = append(records, zonefile.Record{Name: record.Name, TTL: record.TTL, Type: recordType, Rdata: []string{ip}})
+			}
+		}
+		return records, nil
+	}
+}
+
+// renameRecord moves the names of "record" that are within "sourceZone" into "targetZone".
+func renameRecord(record zonefile.Record, sourceZone string, targetZone string) zonefile.Record {
+	if sourceZone == "" || sourceZone == targetZone {
+		return record
+	}
+	rename := func(name string) string {
+		if name == sourceZone {
+			return targetZone
+		}
+		if strings.HasSuffix(name, "."+sourceZone) {
+			return strings.TrimSuffix(name, sourceZone) + targetZone
+		}
+		return name
+	}
+
+	record.Name = rename(record.Name)
+	record.Rdata = append([]string(nil), record.Rdata...)
+	switch record.Type {
+	case zonefile.TypeCname, zonefile.TypePtr:
+		record.Rdata[0] = rename(record.Rdata[0])
+	case zonefile.TypeMx:
+		record.Rdata[1] = rename(record.Rdata[1])
+	case zonefile.TypeSrv:
+		record.Rdata[3] = rename(record.Rdata[3])
+	}
+	return record
+}
+
+func recordKey(record zonefile.Record) string {
+	rdata := record.Rdata
+	if record.Type == zonefile.TypeTxt {
+		rdata = []string{strings.Join(rdata, "")}
+	}
+	return record.Type + " " + record.Name + " " + strings.Join(rdata, " ")
+}
+
+func normalizeName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+}
+
+func describeRecord(record zonefile.Record) string {
+	return fmt.Sprintf("%s ttl=%d", recordKey(record), record.TTL)
+}
+
+func describeResourceRecord(resourceRecord dnssvcsv1.ResourceRecord) string {
+	if record, err := zonefile.FromResourceRecord(resourceRecord); err == nil {
+		return describeRecord(record)
+	}
+	return fmt.Sprintf("%s %s", core.StringNilMapper(resourceRecord.Type), core.StringNilMapper(resourceRecord.Name))
+}
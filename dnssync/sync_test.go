@@ -0,0 +1,240 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnssync
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/IBM/networking-go-sdk/zonefile"
+	"github.com/stretchr/testify/assert"
+)
+
+func cisRecords() []dnsrecordsv1.DnsrecordDetails {
+	srvData := map[string]interface{}{"service": "_sip", "proto": "_udp", "name": "example.com", "priority": 10.0, "weight": 60.0, "port": 5060.0, "target": "sip.example.com"}
+	return []dnsrecordsv1.DnsrecordDetails{
+		{ID: core.StringPtr("c1"), Name: core.StringPtr("www.example.com"), Type: core.StringPtr("A"), Content: core.StringPtr("203.0.113.10"), TTL: core.Int64Ptr(1), ZoneName: core.StringPtr("example.com")},
+		{ID: core.StringPtr("c2"), Name: core.StringPtr("api.example.com"), Type: core.StringPtr("A"), Content: core.StringPtr("203.0.113.20"), TTL: core.Int64Ptr(300), ZoneName: core.StringPtr("example.com")},
+		{ID: core.StringPtr("c3"), Name: core.StringPtr("app.example.com"), Type: core.StringPtr("CNAME"), Content: core.StringPtr("www.example.com"), TTL: core.Int64Ptr(300), ZoneName: core.StringPtr("example.com")},
+		{ID: core.StringPtr("c4"), Name: core.StringPtr("example.com"), Type: core.StringPtr("MX"), Content: core.StringPtr("mail.example.com"), Priority: core.Int64Ptr(10), TTL: core.Int64Ptr(300), ZoneName: core.StringPtr("example.com")},
+		{ID: core.StringPtr("c5"), Name: core.StringPtr("example.com"), Type: core.StringPtr("NS"), Content: core.StringPtr("ns1.example.net"), TTL: core.Int64Ptr(86400), ZoneName: core.StringPtr("example.com")},
+		{ID: core.StringPtr("c6"), Name: core.StringPtr("_sip._udp.example.com"), Type: core.StringPtr("SRV"), Data: srvData, TTL: core.Int64Ptr(300), ZoneName: core.StringPtr("example.com")},
+	}
+}
+
+// privateZone is an in-memory DNS Services zone.
+type privateZone struct {
+	sync.Mutex
+	records  map[string]map[string]interface{}
+	nextID   int
+	requests []string
+}
+
+func newPrivateZone() *privateZone {
+	zone := &privateZone{records: map[string]map[string]interface{}{}}
+	zone.add(map[string]interface{}{"name": "www.example.com", "type": "A", "ttl": 300, "rdata": map[string]interface{}{"ip": "203.0.113.10"}})
+	zone.add(map[string]interface{}{"name": "api.example.com", "type": "A", "ttl": 300, "rdata": map[string]interface{}{"ip": "10.0.0.9"}})
+	zone.add(map[string]interface{}{"name": "old.example.com", "type": "A", "ttl": 300, "rdata": map[string]interface{}{"ip": "10.0.0.99"}})
+	zone.add(map[string]interface{}{"name": "internal.example.com", "type": "TXT", "ttl": 300, "rdata": map[string]interface{}{"text": "keep"}})
+	zone.requests = nil
+	return zone
+}
+
+func (zone *privateZone) add(record map[string]interface{}) map[string]interface{} {
+	zone.nextID++
+	record["id"] = fmt.Sprintf("r%d", zone.nextID)
+	zone.records[record["id"].(string)] = record
+	return record
+}
+
+func (zone *privateZone) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	zone.Lock()
+	defer zone.Unlock()
+
+	res.Header().Set("Content-type", "application/json")
+	const prefix = "/instances/instance-id/dnszones/zone-id/resource_records"
+	if !strings.HasPrefix(req.URL.Path, prefix) {
+		res.WriteHeader(404)
+		return
+	}
+	id := strings.TrimPrefix(strings.TrimPrefix(req.URL.Path, prefix), "/")
+	if req.Method != http.MethodGet {
+		zone.requests = append(zone.requests, req.Method+" "+id)
+	}
+
+	var body map[string]interface{}
+	if req.Body != nil {
+		_ = json.NewDecoder(req.Body).Decode(&body)
+	}
+	switch req.Method {
+	case http.MethodGet:
+		records := []map[string]interface{}{}
+		for i := 1; i <= zone.nextID; i++ {
+			if record, ok := zone.records[fmt.Sprintf("r%d", i)]; ok {
+				records = append(records, record)
+			}
+		}
+		b, _ := json.Marshal(map[string]interface{}{
+			"resource_records": records, "offset": 0, "limit": 200, "count": len(records), "total_count": len(records),
+			"first": map[string]interface{}{"href": "http://example.com?offset=0"}, "last": map[string]interface{}{"href": "http://example.com?offset=0"},
+		})
+		res.WriteHeader(200)
+		_, _ = res.Write(b)
+	case http.MethodPost:
+		record := zone.add(body)
+		b, _ := json.Marshal(record)
+		res.WriteHeader(200)
+		_, _ = res.Write(b)
+	case http.MethodPut:
+		record, ok := zone.records[id]
+		if !ok {
+			res.WriteHeader(404)
+			return
+		}
+		for key, value := range body {
+			record[key] = value
+		}
+		b, _ := json.Marshal(record)
+		res.WriteHeader(200)
+		_, _ = res.Write(b)
+	case http.MethodDelete:
+		delete(zone.records, id)
+		res.WriteHeader(204)
+	}
+}
+
+func newTestSyncer(t *testing.T, zone *privateZone, options *SyncOptions) (*Syncer, func()) {
+	cisServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		records, _ := json.Marshal(cisRecords())
+		res.Header().Set("Content-type", "application/json")
+		res.WriteHeader(200)
+		fmt.Fprintf(res, `{"success": true, "errors": [], "messages": [], "result": %s, "result_info": {"page": 1, "per_page": 1000, "count": 6, "total_count": 6}}`, records)
+	}))
+	dnsSvcsServer := httptest.NewServer(zone)
+
+	source, err := dnsrecordsv1.NewDnsRecordsV1(&dnsrecordsv1.DnsRecordsV1Options{
+		URL:            cisServer.URL,
+		Authenticator:  &core.NoAuthAuthenticator{},
+		Crn:            core.StringPtr("crn"),
+		ZoneIdentifier: core.StringPtr("zone"),
+	})
+	assert.Nil(t, err)
+	target, err := dnssvcsv1.NewDnsSvcsV1(&dnssvcsv1.DnsSvcsV1Options{
+		URL:           dnsSvcsServer.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	syncer, err := NewSyncer(source, target, options)
+	assert.Nil(t, err)
+	return syncer, func() {
+		cisServer.Close()
+		dnsSvcsServer.Close()
+	}
+}
+
+func TestSyncIsIdempotent(t *testing.T) {
+	zone := newPrivateZone()
+	options := NewSyncOptions("instance-id", "zone-id").
+		SetMap(OverrideAddresses(map[string][]string{"api.example.com.": {"10.0.0.20"}})).
+		SetOwned(func(record dnssvcsv1.ResourceRecord) bool {
+			return *record.Name != "internal.example.com"
+		}).
+		SetPrune(true)
+	syncer, closeServers := newTestSyncer(t, zone, options)
+	defer closeServers()
+
+	plan, result, err := syncer.Sync()
+	assert.Nil(t, err)
+	assert.Equal(t, "+ CNAME app.example.com www.example.com ttl=300\n"+
+		"+ MX example.com 10 mail.example.com ttl=300\n"+
+		"+ SRV _sip._udp.example.com 10 60 5060 sip.example.com ttl=300\n"+
+		"~ A api.example.com 10.0.0.9 ttl=300 => A api.example.com 10.0.0.20 ttl=300\n"+
+		"- A old.example.com 10.0.0.99 ttl=300\n"+
+		"? TXT internal.example.com keep ttl=300\n", plan.String())
+	assert.Len(t, plan.Unchanged, 1)
+	assert.Len(t, plan.Skipped, 1)
+	assert.Equal(t, "c5", *plan.Skipped[0].Record.ID)
+	assert.Equal(t, []string{"DELETE r3", "PUT r2", "POST ", "POST ", "POST "}, zone.requests)
+	assert.Len(t, result.Created, 3)
+	assert.Len(t, result.Updated, 1)
+	assert.Len(t, result.Deleted, 1)
+
+	srv := zone.records["r7"]
+	assert.Equal(t, "example.com", srv["name"])
+	assert.Equal(t, "_sip", srv["service"])
+	assert.Equal(t, "udp", srv["protocol"])
+
+	// A second run finds nothing to do.
+	zone.requests = nil
+	plan, result, err = syncer.Sync()
+	assert.Nil(t, err)
+	assert.False(t, plan.HasChanges())
+	assert.True(t, plan.HasDrift())
+	assert.Len(t, plan.Unchanged, 5)
+	assert.Empty(t, zone.requests)
+	assert.Empty(t, result.Created)
+}
+
+func TestSyncDryRunReportsDrift(t *testing.T) {
+	zone := newPrivateZone()
+	syncer, closeServers := newTestSyncer(t, zone, NewSyncOptions("instance-id", "zone-id").SetDryRun(true))
+	defer closeServers()
+
+	plan, result, err := syncer.Sync()
+	assert.Nil(t, err)
+	assert.Nil(t, result)
+	assert.Empty(t, zone.requests)
+	assert.True(t, plan.HasDrift())
+	assert.Len(t, plan.Creates, 3)
+	assert.Equal(t, "~ A api.example.com 10.0.0.9 ttl=300 => A api.example.com 203.0.113.20 ttl=300", strings.Split(plan.String(), "\n")[3])
+	assert.Len(t, plan.Deletes, 0)
+	assert.Len(t, plan.Extra, 2)
+}
+
+func TestComputePlanRenamesIntoTargetZone(t *testing.T) {
+	options := NewSyncOptions("instance-id", "zone-id").
+		SetTargetZone("example.internal.").
+		SetMap(func(record zonefile.Record) ([]zonefile.Record, error) {
+			if record.Type != zonefile.TypeCname {
+				return nil, nil
+			}
+			return []zonefile.Record{record}, nil
+		})
+	plan, err := ComputePlan(cisRecords(), nil, options)
+	assert.Nil(t, err)
+	assert.Equal(t, "+ CNAME app.example.internal www.example.internal ttl=300\n", plan.String())
+	assert.Len(t, plan.Skipped, 5)
+
+	options.SetMap(func(record zonefile.Record) ([]zonefile.Record, error) {
+		return []zonefile.Record{{Name: record.Name, Type: zonefile.TypeA, Rdata: []string{"not-an-ip"}}}, nil
+	})
+	_, err = ComputePlan(cisRecords(), nil, options)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "returned an invalid record")
+
+	_, err = NewSyncer(nil, nil, options)
+	assert.NotNil(t, err)
+}
@@ -106,6 +106,12 @@ func (dnsZones *DnsZonesV1) SetServiceURL(url string) error {
 	return dnsZones.Service.SetServiceURL(url)
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (dnsZones *DnsZonesV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(dnsZones.Service, policy)
+}
+
 // ListDnszones : List DNS zones
 // List the DNS zones for a given service instance.
 func (dnsZones *DnsZonesV1) ListDnszones(listDnszonesOptions *ListDnszonesOptions) (result *ListDnszones, response *core.DetailedResponse, err error) {
@@ -158,6 +164,7 @@ func (dnsZones *DnsZonesV1) ListDnszones(listDnszonesOptions *ListDnszonesOption
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsZones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListDnszones)
@@ -227,6 +234,7 @@ func (dnsZones *DnsZonesV1) CreateDnszone(createDnszoneOptions *CreateDnszoneOpt
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsZones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDnszone)
@@ -277,6 +285,7 @@ func (dnsZones *DnsZonesV1) DeleteDnszone(deleteDnszoneOptions *DeleteDnszoneOpt
 	}
 
 	response, err = dnsZones.Service.Request(request, nil)
+	err = common.NewAPIError(err, response)
 
 	return
 }
@@ -323,6 +332,7 @@ func (dnsZones *DnsZonesV1) GetDnszone(getDnszoneOptions *GetDnszoneOptions) (re
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsZones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDnszone)
@@ -389,6 +399,7 @@ func (dnsZones *DnsZonesV1) UpdateDnszone(updateDnszoneOptions *UpdateDnszoneOpt
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsZones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDnszone)
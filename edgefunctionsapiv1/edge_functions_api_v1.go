@@ -180,6 +180,12 @@ func (edgeFunctionsApi *EdgeFunctionsApiV1) DisableRetries() {
 	edgeFunctionsApi.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (edgeFunctionsApi *EdgeFunctionsApiV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(edgeFunctionsApi.Service, policy)
+}
+
 // ListEdgeFunctionsActions : Get all edge functions scripts for a given instance
 // Get all edge functions scripts for a given instance.
 func (edgeFunctionsApi *EdgeFunctionsApiV1) ListEdgeFunctionsActions(listEdgeFunctionsActionsOptions *ListEdgeFunctionsActionsOptions) (result *ListEdgeFunctionsActionsResp, response *core.DetailedResponse, err error) {
@@ -226,6 +232,7 @@ func (edgeFunctionsApi *EdgeFunctionsApiV1) ListEdgeFunctionsActionsWithContext(
 	var rawResponse map[string]json.RawMessage
 	response, err = edgeFunctionsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListEdgeFunctionsActionsResp)
@@ -294,6 +301,7 @@ func (edgeFunctionsApi *EdgeFunctionsApiV1) UpdateEdgeFunctionsActionWithContext
 	var rawResponse map[string]json.RawMessage
 	response, err = edgeFunctionsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalGetEdgeFunctionsActionResp)
@@ -354,6 +362,7 @@ func (edgeFunctionsApi *EdgeFunctionsApiV1) GetEdgeFunctionsActionWithContext(ct
 	}
 
 	response, err = edgeFunctionsApi.Service.Request(request, &result)
+	err = common.NewAPIError(err, response)
 
 	return
 }
@@ -409,6 +418,7 @@ func (edgeFunctionsApi *EdgeFunctionsApiV1) DeleteEdgeFunctionsActionWithContext
 	var rawResponse map[string]json.RawMessage
 	response, err = edgeFunctionsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDeleteEdgeFunctionsActionResp)
@@ -480,6 +490,7 @@ func (edgeFunctionsApi *EdgeFunctionsApiV1) CreateEdgeFunctionsTriggerWithContex
 	var rawResponse map[string]json.RawMessage
 	response, err = edgeFunctionsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalCreateEdgeFunctionsTriggerResp)
@@ -538,6 +549,7 @@ func (edgeFunctionsApi *EdgeFunctionsApiV1) ListEdgeFunctionsTriggersWithContext
 	var rawResponse map[string]json.RawMessage
 	response, err = edgeFunctionsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListEdgeFunctionsTriggersResp)
@@ -601,6 +613,7 @@ func (edgeFunctionsApi *EdgeFunctionsApiV1) GetEdgeFunctionsTriggerWithContext(c
 	var rawResponse map[string]json.RawMessage
 	response, err = edgeFunctionsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalGetEdgeFunctionsTriggerResp)
@@ -677,6 +690,7 @@ func (edgeFunctionsApi *EdgeFunctionsApiV1) UpdateEdgeFunctionsTriggerWithContex
 	var rawResponse map[string]json.RawMessage
 	response, err = edgeFunctionsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalGetEdgeFunctionsTriggerResp)
@@ -740,6 +754,7 @@ func (edgeFunctionsApi *EdgeFunctionsApiV1) DeleteEdgeFunctionsTriggerWithContex
 	var rawResponse map[string]json.RawMessage
 	response, err = edgeFunctionsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalCreateEdgeFunctionsTriggerResp)
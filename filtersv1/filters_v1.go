@@ -159,6 +159,12 @@ func (filters *FiltersV1) DisableRetries() {
 	filters.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (filters *FiltersV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(filters.Service, policy)
+}
+
 // ListAllFilters : List all filters for a zone
 // List all filters for a zone.
 func (filters *FiltersV1) ListAllFilters(listAllFiltersOptions *ListAllFiltersOptions) (result *ListFiltersResp, response *core.DetailedResponse, err error) {
@@ -210,6 +216,7 @@ func (filters *FiltersV1) ListAllFiltersWithContext(ctx context.Context, listAll
 	var rawResponse map[string]json.RawMessage
 	response, err = filters.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -282,6 +289,7 @@ func (filters *FiltersV1) CreateFilterWithContext(ctx context.Context, createFil
 	var rawResponse map[string]json.RawMessage
 	response, err = filters.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -354,6 +362,7 @@ func (filters *FiltersV1) UpdateFiltersWithContext(ctx context.Context, updateFi
 	var rawResponse map[string]json.RawMessage
 	response, err = filters.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -420,6 +429,7 @@ func (filters *FiltersV1) DeleteFiltersWithContext(ctx context.Context, deleteFi
 	var rawResponse map[string]json.RawMessage
 	response, err = filters.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -485,6 +495,7 @@ func (filters *FiltersV1) DeleteFilterWithContext(ctx context.Context, deleteFil
 	var rawResponse map[string]json.RawMessage
 	response, err = filters.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -550,6 +561,7 @@ func (filters *FiltersV1) GetFilterWithContext(ctx context.Context, getFilterOpt
 	var rawResponse map[string]json.RawMessage
 	response, err = filters.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -634,6 +646,7 @@ func (filters *FiltersV1) UpdateFilterWithContext(ctx context.Context, updateFil
 	var rawResponse map[string]json.RawMessage
 	response, err = filters.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
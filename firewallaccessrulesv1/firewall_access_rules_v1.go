@@ -172,6 +172,12 @@ func (firewallAccessRules *FirewallAccessRulesV1) DisableRetries() {
 	firewallAccessRules.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (firewallAccessRules *FirewallAccessRulesV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(firewallAccessRules.Service, policy)
+}
+
 // ListAllAccountAccessRules : List instance level firewall access rules
 // List all instance level firewall access rules.
 func (firewallAccessRules *FirewallAccessRulesV1) ListAllAccountAccessRules(listAllAccountAccessRulesOptions *ListAllAccountAccessRulesOptions) (result *ListAccountAccessRulesResp, response *core.DetailedResponse, err error) {
@@ -243,6 +249,7 @@ func (firewallAccessRules *FirewallAccessRulesV1) ListAllAccountAccessRulesWithC
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallAccessRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListAccountAccessRulesResp)
@@ -313,6 +320,7 @@ func (firewallAccessRules *FirewallAccessRulesV1) CreateAccountAccessRuleWithCon
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallAccessRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAccountAccessRuleResp)
@@ -372,6 +380,7 @@ func (firewallAccessRules *FirewallAccessRulesV1) DeleteAccountAccessRuleWithCon
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallAccessRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDeleteAccountAccessRuleResp)
@@ -431,6 +440,7 @@ func (firewallAccessRules *FirewallAccessRulesV1) GetAccountAccessRuleWithContex
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallAccessRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAccountAccessRuleResp)
@@ -503,6 +513,7 @@ func (firewallAccessRules *FirewallAccessRulesV1) UpdateAccountAccessRuleWithCon
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallAccessRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAccountAccessRuleResp)
@@ -1176,3 +1187,96 @@ func UnmarshalListAccountAccessRulesResp(m map[string]json.RawMessage, result in
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// Retrieve the value to be passed to a request to access the next page of results
+func (resp *ListAccountAccessRulesResp) GetNextPage() (*int64, error) {
+	if core.IsNil(resp.ResultInfo) || resp.ResultInfo.Page == nil || resp.ResultInfo.PerPage == nil || resp.ResultInfo.TotalCount == nil {
+		return nil, nil
+	}
+	if resp.ResultInfo.Count != nil && *resp.ResultInfo.Count == 0 {
+		return nil, nil
+	}
+	if *resp.ResultInfo.Page**resp.ResultInfo.PerPage >= *resp.ResultInfo.TotalCount {
+		return nil, nil
+	}
+	return core.Int64Ptr(*resp.ResultInfo.Page + 1), nil
+}
+
+// AccountAccessRulesPager can be used to simplify the use of the "ListAllAccountAccessRules" method.
+type AccountAccessRulesPager struct {
+	hasNext     bool
+	options     *ListAllAccountAccessRulesOptions
+	client      *FirewallAccessRulesV1
+	pageContext struct {
+		next *int64
+	}
+}
+
+// NewAccountAccessRulesPager returns a new AccountAccessRulesPager instance.
+func (firewallAccessRules *FirewallAccessRulesV1) NewAccountAccessRulesPager(options *ListAllAccountAccessRulesOptions) (pager *AccountAccessRulesPager, err error) {
+	if options.Page != nil && *options.Page > 1 {
+		err = fmt.Errorf("the 'options.Page' field should not be set")
+		return
+	}
+
+	var optionsCopy ListAllAccountAccessRulesOptions = *options
+	pager = &AccountAccessRulesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  firewallAccessRules,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *AccountAccessRulesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *AccountAccessRulesPager) GetNextWithContext(ctx context.Context) (page []AccountAccessRuleObject, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Page = pager.pageContext.next
+
+	result, _, err := pager.client.ListAllAccountAccessRulesWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+
+	next, err := result.GetNextPage()
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Result
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *AccountAccessRulesPager) GetAllWithContext(ctx context.Context) (allItems []AccountAccessRuleObject, err error) {
+	for pager.HasNext() {
+		var nextPage []AccountAccessRuleObject
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *AccountAccessRulesPager) GetNext() (page []AccountAccessRuleObject, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *AccountAccessRulesPager) GetAll() (allItems []AccountAccessRuleObject, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
@@ -387,6 +387,119 @@ var _ = Describe(`FirewallAccessRulesV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listAllAccountAccessRulesPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						Expect(req.URL.Query()["page"]).To(BeNil())
+						fmt.Fprintf(res, "%s", `{"success": true, "errors": [["Errors"]], "messages": [["Messages"]], "result": [{"id": "92f17202ed8bd63d69a66b86a49a8f6b", "notes": "This rule is set because of an event that occurred and caused X.", "allowed_modes": ["block"], "mode": "block", "scope": {"type": "account"}, "created_on": "2019-01-01T12:00:00", "modified_on": "2019-01-01T12:00:00", "configuration": {"target": "ip", "value": "ip example 198.51.100.4; ip_range example 198.51.100.4/16 ; asn example AS12345; country example AZ"}}], "result_info": {"page": 1, "per_page": 1, "count": 1, "total_count": 2}}`)
+					} else if requestNumber == 2 {
+						Expect(req.URL.Query()["page"]).To(Equal([]string{fmt.Sprint(int64(2))}))
+						fmt.Fprintf(res, "%s", `{"success": true, "errors": [["Errors"]], "messages": [["Messages"]], "result": [{"id": "92f17202ed8bd63d69a66b86a49a8f6b", "notes": "This rule is set because of an event that occurred and caused X.", "allowed_modes": ["block"], "mode": "block", "scope": {"type": "account"}, "created_on": "2019-01-01T12:00:00", "modified_on": "2019-01-01T12:00:00", "configuration": {"target": "ip", "value": "ip example 198.51.100.4; ip_range example 198.51.100.4/16 ; asn example AS12345; country example AZ"}}], "result_info": {"page": 2, "per_page": 1, "count": 1, "total_count": 2}}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use AccountAccessRulesPager.GetNext successfully`, func() {
+				firewallAccessRulesService, serviceErr := firewallaccessrulesv1.NewFirewallAccessRulesV1(&firewallaccessrulesv1.FirewallAccessRulesV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+					Crn:           core.StringPtr(crn),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(firewallAccessRulesService).ToNot(BeNil())
+
+				// Construct an instance of the ListAllAccountAccessRulesOptions model
+				listAllAccountAccessRulesOptionsModel := new(firewallaccessrulesv1.ListAllAccountAccessRulesOptions)
+				listAllAccountAccessRulesOptionsModel.Notes = core.StringPtr("testString")
+				listAllAccountAccessRulesOptionsModel.Mode = core.StringPtr("block")
+				listAllAccountAccessRulesOptionsModel.ConfigurationTarget = core.StringPtr("ip")
+				listAllAccountAccessRulesOptionsModel.ConfigurationValue = core.StringPtr("1.2.3.4")
+				listAllAccountAccessRulesOptionsModel.Order = core.StringPtr("target")
+				listAllAccountAccessRulesOptionsModel.Direction = core.StringPtr("asc")
+				listAllAccountAccessRulesOptionsModel.Match = core.StringPtr("any")
+
+				pager, err := firewallAccessRulesService.NewAccountAccessRulesPager(listAllAccountAccessRulesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []firewallaccessrulesv1.AccountAccessRuleObject
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use AccountAccessRulesPager.GetAll successfully`, func() {
+				firewallAccessRulesService, serviceErr := firewallaccessrulesv1.NewFirewallAccessRulesV1(&firewallaccessrulesv1.FirewallAccessRulesV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+					Crn:           core.StringPtr(crn),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(firewallAccessRulesService).ToNot(BeNil())
+
+				// Construct an instance of the ListAllAccountAccessRulesOptions model
+				listAllAccountAccessRulesOptionsModel := new(firewallaccessrulesv1.ListAllAccountAccessRulesOptions)
+				listAllAccountAccessRulesOptionsModel.Notes = core.StringPtr("testString")
+				listAllAccountAccessRulesOptionsModel.Mode = core.StringPtr("block")
+				listAllAccountAccessRulesOptionsModel.ConfigurationTarget = core.StringPtr("ip")
+				listAllAccountAccessRulesOptionsModel.ConfigurationValue = core.StringPtr("1.2.3.4")
+				listAllAccountAccessRulesOptionsModel.Order = core.StringPtr("target")
+				listAllAccountAccessRulesOptionsModel.Direction = core.StringPtr("asc")
+				listAllAccountAccessRulesOptionsModel.Match = core.StringPtr("any")
+
+				pager, err := firewallAccessRulesService.NewAccountAccessRulesPager(listAllAccountAccessRulesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Invoke NewAccountAccessRulesPager with a page already set`, func() {
+				firewallAccessRulesService, serviceErr := firewallaccessrulesv1.NewFirewallAccessRulesV1(&firewallaccessrulesv1.FirewallAccessRulesV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+					Crn:           core.StringPtr(crn),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(firewallAccessRulesService).ToNot(BeNil())
+
+				// Construct an instance of the ListAllAccountAccessRulesOptions model
+				listAllAccountAccessRulesOptionsModel := new(firewallaccessrulesv1.ListAllAccountAccessRulesOptions)
+				listAllAccountAccessRulesOptionsModel.Notes = core.StringPtr("testString")
+				listAllAccountAccessRulesOptionsModel.Mode = core.StringPtr("block")
+				listAllAccountAccessRulesOptionsModel.ConfigurationTarget = core.StringPtr("ip")
+				listAllAccountAccessRulesOptionsModel.ConfigurationValue = core.StringPtr("1.2.3.4")
+				listAllAccountAccessRulesOptionsModel.Order = core.StringPtr("target")
+				listAllAccountAccessRulesOptionsModel.Direction = core.StringPtr("asc")
+				listAllAccountAccessRulesOptionsModel.Match = core.StringPtr("any")
+				listAllAccountAccessRulesOptionsModel.Page = core.Int64Ptr(int64(3))
+
+				pager, err := firewallAccessRulesService.NewAccountAccessRulesPager(listAllAccountAccessRulesOptionsModel)
+				Expect(err).ToNot(BeNil())
+				Expect(pager).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
 	})
 	Describe(`CreateAccountAccessRule(createAccountAccessRuleOptions *CreateAccountAccessRuleOptions) - Operation response error`, func() {
 		crn := "testString"
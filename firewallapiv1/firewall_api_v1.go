@@ -178,6 +178,12 @@ func (firewallApi *FirewallApiV1) DisableRetries() {
 	firewallApi.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (firewallApi *FirewallApiV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(firewallApi.Service, policy)
+}
+
 // GetSecurityLevelSetting : Get security level setting
 // For a given zone identifier, get security level setting.
 func (firewallApi *FirewallApiV1) GetSecurityLevelSetting(getSecurityLevelSettingOptions *GetSecurityLevelSettingOptions) (result *SecurityLevelSettingResp, response *core.DetailedResponse, err error) {
@@ -222,6 +228,7 @@ func (firewallApi *FirewallApiV1) GetSecurityLevelSettingWithContext(ctx context
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSecurityLevelSettingResp)
@@ -287,6 +294,7 @@ func (firewallApi *FirewallApiV1) SetSecurityLevelSettingWithContext(ctx context
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSecurityLevelSettingResp)
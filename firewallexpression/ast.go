@@ -0,0 +1,247 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package firewallexpression reads, checks and formats the wirefilter
+// expressions of filtersv1 filters, firewallrulesv1 rules and rulesetsv1
+// rules, such as
+//
+//	(http.request.uri.path contains "/admin" and ip.src ne 1.2.3.4)
+//
+// Parse turns an expression into a syntax tree of Nodes. Lint reports the
+// mistakes the API would reject or that are likely unintended: unknown fields
+// and functions, operators and values that don't suit a field, regular
+// expressions that don't compile and clauses that are always true. Format
+// rewrites an expression in canonical form, so expressions kept in source
+// control can be checked by tests and pre-commit hooks.
+//
+// Evaluate matches an expression against a synthetic Request, and a Simulator
+// runs firewall rules or ruleset rules against one in the order the edge runs
+// them, reporting the rule that ends the evaluation and its action, so rule
+// changes can be reviewed as unit tests before they are deployed.
+package firewallexpression
+
+import (
+	"net/netip"
+	"sort"
+)
+
+// Logical operators, in canonical form.
+const (
+	OpAnd = "and"
+	OpOr  = "or"
+	OpXor = "xor"
+)
+
+// Comparison operators, in canonical form.
+const (
+	OpContains       = "contains"
+	OpEq             = "eq"
+	OpGe             = "ge"
+	OpGt             = "gt"
+	OpIn             = "in"
+	OpLe             = "le"
+	OpLt             = "lt"
+	OpMatches        = "matches"
+	OpNe             = "ne"
+	OpStrictWildcard = "strict wildcard"
+	OpWildcard       = "wildcard"
+)
+
+// Node is a node of the syntax tree of an expression.
+type Node interface {
+	// Pos returns the byte offset of the node in the expression.
+	Pos() int
+
+	// String returns the node in canonical form.
+	String() string
+}
+
+// LogicalExpr is two or more conditions joined by the same logical operator.
+type LogicalExpr struct {
+	Offset   int
+	Op       string
+	Operands []Node
+}
+
+// NotExpr negates a condition.
+type NotExpr struct {
+	Offset  int
+	Operand Node
+}
+
+// ComparisonExpr compares a field or function result to a value.
+type ComparisonExpr struct {
+	Offset int
+	Left   Node
+	Op     string
+
+	// A StringLiteral, IntLiteral, IPLiteral or BoolLiteral, or for OpIn a SetLiteral or ListRef.
+	Right Node
+}
+
+// FieldExpr is a field, such as http.request.uri.path, with the indexes applied to it, such as
+// http.request.headers["user-agent"][0].
+type FieldExpr struct {
+	Offset  int
+	Name    string
+	Indexes []Index
+}
+
+// Index selects the value of a map key, an array element, or with All every value of a map or array.
+type Index struct {
+	Key     *string
+	Element *int64
+	All     bool
+}
+
+// CallExpr is a function call, such as lower(http.host).
+type CallExpr struct {
+	Offset int
+	Name   string
+	Args   []Node
+}
+
+// StringLiteral is a quoted or raw string.
+type StringLiteral struct {
+	Offset int
+	Value  string
+
+	// Whether the string was written as a raw string, such as r"\d+"; it is formatted the same way.
+	Raw bool
+}
+
+// IntLiteral is an integer.
+type IntLiteral struct {
+	Offset int
+	Value  int64
+}
+
+// IntRange is an inclusive range of integers in a set, such as 80..89.
+type IntRange struct {
+	Offset int
+	From   int64
+	To     int64
+}
+
+// IPLiteral is an IP address, with a full-length prefix, or a CIDR range.
+type IPLiteral struct {
+	Offset int
+	Prefix netip.Prefix
+}
+
+// IPRange is an inclusive range of IP addresses in a set, such as 192.0.2.10..192.0.2.20.
+type IPRange struct {
+	Offset int
+	From   netip.Addr
+	To     netip.Addr
+}
+
+// BoolLiteral is true or false.
+type BoolLiteral struct {
+	Offset int
+	Value  bool
+}
+
+// SetLiteral is a set of values, such as {80 443}.
+type SetLiteral struct {
+	Offset   int
+	Elements []Node
+}
+
+// ListRef is a reference to a custom or managed list, such as $office_networks.
+type ListRef struct {
+	Offset int
+	Name   string
+}
+
+func (node *LogicalExpr) Pos() int    { return node.Offset }
+func (node *NotExpr) Pos() int        { return node.Offset }
+func (node *ComparisonExpr) Pos() int { return node.Offset }
+func (node *FieldExpr) Pos() int      { return node.Offset }
+func (node *CallExpr) Pos() int       { return node.Offset }
+func (node *StringLiteral) Pos() int  { return node.Offset }
+func (node *IntLiteral) Pos() int     { return node.Offset }
+func (node *IntRange) Pos() int       { return node.Offset }
+func (node *IPLiteral) Pos() int      { return node.Offset }
+func (node *IPRange) Pos() int        { return node.Offset }
+func (node *BoolLiteral) Pos() int    { return node.Offset }
+func (node *SetLiteral) Pos() int     { return node.Offset }
+func (node *ListRef) Pos() int        { return node.Offset }
+
+// Inspect traverses the tree rooted at "node" depth-first, calling "f" for each node. When "f" returns false the
+// children of the node are skipped.
+func Inspect(node Node, f func(Node) bool) {
+	if node == nil || !f(node) {
+		return
+	}
+	switch node := node.(type) {
+	case *LogicalExpr:
+		for _, operand := range node.Operands {
+			Inspect(operand, f)
+		}
+	case *NotExpr:
+		Inspect(node.Operand, f)
+	case *ComparisonExpr:
+		Inspect(node.Left, f)
+		Inspect(node.Right, f)
+	case *CallExpr:
+		for _, arg := range node.Args {
+			Inspect(arg, f)
+		}
+	case *SetLiteral:
+		for _, element := range node.Elements {
+			Inspect(element, f)
+		}
+	}
+}
+
+// Expression is a parsed expression.
+type Expression struct {
+	Root Node
+}
+
+// String returns the expression in canonical form.
+func (expression *Expression) String() string {
+	return expression.Root.String()
+}
+
+// Fields returns the names of the fields the expression uses, sorted.
+func (expression *Expression) Fields() (fields []string) {
+	seen := map[string]bool{}
+	Inspect(expression.Root, func(node Node) bool {
+		if field, ok := node.(*FieldExpr); ok && !seen[field.Name] {
+			seen[field.Name] = true
+			fields = append(fields, field.Name)
+		}
+		return true
+	})
+	sort.Strings(fields)
+	return
+}
+
+// Lists returns the names of the lists the expression references, sorted.
+func (expression *Expression) Lists() (lists []string) {
+	seen := map[string]bool{}
+	Inspect(expression.Root, func(node Node) bool {
+		if list, ok := node.(*ListRef); ok && !seen[list.Name] {
+			seen[list.Name] = true
+			lists = append(lists, list.Name)
+		}
+		return true
+	})
+	sort.Strings(lists)
+	return
+}
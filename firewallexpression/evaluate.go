@@ -0,0 +1,735 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firewallexpression
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// EvaluationError reports an expression that can't be evaluated, such as one that references a list that wasn't
+// given.
+type EvaluationError struct {
+	// The byte offset of the problem in the expression.
+	Offset int
+	Err    error
+}
+
+func (evalErr *EvaluationError) Error() string {
+	return fmt.Sprintf("firewallexpression: offset %d: %s", evalErr.Offset, evalErr.Err)
+}
+
+func (evalErr *EvaluationError) Unwrap() error {
+	return evalErr.Err
+}
+
+// Evaluate reports whether a request matches the expression. The lists referenced by the expression, such as
+// $office_networks, are given by name with their items: IP addresses, CIDR ranges, ASNs or hostnames.
+//
+// A comparison of a map key or array element the request doesn't have is false, whatever the operator. Regular
+// expressions are evaluated with the regexp package, which accepts the same syntax as the edge for all but
+// back-references and look-arounds, which the edge rejects too.
+func (expression *Expression) Evaluate(request *Request, lists map[string][]string) (bool, error) {
+	values, err := request.values()
+	if err != nil {
+		return false, err
+	}
+	evaluator := &evaluator{values: values, lists: lists, regexps: map[string]*regexp.Regexp{}}
+	return evaluator.condition(expression.Root)
+}
+
+// unpacked is the value of an unpacked array or map, such as http.request.headers.names[*]: the values of its
+// elements.
+type unpacked []interface{}
+
+// missing is the value of a map key or array element that doesn't exist.
+type missing struct{}
+
+type evaluator struct {
+	values  map[string]interface{}
+	lists   map[string][]string
+	regexps map[string]*regexp.Regexp
+}
+
+func (evaluator *evaluator) errorf(node Node, format string, args ...interface{}) error {
+	return &EvaluationError{Offset: node.Pos(), Err: fmt.Errorf(format, args...)}
+}
+
+// condition evaluates a node used as a condition.
+func (evaluator *evaluator) condition(node Node) (bool, error) {
+	switch node := node.(type) {
+	case *LogicalExpr:
+		result := node.Op == OpAnd
+		for _, operand := range node.Operands {
+			value, err := evaluator.condition(operand)
+			if err != nil {
+				return false, err
+			}
+			switch {
+			case node.Op == OpAnd && !value:
+				return false, nil
+			case node.Op == OpOr && value:
+				return true, nil
+			case node.Op == OpXor:
+				result = result != value
+			}
+		}
+		return result, nil
+	case *NotExpr:
+		value, err := evaluator.condition(node.Operand)
+		return !value, err
+	}
+	value, err := evaluator.value(node)
+	if err != nil {
+		return false, err
+	}
+	switch value := value.(type) {
+	case bool:
+		return value, nil
+	case missing:
+		return false, nil
+	}
+	return false, evaluator.errorf(node, "%s is not a condition", node)
+}
+
+// value evaluates a node to a string, int64, bool, netip.Addr, []string, []bool, map[string][]string, unpacked or
+// missing value.
+func (evaluator *evaluator) value(node Node) (interface{}, error) {
+	switch node := node.(type) {
+	case *LogicalExpr, *NotExpr:
+		return evaluator.condition(node)
+	case *ComparisonExpr:
+		left, err := evaluator.value(node.Left)
+		if err != nil {
+			return nil, err
+		}
+		return evaluator.each(left, func(left interface{}) (interface{}, error) {
+			return evaluator.compare(left, node)
+		})
+	case *FieldExpr:
+		return evaluator.field(node)
+	case *CallExpr:
+		return evaluator.call(node)
+	case *StringLiteral:
+		return node.Value, nil
+	case *IntLiteral:
+		return node.Value, nil
+	case *BoolLiteral:
+		return node.Value, nil
+	case *IPLiteral:
+		if node.Prefix.IsSingleIP() {
+			return node.Prefix.Addr(), nil
+		}
+	}
+	return nil, evaluator.errorf(node, "%s can't be used here", node)
+}
+
+// each applies f to a value, or to each element of an unpacked value.
+func (evaluator *evaluator) each(value interface{}, f func(interface{}) (interface{}, error)) (interface{}, error) {
+	elements, ok := value.(unpacked)
+	if !ok {
+		return f(value)
+	}
+	results := make(unpacked, len(elements))
+	for i, element := range elements {
+		var err error
+		if results[i], err = f(element); err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+func (evaluator *evaluator) field(field *FieldExpr) (interface{}, error) {
+	value, ok := evaluator.values[field.Name]
+	if !ok {
+		return nil, evaluator.errorf(field, "unknown field %s", field.Name)
+	}
+	for _, index := range field.Indexes {
+		index := index
+		var err error
+		value, err = evaluator.each(value, func(value interface{}) (interface{}, error) {
+			return evaluator.index(field, value, index)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if index.All {
+			value = flatten(value)
+		}
+	}
+	return value, nil
+}
+
+// index applies an index to a map or array. With All it returns the elements as an unpacked value, which flatten
+// merges into the unpacked value it may be part of.
+func (evaluator *evaluator) index(field *FieldExpr, value interface{}, index Index) (interface{}, error) {
+	switch value := value.(type) {
+	case missing:
+		return value, nil
+	case map[string][]string:
+		switch {
+		case index.Key != nil:
+			if elements, ok := value[*index.Key]; ok {
+				return elements, nil
+			}
+			return missing{}, nil
+		case index.All:
+			elements := unpacked{}
+			for _, key := range sortedKeys(value) {
+				elements = append(elements, value[key])
+			}
+			return elements, nil
+		}
+	case []string:
+		switch {
+		case index.Element != nil:
+			if *index.Element >= 0 && *index.Element < int64(len(value)) {
+				return value[*index.Element], nil
+			}
+			return missing{}, nil
+		case index.All:
+			elements := make(unpacked, len(value))
+			for i, element := range value {
+				elements[i] = element
+			}
+			return elements, nil
+		}
+	case []bool:
+		switch {
+		case index.Element != nil:
+			if *index.Element >= 0 && *index.Element < int64(len(value)) {
+				return value[*index.Element], nil
+			}
+			return missing{}, nil
+		case index.All:
+			elements := make(unpacked, len(value))
+			for i, element := range value {
+				elements[i] = element
+			}
+			return elements, nil
+		}
+	}
+	return nil, evaluator.errorf(field, "%s can't be indexed this way", field)
+}
+
+// flatten merges the unpacked elements of an unpacked value.
+func flatten(value interface{}) interface{} {
+	elements, ok := value.(unpacked)
+	if !ok {
+		return value
+	}
+	flattened := unpacked{}
+	for _, element := range elements {
+		if inner, ok := element.(unpacked); ok {
+			flattened = append(flattened, inner...)
+		} else {
+			flattened = append(flattened, element)
+		}
+	}
+	return flattened
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// compare evaluates a comparison for a value of its left side.
+func (evaluator *evaluator) compare(left interface{}, comparison *ComparisonExpr) (interface{}, error) {
+	if _, ok := left.(missing); ok {
+		return false, nil
+	}
+	if comparison.Op == OpIn {
+		return evaluator.in(left, comparison)
+	}
+	right, err := evaluator.value(comparison.Right)
+	if err != nil {
+		return nil, err
+	}
+	switch left := left.(type) {
+	case string:
+		if right, ok := right.(string); ok {
+			return evaluator.compareStrings(left, comparison, right)
+		}
+	case int64:
+		if right, ok := right.(int64); ok {
+			return compareOrdered(comparison.Op, compareInts(left, right))
+		}
+	case netip.Addr:
+		if right, ok := right.(netip.Addr); ok {
+			switch comparison.Op {
+			case OpEq:
+				return left == right.Unmap(), nil
+			case OpNe:
+				return left != right.Unmap(), nil
+			}
+		}
+	case bool:
+		if right, ok := right.(bool); ok {
+			switch comparison.Op {
+			case OpEq:
+				return left == right, nil
+			case OpNe:
+				return left != right, nil
+			}
+		}
+	}
+	return nil, evaluator.errorf(comparison, "%s can't be evaluated; check it with Lint", comparison)
+}
+
+func (evaluator *evaluator) compareStrings(left string, comparison *ComparisonExpr, right string) (interface{}, error) {
+	switch comparison.Op {
+	case OpContains:
+		return strings.Contains(left, right), nil
+	case OpMatches:
+		re, err := evaluator.regexp(comparison, right)
+		if err != nil {
+			return nil, err
+		}
+		return re.MatchString(left), nil
+	case OpWildcard, OpStrictWildcard:
+		re, err := evaluator.regexp(comparison, wildcardRegexp(right, comparison.Op == OpStrictWildcard))
+		if err != nil {
+			return nil, err
+		}
+		return re.MatchString(left), nil
+	}
+	return compareOrdered(comparison.Op, strings.Compare(left, right))
+}
+
+// compareOrdered returns the result of an ordering operator given the result of comparing its sides.
+func compareOrdered(op string, cmp int) (interface{}, error) {
+	switch op {
+	case OpEq:
+		return cmp == 0, nil
+	case OpNe:
+		return cmp != 0, nil
+	case OpLt:
+		return cmp < 0, nil
+	case OpLe:
+		return cmp <= 0, nil
+	case OpGt:
+		return cmp > 0, nil
+	case OpGe:
+		return cmp >= 0, nil
+	}
+	return nil, fmt.Errorf("firewallexpression: unknown operator %s", op)
+}
+
+func compareInts(a int64, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// in evaluates the in operator with a set or list.
+func (evaluator *evaluator) in(left interface{}, comparison *ComparisonExpr) (interface{}, error) {
+	switch right := comparison.Right.(type) {
+	case *SetLiteral:
+		for _, element := range right.Elements {
+			if inSetElement(left, element) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case *ListRef:
+		items, ok := evaluator.lists[right.Name]
+		if !ok {
+			return nil, evaluator.errorf(right, "the list $%s wasn't given", right.Name)
+		}
+		for _, item := range items {
+			if inListItem(left, item) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	return nil, evaluator.errorf(comparison, "%s can't be evaluated; check it with Lint", comparison)
+}
+
+func inSetElement(value interface{}, element Node) bool {
+	switch value := value.(type) {
+	case string:
+		literal, ok := element.(*StringLiteral)
+		return ok && literal.Value == value
+	case int64:
+		switch element := element.(type) {
+		case *IntLiteral:
+			return element.Value == value
+		case *IntRange:
+			return element.From <= value && value <= element.To
+		}
+	case netip.Addr:
+		switch element := element.(type) {
+		case *IPLiteral:
+			return value.IsValid() && element.Prefix.Contains(value)
+		case *IPRange:
+			return value.IsValid() && element.From.Compare(value) <= 0 && value.Compare(element.To) <= 0
+		}
+	}
+	return false
+}
+
+// inListItem reports whether a value is an item of a list: an IP address or CIDR range of an IP list, an ASN of an
+// ASN list, or a hostname of a hostname list, where *.example.com also matches the subdomains of example.com.
+func inListItem(value interface{}, item string) bool {
+	switch value := value.(type) {
+	case string:
+		item = strings.ToLower(item)
+		value = strings.ToLower(value)
+		if suffix, ok := strings.CutPrefix(item, "*."); ok {
+			return value == suffix || strings.HasSuffix(value, "."+suffix)
+		}
+		return value == item
+	case int64:
+		asn, err := strconv.ParseInt(strings.TrimPrefix(strings.ToUpper(item), "AS"), 10, 64)
+		return err == nil && asn == value
+	case netip.Addr:
+		if !value.IsValid() {
+			return false
+		}
+		if prefix, err := netip.ParsePrefix(item); err == nil {
+			return prefix.Masked().Contains(value)
+		}
+		ip, err := netip.ParseAddr(item)
+		return err == nil && ip.Unmap() == value
+	}
+	return false
+}
+
+// regexp returns a compiled regular expression, compiling each only once.
+func (evaluator *evaluator) regexp(node Node, expr string) (*regexp.Regexp, error) {
+	if re, ok := evaluator.regexps[expr]; ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, evaluator.errorf(node, "invalid regular expression: %s", err)
+	}
+	evaluator.regexps[expr] = re
+	return re, nil
+}
+
+// wildcardRegexp returns a regular expression matching the strings a wildcard pattern matches: * matches any
+// characters, and a backslash escapes * and itself. The match is case-insensitive unless strict.
+func wildcardRegexp(pattern string, strict bool) string {
+	var b strings.Builder
+	b.WriteString("^(?s")
+	if !strict {
+		b.WriteString("i")
+	}
+	b.WriteString(")")
+	var literal strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\' && i+1 < len(pattern) && (pattern[i+1] == '*' || pattern[i+1] == '\\'):
+			i++
+			literal.WriteByte(pattern[i])
+		case c == '*':
+			b.WriteString(regexp.QuoteMeta(literal.String()))
+			literal.Reset()
+			b.WriteString("(.*?)")
+		default:
+			literal.WriteByte(c)
+		}
+	}
+	b.WriteString(regexp.QuoteMeta(literal.String()))
+	b.WriteString("$")
+	return b.String()
+}
+
+func (evaluator *evaluator) call(call *CallExpr) (interface{}, error) {
+	args := make([]interface{}, len(call.Args))
+	for i, arg := range call.Args {
+		var err error
+		if args[i], err = evaluator.value(arg); err != nil {
+			return nil, err
+		}
+	}
+
+	switch call.Name {
+	case "any", "all":
+		if len(args) != 1 {
+			break
+		}
+		var elements unpacked
+		switch arg := args[0].(type) {
+		case unpacked:
+			elements = arg
+		case []bool:
+			for _, element := range arg {
+				elements = append(elements, element)
+			}
+		default:
+			return nil, evaluator.errorf(call, "%s expects a comparison of unpacked elements", call.Name)
+		}
+		result := call.Name == "all"
+		for _, element := range elements {
+			if b, ok := element.(bool); ok && b != result {
+				return !result, nil
+			}
+		}
+		return result, nil
+	}
+
+	// The other functions are applied to each element of an unpacked argument.
+	for i, arg := range args {
+		if elements, ok := arg.(unpacked); ok {
+			return evaluator.each(elements, func(element interface{}) (interface{}, error) {
+				elementArgs := append([]interface{}{}, args...)
+				elementArgs[i] = element
+				return evaluator.apply(call, elementArgs)
+			})
+		}
+	}
+	return evaluator.apply(call, args)
+}
+
+// apply applies a function to packed arguments.
+func (evaluator *evaluator) apply(call *CallExpr, args []interface{}) (interface{}, error) {
+	function, ok := Functions[call.Name]
+	if !ok {
+		return nil, evaluator.errorf(call, "unknown function %s", call.Name)
+	}
+	if len(args) < function.MinArgs || (function.MaxArgs >= 0 && len(args) > function.MaxArgs) {
+		return nil, evaluator.errorf(call, "%s takes %s, not %d", call.Name, describeArity(function), len(args))
+	}
+	for _, arg := range args {
+		if _, ok := arg.(missing); ok {
+			return missing{}, nil
+		}
+	}
+
+	s := func(i int) string {
+		if i >= len(args) {
+			return ""
+		}
+		value, _ := args[i].(string)
+		return value
+	}
+	n := func(i int) int64 {
+		value, _ := args[i].(int64)
+		return value
+	}
+
+	switch call.Name {
+	case "cidr":
+		ip, ok := args[0].(netip.Addr)
+		if !ok || !ip.IsValid() {
+			return netip.Addr{}, nil
+		}
+		bits := n(1)
+		if ip.Is6() {
+			bits = n(2)
+		}
+		prefix, err := ip.Prefix(int(bits))
+		if err != nil {
+			return nil, evaluator.errorf(call, "%s", err)
+		}
+		return prefix.Addr(), nil
+	case "concat":
+		if _, ok := args[0].([]string); ok {
+			var result []string
+			for _, arg := range args {
+				elements, _ := arg.([]string)
+				result = append(result, elements...)
+			}
+			return result, nil
+		}
+		var b strings.Builder
+		for i := range args {
+			b.WriteString(s(i))
+		}
+		return b.String(), nil
+	case "ends_with":
+		return strings.HasSuffix(s(0), s(1)), nil
+	case "len":
+		switch arg := args[0].(type) {
+		case string:
+			return int64(len(arg)), nil
+		case []string:
+			return int64(len(arg)), nil
+		case []bool:
+			return int64(len(arg)), nil
+		case map[string][]string:
+			return int64(len(arg)), nil
+		}
+	case "lookup_json_integer", "lookup_json_string":
+		return lookupJSON(s(0), args[1:], call.Name == "lookup_json_integer"), nil
+	case "lower":
+		return strings.ToLower(s(0)), nil
+	case "regex_replace":
+		re, err := evaluator.regexp(call, s(1))
+		if err != nil {
+			return nil, err
+		}
+		source := s(0)
+		match := re.FindStringSubmatchIndex(source)
+		if match == nil {
+			return source, nil
+		}
+		replaced := re.ExpandString(nil, s(2), source, match)
+		return source[:match[0]] + string(replaced) + source[match[1]:], nil
+	case "remove_bytes":
+		return strings.Map(func(r rune) rune {
+			if strings.ContainsRune(s(1), r) {
+				return -1
+			}
+			return r
+		}, s(0)), nil
+	case "starts_with":
+		return strings.HasPrefix(s(0), s(1)), nil
+	case "substring":
+		source := s(0)
+		start, end := n(1), int64(len(source))
+		if len(args) > 2 {
+			end = n(2)
+		}
+		start, end = clampIndex(start, len(source)), clampIndex(end, len(source))
+		if start >= end {
+			return "", nil
+		}
+		return source[start:end], nil
+	case "to_string":
+		switch arg := args[0].(type) {
+		case string:
+			return arg, nil
+		case int64:
+			return strconv.FormatInt(arg, 10), nil
+		case bool:
+			return strconv.FormatBool(arg), nil
+		case netip.Addr:
+			return arg.String(), nil
+		}
+	case "upper":
+		return strings.ToUpper(s(0)), nil
+	case "url_decode":
+		return urlDecode(s(0), s(1)), nil
+	case "wildcard_replace":
+		re, err := evaluator.regexp(call, wildcardRegexp(s(1), strings.Contains(s(3), "s")))
+		if err != nil {
+			return nil, err
+		}
+		source := s(0)
+		match := re.FindStringSubmatchIndex(source)
+		if match == nil {
+			return source, nil
+		}
+		return string(re.ExpandString(nil, s(2), source, match)), nil
+	}
+	return nil, evaluator.errorf(call, "%s can't be evaluated; check it with Lint", call)
+}
+
+// clampIndex resolves an index of substring, which counts from the end when negative, to a byte offset.
+func clampIndex(index int64, length int) int64 {
+	if index < 0 {
+		index += int64(length)
+	}
+	return max(0, min(index, int64(length)))
+}
+
+// lookupJSON returns the value at a path of object keys and array indexes in a JSON document, or a missing value
+// when there is none of the expected type.
+func lookupJSON(document string, path []interface{}, integer bool) interface{} {
+	var value interface{}
+	decoder := json.NewDecoder(strings.NewReader(document))
+	decoder.UseNumber()
+	if decoder.Decode(&value) != nil {
+		return missing{}
+	}
+	for _, key := range path {
+		switch key := key.(type) {
+		case string:
+			object, ok := value.(map[string]interface{})
+			if !ok {
+				return missing{}
+			}
+			if value, ok = object[key]; !ok {
+				return missing{}
+			}
+		case int64:
+			array, ok := value.([]interface{})
+			if !ok || key < 0 || key >= int64(len(array)) {
+				return missing{}
+			}
+			value = array[key]
+		default:
+			return missing{}
+		}
+	}
+	if integer {
+		if number, ok := value.(json.Number); ok {
+			if i, err := number.Int64(); err == nil {
+				return i
+			}
+		}
+		return missing{}
+	}
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return missing{}
+}
+
+// urlDecode decodes percent-encoded bytes and + as a space. With the r option it decodes repeatedly until nothing is
+// left to decode, and with the u option it also decodes %uXXXX sequences as UTF-8.
+func urlDecode(s string, options string) string {
+	for {
+		var b strings.Builder
+		for i := 0; i < len(s); i++ {
+			switch {
+			case s[i] == '+':
+				b.WriteByte(' ')
+			case s[i] == '%' && strings.Contains(options, "u") && i+5 < len(s) && (s[i+1] == 'u' || s[i+1] == 'U'):
+				if r, err := strconv.ParseUint(s[i+2:i+6], 16, 32); err == nil {
+					b.WriteRune(rune(r))
+					i += 5
+				} else {
+					b.WriteByte(s[i])
+				}
+			case s[i] == '%' && i+2 < len(s):
+				if c, err := strconv.ParseUint(s[i+1:i+3], 16, 8); err == nil {
+					b.WriteByte(byte(c))
+					i += 2
+				} else {
+					b.WriteByte(s[i])
+				}
+			default:
+				b.WriteByte(s[i])
+			}
+		}
+		decoded := b.String()
+		if !strings.Contains(options, "r") || decoded == s {
+			return decoded
+		}
+		s = decoded
+	}
+}
@@ -0,0 +1,106 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firewallexpression
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func evaluate(t *testing.T, expression string, request *Request) bool {
+	parsed, err := Parse(expression)
+	if !assert.Nil(t, err, expression) {
+		return false
+	}
+	matched, err := parsed.Evaluate(request, map[string][]string{
+		"office_networks": {"192.0.2.0/24", "2001:db8::1"},
+		"bad_asns":        {"AS64496"},
+		"partners":        {"*.partner.example"},
+	})
+	assert.Nil(t, err, expression)
+	return matched
+}
+
+func TestEvaluate(t *testing.T) {
+	request := &Request{
+		Method:    "post",
+		URL:       "https://www.Example.com/wp-admin/Login.PHP?user=admin&next=%2Fhome&tag=a&tag=b",
+		IP:        "192.0.2.10",
+		Country:   "FR",
+		Continent: "EU",
+		ASN:       64496,
+		UserAgent: "curl/8.4.0",
+		Headers: map[string][]string{
+			"Cookie":          {"session=abc; theme=dark"},
+			"X-Forwarded-For": {"198.51.100.7"},
+			"Accept-Language": {"fr-FR,fr;q=0.9,en;q=0.8"},
+			"Content-Type":    {"application/json; charset=utf-8"},
+		},
+		Body:   `{"user": {"name": "admin", "roles": ["root"], "age": 42}}`,
+		Fields: map[string]interface{}{"cf.threat_score": float64(30), "cf.client.bot": true},
+	}
+
+	for expression, expected := range map[string]bool{
+		`http.request.uri.path contains "/wp-admin" and ip.src ne 1.2.3.4`:                            true,
+		`http.host eq "www.example.com" and ssl and cf.edge.server_port eq 443`:                       true,
+		`http.request.method eq "POST" and http.request.uri.path.extension eq "php"`:                  true,
+		`ip.src in $office_networks and ip.geoip.asnum in $bad_asns`:                                  true,
+		`ip.src in {198.51.100.0/24 192.0.2.1..192.0.2.9}`:                                            false,
+		`ip.src in {192.0.2.0/24} and ip.geoip.country in {"FR" "DE"} and ip.geoip.continent eq "EU"`: true,
+		`http.host in $partners`: false,
+		`cf.threat_score gt 20 and cf.threat_score in {25..35} and cf.client.bot`:                        true,
+		`http.user_agent matches r"^curl/\d+" and http.user_agent wildcard "CURL/*"`:                     true,
+		`http.user_agent strict wildcard "CURL/*"`:                                                       false,
+		`http.request.uri.args["next"][0] eq "/home" and http.request.uri.args["missing"][0] ne "x"`:     false,
+		`any(http.request.uri.args["tag"][*] eq "b") and all(http.request.uri.args.values[*] ne "")`:     true,
+		`any(lower(http.request.headers.names[*]) eq "x-forwarded-for")`:                                 true,
+		`http.request.cookies["session"][0] eq "abc" and http.cookie contains "theme=dark"`:              true,
+		`http.request.accepted_languages[0] eq "fr-FR" and http.request.body.mime eq "application/json"`: true,
+		`lookup_json_string(http.request.body.raw, "user", "roles", 0) eq "root"`:                        true,
+		`lookup_json_integer(http.request.body.raw, "user", "age") ge 18`:                                true,
+		`starts_with(http.request.uri.path, "/wp-") and len(http.request.uri.query) gt 10`:               true,
+		`not http.x_forwarded_for eq "198.51.100.7" or ip.src eq 192.0.2.10 xor ssl`:                     false,
+		`cidr(ip.src, 24, 64) eq 192.0.2.0 and to_string(ip.geoip.asnum) eq "64496"`:                     true,
+		`url_decode("%252Fadmin", "r") eq "/admin" and substring(http.host, -11) eq "example.com"`:       true,
+		`regex_replace(http.request.uri.path, r"/([\w-]+)/", "/${1}-x/") eq "/wp-admin-x/Login.PHP"`:     true,
+		`wildcard_replace(http.host, "*.example.com", "${1}") eq "www"`:                                  true,
+		`concat(upper(http.host), remove_bytes(http.request.method, "O")) eq "WWW.EXAMPLE.COMPST"`:       true,
+	} {
+		assert.Equal(t, expected, evaluate(t, expression, request), expression)
+	}
+
+	// A request without any field set matches only the conditions of zero values.
+	assert.True(t, evaluate(t, `http.host eq "" and ip.geoip.asnum eq 0 and not ssl and not ip.src in {0.0.0.0/0 ::/0}`, &Request{}))
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	parsed, err := Parse(`ip.src in $blocked`)
+	assert.Nil(t, err)
+	_, err = parsed.Evaluate(&Request{IP: "192.0.2.1"}, nil)
+	var evalErr *EvaluationError
+	if assert.True(t, errors.As(err, &evalErr)) {
+		assert.Equal(t, "firewallexpression: offset 10: the list $blocked wasn't given", err.Error())
+	}
+
+	_, err = parsed.Evaluate(&Request{IP: "192.0.2"}, nil)
+	assert.Equal(t, `firewallexpression: invalid request IP address "192.0.2"`, err.Error())
+
+	_, err = parsed.Evaluate(&Request{Fields: map[string]interface{}{"cf.threat_score": "high"}}, nil)
+	assert.Equal(t, "firewallexpression: the value of cf.threat_score in the request is not an Integer", err.Error())
+}
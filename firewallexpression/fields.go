@@ -0,0 +1,138 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firewallexpression
+
+// Types of fields, function arguments and function results.
+const (
+	TypeArrayBool      = "Array<Boolean>"
+	TypeArrayString    = "Array<String>"
+	TypeBool           = "Boolean"
+	TypeInt            = "Integer"
+	TypeIP             = "IP address"
+	TypeMapArrayString = "Map<Array<String>>"
+	TypeString         = "String"
+
+	// typeAny accepts any argument, or as a result is the type of the first argument.
+	typeAny = ""
+)
+
+// Fields are the fields the linter knows, by name, with their types. Fields can be added for new or beta fields.
+var Fields = map[string]string{
+	"cf.bot_management.ja3_hash":            TypeString,
+	"cf.bot_management.js_detection.passed": TypeBool,
+	"cf.bot_management.score":               TypeInt,
+	"cf.bot_management.static_resource":     TypeBool,
+	"cf.bot_management.verified_bot":        TypeBool,
+	"cf.client.bot":                         TypeBool,
+	"cf.edge.server_ip":                     TypeIP,
+	"cf.edge.server_port":                   TypeInt,
+	"cf.threat_score":                       TypeInt,
+	"cf.tls_client_auth.cert_verified":      TypeBool,
+	"cf.verified_bot_category":              TypeString,
+	"cf.waf.score":                          TypeInt,
+	"cf.waf.score.rce":                      TypeInt,
+	"cf.waf.score.sqli":                     TypeInt,
+	"cf.waf.score.xss":                      TypeInt,
+	"http.cookie":                           TypeString,
+	"http.host":                             TypeString,
+	"http.referer":                          TypeString,
+	"http.request.accepted_languages":       TypeArrayString,
+	"http.request.body.mime":                TypeString,
+	"http.request.body.raw":                 TypeString,
+	"http.request.body.size":                TypeInt,
+	"http.request.body.truncated":           TypeBool,
+	"http.request.cookies":                  TypeMapArrayString,
+	"http.request.full_uri":                 TypeString,
+	"http.request.headers":                  TypeMapArrayString,
+	"http.request.headers.names":            TypeArrayString,
+	"http.request.headers.truncated":        TypeBool,
+	"http.request.headers.values":           TypeArrayString,
+	"http.request.method":                   TypeString,
+	"http.request.timestamp.sec":            TypeInt,
+	"http.request.uri":                      TypeString,
+	"http.request.uri.args":                 TypeMapArrayString,
+	"http.request.uri.args.names":           TypeArrayString,
+	"http.request.uri.args.values":          TypeArrayString,
+	"http.request.uri.path":                 TypeString,
+	"http.request.uri.path.extension":       TypeString,
+	"http.request.uri.query":                TypeString,
+	"http.request.version":                  TypeString,
+	"http.user_agent":                       TypeString,
+	"http.x_forwarded_for":                  TypeString,
+	"ip.geoip.asnum":                        TypeInt,
+	"ip.geoip.continent":                    TypeString,
+	"ip.geoip.country":                      TypeString,
+	"ip.geoip.is_in_european_union":         TypeBool,
+	"ip.geoip.subdivision_1_iso_code":       TypeString,
+	"ip.geoip.subdivision_2_iso_code":       TypeString,
+	"ip.src":                                TypeIP,
+	"ip.src.asnum":                          TypeInt,
+	"ip.src.city":                           TypeString,
+	"ip.src.continent":                      TypeString,
+	"ip.src.country":                        TypeString,
+	"ip.src.is_in_european_union":           TypeBool,
+	"ip.src.postal_code":                    TypeString,
+	"ip.src.region_code":                    TypeString,
+	"raw.http.request.full_uri":             TypeString,
+	"raw.http.request.uri":                  TypeString,
+	"raw.http.request.uri.args":             TypeMapArrayString,
+	"raw.http.request.uri.path":             TypeString,
+	"raw.http.request.uri.query":            TypeString,
+	"ssl":                                   TypeBool,
+}
+
+// Function describes the arguments and result of a function.
+type Function struct {
+	// The number of arguments; a MaxArgs of -1 allows any number.
+	MinArgs int
+	MaxArgs int
+
+	// The types of the arguments. The last type applies to any further arguments; an empty type accepts any type.
+	Args []string
+
+	// The type of the result; empty when it is the type of the first argument.
+	Returns string
+}
+
+// Functions are the functions the linter knows, by name. Functions can be added for new or beta functions.
+var Functions = map[string]Function{
+	"all":                 {MinArgs: 1, MaxArgs: 1, Args: []string{TypeArrayBool}, Returns: TypeBool},
+	"any":                 {MinArgs: 1, MaxArgs: 1, Args: []string{TypeArrayBool}, Returns: TypeBool},
+	"cidr":                {MinArgs: 3, MaxArgs: 3, Args: []string{TypeIP, TypeInt}, Returns: TypeIP},
+	"concat":              {MinArgs: 1, MaxArgs: -1, Args: []string{typeAny}, Returns: typeAny},
+	"ends_with":           {MinArgs: 2, MaxArgs: 2, Args: []string{TypeString}, Returns: TypeBool},
+	"len":                 {MinArgs: 1, MaxArgs: 1, Args: []string{typeAny}, Returns: TypeInt},
+	"lookup_json_integer": {MinArgs: 2, MaxArgs: -1, Args: []string{TypeString, typeAny}, Returns: TypeInt},
+	"lookup_json_string":  {MinArgs: 2, MaxArgs: -1, Args: []string{TypeString, typeAny}, Returns: TypeString},
+	"lower":               {MinArgs: 1, MaxArgs: 1, Args: []string{TypeString}, Returns: TypeString},
+	"regex_replace":       {MinArgs: 3, MaxArgs: 3, Args: []string{TypeString}, Returns: TypeString},
+	"remove_bytes":        {MinArgs: 2, MaxArgs: 2, Args: []string{TypeString}, Returns: TypeString},
+	"starts_with":         {MinArgs: 2, MaxArgs: 2, Args: []string{TypeString}, Returns: TypeBool},
+	"substring":           {MinArgs: 2, MaxArgs: 3, Args: []string{TypeString, TypeInt}, Returns: TypeString},
+	"to_string":           {MinArgs: 1, MaxArgs: 1, Args: []string{typeAny}, Returns: TypeString},
+	"upper":               {MinArgs: 1, MaxArgs: 1, Args: []string{TypeString}, Returns: TypeString},
+	"url_decode":          {MinArgs: 1, MaxArgs: 2, Args: []string{TypeString}, Returns: TypeString},
+	"wildcard_replace":    {MinArgs: 3, MaxArgs: 4, Args: []string{TypeString}, Returns: TypeString},
+}
+
+// comparisonsByType are the comparison operators each type supports.
+var comparisonsByType = map[string][]string{
+	TypeString: {OpEq, OpNe, OpLt, OpLe, OpGt, OpGe, OpContains, OpMatches, OpIn, OpWildcard, OpStrictWildcard},
+	TypeInt:    {OpEq, OpNe, OpLt, OpLe, OpGt, OpGe, OpIn},
+	TypeIP:     {OpEq, OpNe, OpIn},
+	TypeBool:   {OpEq, OpNe},
+}
@@ -0,0 +1,152 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firewallexpression
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// precedence returns the precedence of a logical operator; not binds tighter than all of them.
+func precedence(op string) int {
+	for level, operator := range logicalLevels {
+		if operator.word == op {
+			return level
+		}
+	}
+	return len(logicalLevels)
+}
+
+func (node *LogicalExpr) String() string {
+	parts := make([]string, 0, len(node.Operands))
+	for _, operand := range node.Operands {
+		if logical, ok := operand.(*LogicalExpr); ok {
+			switch {
+			case logical.Op == node.Op:
+				// The operators are associative, so a nested expression of the same operator needs no parentheses.
+			case precedence(logical.Op) < precedence(node.Op):
+				parts = append(parts, "("+logical.String()+")")
+				continue
+			}
+		}
+		parts = append(parts, operand.String())
+	}
+	return strings.Join(parts, " "+node.Op+" ")
+}
+
+func (node *NotExpr) String() string {
+	if _, ok := node.Operand.(*LogicalExpr); ok {
+		return "not (" + node.Operand.String() + ")"
+	}
+	return "not " + node.Operand.String()
+}
+
+func (node *ComparisonExpr) String() string {
+	return node.Left.String() + " " + node.Op + " " + node.Right.String()
+}
+
+func (node *FieldExpr) String() string {
+	var b strings.Builder
+	b.WriteString(node.Name)
+	for _, index := range node.Indexes {
+		switch {
+		case index.All:
+			b.WriteString("[*]")
+		case index.Key != nil:
+			b.WriteString("[" + quote(*index.Key) + "]")
+		case index.Element != nil:
+			fmt.Fprintf(&b, "[%d]", *index.Element)
+		}
+	}
+	return b.String()
+}
+
+func (node *CallExpr) String() string {
+	args := make([]string, len(node.Args))
+	for i, arg := range node.Args {
+		args[i] = arg.String()
+	}
+	return node.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+func (node *StringLiteral) String() string {
+	if node.Raw {
+		hashes := ""
+		for strings.Contains(node.Value, `"`+hashes) {
+			hashes += "#"
+		}
+		return "r" + hashes + `"` + node.Value + `"` + hashes
+	}
+	return quote(node.Value)
+}
+
+func (node *IntLiteral) String() string {
+	return strconv.FormatInt(node.Value, 10)
+}
+
+func (node *IntRange) String() string {
+	return fmt.Sprintf("%d..%d", node.From, node.To)
+}
+
+func (node *IPLiteral) String() string {
+	if node.Prefix.IsSingleIP() {
+		return node.Prefix.Addr().String()
+	}
+	return node.Prefix.String()
+}
+
+func (node *IPRange) String() string {
+	return node.From.String() + ".." + node.To.String()
+}
+
+func (node *BoolLiteral) String() string {
+	return strconv.FormatBool(node.Value)
+}
+
+func (node *SetLiteral) String() string {
+	elements := make([]string, len(node.Elements))
+	for i, element := range node.Elements {
+		elements[i] = element.String()
+	}
+	return "{" + strings.Join(elements, " ") + "}"
+}
+
+func (node *ListRef) String() string {
+	return "$" + node.Name
+}
+
+// quote returns a string in double quotes, escaping quotes and backslashes, and writing the ASCII control characters,
+// DEL included, as \x escapes. Other bytes, e.g. those of UTF-8 characters, are written as they are.
+func quote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '"' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c < 0x20 || c == 0x7f:
+			fmt.Fprintf(&b, `\x%02x`, c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,559 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firewallexpression
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"sort"
+	"strings"
+)
+
+// Severities of diagnostics. Errors are expressions the API rejects; warnings are expressions it accepts but that are
+// likely unintended.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// Rules of the linter.
+const (
+	RuleAlwaysTrue      = "always-true"
+	RuleInvalidRegex    = "invalid-regex"
+	RuleTypeMismatch    = "type-mismatch"
+	RuleUnknownField    = "unknown-field"
+	RuleUnknownFunction = "unknown-function"
+)
+
+// Diagnostic is a problem the linter found in an expression.
+type Diagnostic struct {
+	// The byte offset of the problem in the expression.
+	Offset   int
+	Severity string
+	Rule     string
+	Message  string
+}
+
+func (diagnostic Diagnostic) String() string {
+	return fmt.Sprintf("offset %d: %s: %s (%s)", diagnostic.Offset, diagnostic.Severity, diagnostic.Message, diagnostic.Rule)
+}
+
+// LintError reports the errors the linter found in an expression.
+type LintError struct {
+	Diagnostics []Diagnostic
+}
+
+func (lintErr *LintError) Error() string {
+	messages := make([]string, len(lintErr.Diagnostics))
+	for i, diagnostic := range lintErr.Diagnostics {
+		messages[i] = diagnostic.String()
+	}
+	return "firewallexpression: " + strings.Join(messages, "; ")
+}
+
+// Lint parses an expression and returns the problems found in it. A syntax error is returned as a *ParseError.
+func Lint(expression string) ([]Diagnostic, error) {
+	parsed, err := Parse(expression)
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Lint(), nil
+}
+
+// Check parses and lints an expression, for tests and pre-commit hooks. It returns a *ParseError for a syntax error,
+// a *LintError when the linter found errors, and nil when there are at most warnings.
+func Check(expression string) error {
+	parsed, err := Parse(expression)
+	if err != nil {
+		return err
+	}
+	return parsed.check()
+}
+
+// check returns a *LintError when the linter finds errors in the expression.
+func (expression *Expression) check() error {
+	lintErr := &LintError{}
+	for _, diagnostic := range expression.Lint() {
+		if diagnostic.Severity == SeverityError {
+			lintErr.Diagnostics = append(lintErr.Diagnostics, diagnostic)
+		}
+	}
+	if len(lintErr.Diagnostics) > 0 {
+		return lintErr
+	}
+	return nil
+}
+
+// Lint returns the problems found in the expression, ordered by offset: unknown fields and functions, operators,
+// values and arguments of the wrong type, regular expressions that don't compile, and clauses that are always true.
+func (expression *Expression) Lint() []Diagnostic {
+	linter := &linter{}
+	linter.checkCondition(expression.Root)
+	sort.SliceStable(linter.diagnostics, func(i, j int) bool {
+		return linter.diagnostics[i].Offset < linter.diagnostics[j].Offset
+	})
+	return linter.diagnostics
+}
+
+// valueType is the type of a node. An unpacked value, such as http.request.headers.names[*], stands for each element
+// of an array in turn.
+type valueType struct {
+	typ      string
+	unpacked bool
+}
+
+type linter struct {
+	diagnostics []Diagnostic
+}
+
+func (linter *linter) report(node Node, severity string, rule string, format string, args ...interface{}) {
+	linter.diagnostics = append(linter.diagnostics, Diagnostic{
+		Offset:   node.Pos(),
+		Severity: severity,
+		Rule:     rule,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// checkCondition checks a node used as a condition: the whole expression, or an operand of a logical operator.
+func (linter *linter) checkCondition(node Node) {
+	t, ok := linter.typeOf(node)
+	switch {
+	case !ok:
+	case t.unpacked && t.typ == TypeBool:
+		linter.report(node, SeverityError, RuleTypeMismatch, "%s compares each element of an array; wrap it in any() or all()", node)
+	case t.unpacked || t.typ != TypeBool:
+		linter.report(node, SeverityError, RuleTypeMismatch, "%s is %s, not a condition; compare it to a value", node, describeType(t))
+	}
+}
+
+// typeOf checks a node and returns its type. It returns false when the type is unknown because of a problem already
+// reported.
+func (linter *linter) typeOf(node Node) (valueType, bool) {
+	switch node := node.(type) {
+	case *LogicalExpr:
+		for _, operand := range node.Operands {
+			linter.checkCondition(operand)
+		}
+		linter.checkLogical(node)
+		return valueType{typ: TypeBool}, true
+	case *NotExpr:
+		linter.checkCondition(node.Operand)
+		if literal, ok := node.Operand.(*BoolLiteral); ok && !literal.Value {
+			linter.report(node, SeverityWarning, RuleAlwaysTrue, "%s is always true", node)
+		}
+		return valueType{typ: TypeBool}, true
+	case *ComparisonExpr:
+		return linter.typeOfComparison(node)
+	case *FieldExpr:
+		return linter.typeOfField(node)
+	case *CallExpr:
+		return linter.typeOfCall(node)
+	case *StringLiteral:
+		return valueType{typ: TypeString}, true
+	case *IntLiteral:
+		return valueType{typ: TypeInt}, true
+	case *IPLiteral:
+		return valueType{typ: TypeIP}, true
+	case *BoolLiteral:
+		return valueType{typ: TypeBool}, true
+	}
+	linter.report(node, SeverityError, RuleTypeMismatch, "%s can't be used here", node)
+	return valueType{}, false
+}
+
+func (linter *linter) typeOfField(field *FieldExpr) (valueType, bool) {
+	typ, ok := Fields[field.Name]
+	if !ok {
+		if suggestion := suggest(field.Name, Fields); suggestion != "" {
+			linter.report(field, SeverityError, RuleUnknownField, "unknown field %s; did you mean %s?", field.Name, suggestion)
+		} else {
+			linter.report(field, SeverityError, RuleUnknownField, "unknown field %s", field.Name)
+		}
+		return valueType{}, false
+	}
+	t := valueType{typ: typ}
+	for _, index := range field.Indexes {
+		element, isMap := elementType(t.typ, typeMapPrefix)
+		if !isMap {
+			element, ok = elementType(t.typ, typeArrayPrefix)
+			if !ok {
+				linter.report(field, SeverityError, RuleTypeMismatch, "%s is %s and can't be indexed", field.Name, describeType(t))
+				return valueType{}, false
+			}
+		}
+		switch {
+		case isMap && index.Element != nil:
+			linter.report(field, SeverityError, RuleTypeMismatch, "%s is a map; index it with a quoted key", field.Name)
+			return valueType{}, false
+		case !isMap && index.Key != nil:
+			linter.report(field, SeverityError, RuleTypeMismatch, "%s is an array; index it with a number", field.Name)
+			return valueType{}, false
+		case index.All:
+			t.unpacked = true
+		}
+		t.typ = element
+	}
+	return t, true
+}
+
+func (linter *linter) typeOfCall(call *CallExpr) (valueType, bool) {
+	function, known := Functions[call.Name]
+	if !known {
+		if suggestion := suggest(call.Name, Functions); suggestion != "" {
+			linter.report(call, SeverityError, RuleUnknownFunction, "unknown function %s; did you mean %s?", call.Name, suggestion)
+		} else {
+			linter.report(call, SeverityError, RuleUnknownFunction, "unknown function %s", call.Name)
+		}
+	}
+
+	argTypes := make([]valueType, len(call.Args))
+	argsOK := true
+	for i, arg := range call.Args {
+		var ok bool
+		argTypes[i], ok = linter.typeOf(arg)
+		argsOK = argsOK && ok
+	}
+	if !known {
+		return valueType{}, false
+	}
+	if len(call.Args) < function.MinArgs || (function.MaxArgs >= 0 && len(call.Args) > function.MaxArgs) {
+		linter.report(call, SeverityError, RuleTypeMismatch, "%s takes %s, not %d", call.Name, describeArity(function), len(call.Args))
+		return valueType{}, false
+	}
+	if !argsOK {
+		return valueType{}, false
+	}
+
+	result := valueType{typ: function.Returns}
+	for i, arg := range call.Args {
+		expected := function.Args[len(function.Args)-1]
+		if i < len(function.Args) {
+			expected = function.Args[i]
+		}
+		actual := argTypes[i]
+		switch {
+		case expected == TypeArrayBool:
+			if actual.typ != TypeArrayBool && !(actual.unpacked && actual.typ == TypeBool) {
+				linter.report(arg, SeverityError, RuleTypeMismatch, "%s expects a comparison of unpacked elements, such as http.request.headers.names[*] eq \"x\", not %s", call.Name, describeType(actual))
+				return valueType{}, false
+			}
+		case expected != typeAny && actual.typ != expected:
+			linter.report(arg, SeverityError, RuleTypeMismatch, "argument %d of %s must be %s, not %s", i+1, call.Name, describeType(valueType{typ: expected}), describeType(actual))
+			return valueType{}, false
+		default:
+			result.unpacked = result.unpacked || actual.unpacked
+		}
+	}
+	if result.typ == typeAny {
+		result.typ = argTypes[0].typ
+	}
+	return result, true
+}
+
+func (linter *linter) typeOfComparison(comparison *ComparisonExpr) (valueType, bool) {
+	if comparison.Op == OpMatches {
+		linter.checkRegex(comparison)
+	}
+	left, ok := linter.typeOf(comparison.Left)
+	if !ok {
+		return valueType{typ: TypeBool, unpacked: left.unpacked}, false
+	}
+	result := valueType{typ: TypeBool, unpacked: left.unpacked}
+	operators, comparable := comparisonsByType[left.typ]
+	if !comparable {
+		linter.report(comparison, SeverityError, RuleTypeMismatch, "%s is %s; index it or unpack it with [*] to compare its elements", comparison.Left, describeType(valueType{typ: left.typ}))
+		return result, false
+	}
+	if !containsString(operators, comparison.Op) {
+		linter.report(comparison, SeverityError, RuleTypeMismatch, "%s does not support %s; use one of %s", describeType(valueType{typ: left.typ}), comparison.Op, strings.Join(operators, ", "))
+		return result, false
+	}
+
+	switch right := comparison.Right.(type) {
+	case *SetLiteral:
+		if comparison.Op != OpIn {
+			linter.report(right, SeverityError, RuleTypeMismatch, "%s takes a single value; use in to compare to a set", comparison.Op)
+			return result, false
+		}
+		ok = true
+		for _, element := range right.Elements {
+			if !valueSuits(left.typ, element, true) {
+				linter.report(element, SeverityError, RuleTypeMismatch, "%s is not %s", element, describeValueType(left.typ, true))
+				ok = false
+			}
+		}
+		if ok && left.typ == TypeIP && coversAllAddresses(right) {
+			linter.report(comparison, SeverityWarning, RuleAlwaysTrue, "%s is always true", comparison)
+		}
+		return result, ok
+	case *ListRef:
+		if comparison.Op != OpIn {
+			linter.report(right, SeverityError, RuleTypeMismatch, "%s takes a single value; use in to compare to a list", comparison.Op)
+			return result, false
+		}
+		return result, true
+	}
+
+	if comparison.Op == OpIn {
+		linter.report(comparison.Right, SeverityError, RuleTypeMismatch, "in takes a set, such as {%s}, or a list, such as $name", comparison.Right)
+		return result, false
+	}
+	if !valueSuits(left.typ, comparison.Right, false) {
+		linter.report(comparison.Right, SeverityError, RuleTypeMismatch, "%s is not %s", comparison.Right, describeValueType(left.typ, false))
+		return result, false
+	}
+	if alwaysTrue(comparison) {
+		linter.report(comparison, SeverityWarning, RuleAlwaysTrue, "%s is always true", comparison)
+	}
+	return result, true
+}
+
+// checkRegex compiles the pattern of a matches comparison. The RE2 syntax of Go is close to the Rust regex syntax of
+// the API: neither has backreferences or lookaround.
+func (linter *linter) checkRegex(comparison *ComparisonExpr) {
+	pattern, ok := comparison.Right.(*StringLiteral)
+	if !ok {
+		return
+	}
+	if _, err := regexp.Compile(pattern.Value); err != nil {
+		message := strings.TrimPrefix(err.Error(), "error parsing regexp: ")
+		linter.report(pattern, SeverityError, RuleInvalidRegex, "invalid regular expression: %s", message)
+	}
+}
+
+// checkLogical reports an or with a true operand, or with both a condition and its negation.
+func (linter *linter) checkLogical(logical *LogicalExpr) {
+	if logical.Op != OpOr {
+		return
+	}
+	seen := map[string]bool{}
+	for _, operand := range logical.Operands {
+		seen[operand.String()] = true
+	}
+	for _, operand := range logical.Operands {
+		if literal, ok := operand.(*BoolLiteral); ok && literal.Value {
+			linter.report(logical, SeverityWarning, RuleAlwaysTrue, "%s is always true because of the true operand", logical)
+			return
+		}
+		if not, ok := operand.(*NotExpr); ok && seen[not.Operand.String()] {
+			linter.report(logical, SeverityWarning, RuleAlwaysTrue, "%s is always true because it contains both %s and its negation", logical, not.Operand)
+			return
+		}
+	}
+}
+
+// alwaysTrue tells whether a comparison to a single value holds for every request.
+func alwaysTrue(comparison *ComparisonExpr) bool {
+	switch right := comparison.Right.(type) {
+	case *StringLiteral:
+		switch comparison.Op {
+		case OpContains:
+			return right.Value == ""
+		case OpWildcard, OpStrictWildcard:
+			return right.Value != "" && strings.Trim(right.Value, "*") == ""
+		case OpMatches:
+			re, err := syntax.Parse(right.Value, syntax.Perl)
+			return err == nil && matchesEverywhere(re.Simplify())
+		}
+	case *IntLiteral:
+		// The integer fields are never negative.
+		if _, isField := comparison.Left.(*FieldExpr); isField {
+			return (comparison.Op == OpGe && right.Value <= 0) || (comparison.Op == OpGt && right.Value < 0)
+		}
+	}
+	return false
+}
+
+// matchesEverywhere tells whether a regular expression matches some part of every string.
+func matchesEverywhere(re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpEmptyMatch, syntax.OpBeginLine, syntax.OpEndLine, syntax.OpBeginText, syntax.OpEndText,
+		syntax.OpStar, syntax.OpQuest:
+		return true
+	case syntax.OpRepeat:
+		return re.Min == 0
+	case syntax.OpCapture:
+		return matchesEverywhere(re.Sub[0])
+	case syntax.OpAlternate:
+		for _, sub := range re.Sub {
+			if matchesEverywhere(sub) {
+				return true
+			}
+		}
+	case syntax.OpConcat:
+		// A begin and an end anchor together only match some strings, as in ^$.
+		begin, end := false, false
+		for _, sub := range re.Sub {
+			if !matchesEverywhere(sub) {
+				return false
+			}
+			begin = begin || sub.Op == syntax.OpBeginLine || sub.Op == syntax.OpBeginText
+			end = end || sub.Op == syntax.OpEndLine || sub.Op == syntax.OpEndText
+		}
+		return !(begin && end)
+	}
+	return false
+}
+
+// coversAllAddresses tells whether a set contains both 0.0.0.0/0 and ::/0.
+func coversAllAddresses(set *SetLiteral) bool {
+	ipv4, ipv6 := false, false
+	for _, element := range set.Elements {
+		if ip, ok := element.(*IPLiteral); ok && ip.Prefix.Bits() == 0 {
+			ipv4 = ipv4 || ip.Prefix.Addr().Is4()
+			ipv6 = ipv6 || ip.Prefix.Addr().Is6()
+		}
+	}
+	return ipv4 && ipv6
+}
+
+// valueSuits tells whether a value can be compared to a field of the given type.
+func valueSuits(typ string, value Node, inSet bool) bool {
+	switch value := value.(type) {
+	case *StringLiteral:
+		return typ == TypeString
+	case *IntLiteral:
+		return typ == TypeInt
+	case *IntRange:
+		return typ == TypeInt && inSet
+	case *IPLiteral:
+		return typ == TypeIP && (inSet || value.Prefix.IsSingleIP())
+	case *IPRange:
+		return typ == TypeIP && inSet
+	case *BoolLiteral:
+		return typ == TypeBool && !inSet
+	}
+	return false
+}
+
+func describeValueType(typ string, inSet bool) string {
+	switch {
+	case typ == TypeString:
+		return "a quoted string"
+	case typ == TypeInt && inSet:
+		return "an integer or a range of integers"
+	case typ == TypeInt:
+		return "an integer"
+	case typ == TypeIP && inSet:
+		return "an IP address, CIDR range or range of addresses"
+	case typ == TypeIP:
+		return "an IP address; use in to compare to a CIDR range"
+	}
+	return "true or false"
+}
+
+func describeType(t valueType) string {
+	if t.unpacked {
+		return "each element of an array of " + t.typ
+	}
+	switch t.typ {
+	case TypeArrayBool, TypeArrayString:
+		return "an array"
+	case TypeMapArrayString:
+		return "a map"
+	case TypeInt, TypeIP:
+		return "an " + t.typ
+	}
+	return "a " + t.typ
+}
+
+func describeArity(function Function) string {
+	switch {
+	case function.MaxArgs < 0:
+		return fmt.Sprintf("at least %d arguments", function.MinArgs)
+	case function.MinArgs == function.MaxArgs && function.MinArgs == 1:
+		return "1 argument"
+	case function.MinArgs == function.MaxArgs:
+		return fmt.Sprintf("%d arguments", function.MinArgs)
+	}
+	return fmt.Sprintf("%d to %d arguments", function.MinArgs, function.MaxArgs)
+}
+
+const (
+	typeArrayPrefix = "Array<"
+	typeMapPrefix   = "Map<"
+)
+
+// elementType returns the type of the elements of an array or map type.
+func elementType(typ string, prefix string) (string, bool) {
+	if !strings.HasPrefix(typ, prefix) || !strings.HasSuffix(typ, ">") {
+		return "", false
+	}
+	return typ[len(prefix) : len(typ)-1], true
+}
+
+// suggest returns the known name closest to an unknown one, or "" when none is close.
+func suggest(name string, known interface{}) string {
+	var names []string
+	switch known := known.(type) {
+	case map[string]string:
+		for candidate := range known {
+			names = append(names, candidate)
+		}
+	case map[string]Function:
+		for candidate := range known {
+			names = append(names, candidate)
+		}
+	}
+	sort.Strings(names)
+	best, bestDistance := "", 3
+	for _, candidate := range names {
+		if strings.EqualFold(candidate, name) {
+			return candidate
+		}
+		if len(candidate) >= 4 && strings.HasPrefix(strings.ToLower(name), candidate) {
+			best, bestDistance = candidate, 0
+			continue
+		}
+		if distance := editDistance(name, candidate); distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance of two strings.
+func editDistance(a string, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firewallexpression
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func lintStrings(t *testing.T, expression string) (diagnostics []string) {
+	found, err := Lint(expression)
+	assert.Nil(t, err, expression)
+	for _, diagnostic := range found {
+		diagnostics = append(diagnostics, diagnostic.String())
+	}
+	return
+}
+
+func TestLintValidExpressions(t *testing.T) {
+	for _, expression := range []string{
+		`(http.request.uri.path contains "/admin" and ip.src ne 1.2.3.4)`,
+		`ip.geoip.country in {"CN" "RU"} and not cf.client.bot`,
+		`any(lower(http.request.headers.names[*]) eq "x-debug") or len(http.request.uri.query) gt 1024`,
+		`any(http.request.headers["x-forwarded-proto"][*] ne "https")`,
+		`http.request.uri.path matches r"^/api/v\d+/" and ip.src in $office_networks`,
+		`cf.edge.server_port in {80 8000..8999} and ip.src in {192.0.2.0/24 198.51.100.1..198.51.100.9}`,
+		`starts_with(http.request.uri.path, "/wp-") and ends_with(http.host, ".example.com")`,
+		`true`,
+	} {
+		assert.Empty(t, lintStrings(t, expression), expression)
+		assert.Nil(t, Check(expression))
+	}
+}
+
+func TestLintUnknownNames(t *testing.T) {
+	assert.Equal(t, []string{
+		"offset 0: error: unknown field http.hots; did you mean http.host? (unknown-field)",
+		"offset 20: error: unknown field ip.source (unknown-field)",
+		"offset 44: error: unknown function lowercase; did you mean lower? (unknown-function)",
+		"offset 54: error: unknown field HTTP.Host; did you mean http.host? (unknown-field)",
+	}, lintStrings(t, `http.hots eq "x" or ip.source eq 1.2.3.4 or lowercase(HTTP.Host) eq "x"`))
+}
+
+func TestLintTypes(t *testing.T) {
+	assert.Equal(t, []string{
+		"offset 0: error: an IP address does not support contains; use one of eq, ne, in (type-mismatch)",
+		`offset 44: error: "10" is not an integer (type-mismatch)`,
+		"offset 62: error: 10.0.0.0/8 is not an IP address; use in to compare to a CIDR range (type-mismatch)",
+		`offset 101: error: "a" is not an integer or a range of integers (type-mismatch)`,
+	}, lintStrings(t, `ip.src contains "10." or cf.threat_score gt "10" or ip.src eq 10.0.0.0/8 or cf.threat_score in {1..5 "a"}`))
+
+	assert.Equal(t, []string{
+		"offset 0: error: http.host is a String, not a condition; compare it to a value (type-mismatch)",
+		`offset 13: error: http.request.headers.names[*] eq "x" compares each element of an array; wrap it in any() or all() (type-mismatch)`,
+		"offset 53: error: http.request.headers is a map; index it or unpack it with [*] to compare its elements (type-mismatch)",
+		"offset 84: error: lower takes 1 argument, not 2 (type-mismatch)",
+		"offset 137: error: argument 2 of ends_with must be a String, not an Integer (type-mismatch)",
+	}, lintStrings(t, `http.host or http.request.headers.names[*] eq "x" or http.request.headers eq "x" or lower(http.host, "x") eq "a" or ends_with(http.host, 1)`))
+}
+
+func TestLintRegexAndAlwaysTrue(t *testing.T) {
+	assert.Equal(t, []string{
+		"offset 0: warning: http.host contains \"\" is always true (always-true)",
+		"offset 26: warning: cf.threat_score ge 0 is always true (always-true)",
+		"offset 81: error: invalid regular expression: missing closing ): `^/(admin` (invalid-regex)",
+		"offset 96: warning: http.request.uri wildcard \"**\" is always true (always-true)",
+		"offset 131: warning: http.user_agent matches \"(.*)|x\" is always true (always-true)",
+	}, lintStrings(t, `http.host contains "" and cf.threat_score ge 0 and http.request.uri.path matches "^/(admin" and http.request.uri wildcard "**" and http.user_agent matches "(.*)|x" and http.user_agent matches "^$"`))
+
+	assert.Equal(t, []string{
+		"offset 0: warning: ssl or ip.src in {0.0.0.0/0 ::/0} or not ssl is always true because it contains both ssl and its negation (always-true)",
+		"offset 7: warning: ip.src in {0.0.0.0/0 ::/0} is always true (always-true)",
+	}, lintStrings(t, `ssl or ip.src in {0.0.0.0/0 ::/0} or not ssl`))
+
+	err := Check(`http.host contains "" or http.hots eq "a"`)
+	var lintErr *LintError
+	assert.True(t, errors.As(err, &lintErr))
+	assert.Len(t, lintErr.Diagnostics, 1)
+	assert.Equal(t, "firewallexpression: offset 25: error: unknown field http.hots; did you mean http.host? (unknown-field)", err.Error())
+	assert.Nil(t, Check(`http.host contains ""`))
+}
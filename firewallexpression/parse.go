@@ -0,0 +1,548 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firewallexpression
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// ParseError reports a syntax error in an expression.
+type ParseError struct {
+	// The byte offset of the error in the expression.
+	Offset int
+	Err    error
+}
+
+func (parseErr *ParseError) Error() string {
+	return fmt.Sprintf("firewallexpression: offset %d: %s", parseErr.Offset, parseErr.Err.Error())
+}
+
+// Unwrap returns the underlying error.
+func (parseErr *ParseError) Unwrap() error {
+	return parseErr.Err
+}
+
+// operator is a canonical operator and the symbol that may be written instead.
+type operator struct {
+	word   string
+	symbol string
+}
+
+// logicalLevels are the logical operators from the lowest precedence to the highest.
+var logicalLevels = []operator{
+	{OpOr, "||"},
+	{OpXor, "^^"},
+	{OpAnd, "&&"},
+}
+
+// comparisonOperators are tried in order, so longer symbols come first.
+var comparisonOperators = []operator{
+	{OpEq, "=="},
+	{OpNe, "!="},
+	{OpLe, "<="},
+	{OpGe, ">="},
+	{OpLt, "<"},
+	{OpGt, ">"},
+	{OpMatches, "~"},
+	{OpContains, ""},
+	{OpIn, ""},
+	{OpWildcard, ""},
+	{OpStrictWildcard, ""},
+}
+
+// reservedWords can't be field or function names.
+var reservedWords = map[string]bool{
+	OpAnd: true, OpOr: true, OpXor: true, "not": true,
+	OpContains: true, OpEq: true, OpGe: true, OpGt: true, OpIn: true, OpLe: true, OpLt: true, OpMatches: true, OpNe: true,
+	OpWildcard: true, "strict": true,
+}
+
+type parser struct {
+	src string
+	pos int
+}
+
+// Parse parses an expression. Operators written as symbols, such as == and &&, are read as their canonical words,
+// and redundant parentheses are dropped. Parse only checks the syntax; Lint checks the fields, functions and values.
+func Parse(expression string) (*Expression, error) {
+	p := &parser{src: expression}
+	p.skipSpace()
+	if p.eof() {
+		return nil, p.errorf(p.pos, "the expression is empty")
+	}
+	root, err := p.parseLogical(0)
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if !p.eof() {
+		return nil, p.errorf(p.pos, "unexpected %s", p.describeNext())
+	}
+	return &Expression{Root: root}, nil
+}
+
+// Format parses an expression and returns it in canonical form: operators as lower case words, single spaces,
+// parentheses only where the precedence of not, and, xor and or requires them, and strings in double quotes unless
+// they were written as raw strings.
+func Format(expression string) (string, error) {
+	parsed, err := Parse(expression)
+	if err != nil {
+		return "", err
+	}
+	return parsed.String(), nil
+}
+
+func (p *parser) parseLogical(level int) (Node, error) {
+	if level == len(logicalLevels) {
+		return p.parseUnary()
+	}
+	first, err := p.parseLogical(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	operands := []Node{first}
+	for {
+		p.skipSpace()
+		if !p.acceptOperator(logicalLevels[level]) {
+			break
+		}
+		operand, err := p.parseLogical(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, operand)
+	}
+	if len(operands) == 1 {
+		return first, nil
+	}
+	return &LogicalExpr{Offset: first.Pos(), Op: logicalLevels[level].word, Operands: operands}, nil
+}
+
+func (p *parser) parseUnary() (Node, error) {
+	p.skipSpace()
+	start := p.pos
+	if p.acceptWord("not") || (p.peekString("!") && !p.peekString("!=")) {
+		if p.src[start] == '!' {
+			p.pos++
+		}
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &NotExpr{Offset: start, Operand: operand}, nil
+	}
+	if p.peekString("(") {
+		p.pos++
+		node, err := p.parseLogical(0)
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if !p.peekString(")") {
+			return nil, p.errorf(p.pos, "expected ), found %s", p.describeNext())
+		}
+		p.pos++
+		return node, nil
+	}
+	return p.parsePredicate()
+}
+
+func (p *parser) parsePredicate() (Node, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	for _, op := range comparisonOperators {
+		if p.acceptOperator(op) {
+			right, err := p.parseValue(op.word)
+			if err != nil {
+				return nil, err
+			}
+			return &ComparisonExpr{Offset: left.Pos(), Left: left, Op: op.word, Right: right}, nil
+		}
+	}
+	return left, nil
+}
+
+// parseOperand parses a field, a function call, or true or false.
+func (p *parser) parseOperand() (Node, error) {
+	p.skipSpace()
+	start := p.pos
+	name := p.scanIdentifier()
+	if name == "" || reservedWords[name] {
+		p.pos = start
+		return nil, p.errorf(start, "expected a field or function, found %s", p.describeNext())
+	}
+	switch name {
+	case "true", "false":
+		return &BoolLiteral{Offset: start, Value: name == "true"}, nil
+	}
+	if p.peekString("(") {
+		return p.parseCall(start, name)
+	}
+
+	field := &FieldExpr{Offset: start, Name: name}
+	for p.peekString("[") {
+		p.pos++
+		p.skipSpace()
+		var index Index
+		switch {
+		case p.peekString("*"):
+			p.pos++
+			index.All = true
+		case p.peekString(`"`) || p.peekRawString():
+			key, err := p.parseString()
+			if err != nil {
+				return nil, err
+			}
+			index.Key = &key.Value
+		default:
+			indexStart := p.pos
+			element, err := strconv.ParseInt(p.scanWord(), 10, 64)
+			if err != nil || element < 0 {
+				return nil, p.errorf(indexStart, "expected a string, an array index or *, found %q", p.src[indexStart:p.pos])
+			}
+			index.Element = &element
+		}
+		p.skipSpace()
+		if !p.peekString("]") {
+			return nil, p.errorf(p.pos, "expected ], found %s", p.describeNext())
+		}
+		p.pos++
+		field.Indexes = append(field.Indexes, index)
+	}
+	return field, nil
+}
+
+func (p *parser) parseCall(start int, name string) (Node, error) {
+	call := &CallExpr{Offset: start, Name: name, Args: []Node{}}
+	p.pos++
+	p.skipSpace()
+	if p.peekString(")") {
+		p.pos++
+		return call, nil
+	}
+	for {
+		arg, err := p.parseArg()
+		if err != nil {
+			return nil, err
+		}
+		call.Args = append(call.Args, arg)
+		p.skipSpace()
+		switch {
+		case p.peekString(","):
+			p.pos++
+		case p.peekString(")"):
+			p.pos++
+			return call, nil
+		default:
+			return nil, p.errorf(p.pos, "expected , or ) in the arguments of %s, found %s", name, p.describeNext())
+		}
+	}
+}
+
+// parseArg parses a function argument: a string, a number or a condition.
+func (p *parser) parseArg() (Node, error) {
+	p.skipSpace()
+	switch {
+	case p.peekString(`"`) || p.peekRawString():
+		return p.parseString()
+	case !p.eof() && (isDigit(p.src[p.pos]) || p.src[p.pos] == '-'):
+		return p.parseBareValue()
+	}
+	return p.parseLogical(0)
+}
+
+func (p *parser) parseValue(op string) (Node, error) {
+	p.skipSpace()
+	switch {
+	case p.eof():
+		return nil, p.errorf(p.pos, "expected a value after %s, found the end of the expression", op)
+	case p.peekString("{"):
+		return p.parseSet()
+	case p.peekString(`"`) || p.peekRawString():
+		return p.parseString()
+	}
+	return p.parseBareValue()
+}
+
+func (p *parser) parseSet() (Node, error) {
+	set := &SetLiteral{Offset: p.pos}
+	p.pos++
+	for {
+		p.skipSpace()
+		switch {
+		case p.eof():
+			return nil, p.errorf(set.Offset, "unterminated set")
+		case p.peekString("}"):
+			p.pos++
+			if len(set.Elements) == 0 {
+				return nil, p.errorf(set.Offset, "empty set")
+			}
+			return set, nil
+		case p.peekString("{"):
+			return nil, p.errorf(p.pos, "sets can't be nested")
+		}
+		var element Node
+		var err error
+		if p.peekString(`"`) || p.peekRawString() {
+			element, err = p.parseString()
+		} else {
+			element, err = p.parseBareValue()
+		}
+		if err != nil {
+			return nil, err
+		}
+		set.Elements = append(set.Elements, element)
+	}
+}
+
+// parseString parses a quoted string, in which \", \\ and \xHH are escapes, or a raw string such as r"\d+" or
+// r#"say "hi""#.
+func (p *parser) parseString() (*StringLiteral, error) {
+	start := p.pos
+	if p.src[p.pos] == 'r' {
+		p.pos++
+		hashes := 0
+		for !p.eof() && p.src[p.pos] == '#' {
+			hashes++
+			p.pos++
+		}
+		if !p.peekString(`"`) {
+			return nil, p.errorf(start, "invalid raw string")
+		}
+		p.pos++
+		terminator := `"` + strings.Repeat("#", hashes)
+		end := strings.Index(p.src[p.pos:], terminator)
+		if end < 0 {
+			return nil, p.errorf(start, "unterminated string")
+		}
+		value := p.src[p.pos : p.pos+end]
+		p.pos += end + len(terminator)
+		return &StringLiteral{Offset: start, Value: value, Raw: true}, nil
+	}
+
+	p.pos++
+	var value strings.Builder
+	for {
+		if p.eof() {
+			return nil, p.errorf(start, "unterminated string")
+		}
+		c := p.src[p.pos]
+		p.pos++
+		switch c {
+		case '"':
+			return &StringLiteral{Offset: start, Value: value.String()}, nil
+		case '\\':
+			if p.eof() {
+				return nil, p.errorf(start, "unterminated string")
+			}
+			escape := p.src[p.pos]
+			p.pos++
+			switch escape {
+			case '"', '\\':
+				value.WriteByte(escape)
+			case 'x':
+				if p.pos+2 > len(p.src) {
+					return nil, p.errorf(p.pos-2, `invalid escape \x`)
+				}
+				b, err := strconv.ParseUint(p.src[p.pos:p.pos+2], 16, 8)
+				if err != nil {
+					return nil, p.errorf(p.pos-2, `invalid escape \x%s`, p.src[p.pos:p.pos+2])
+				}
+				value.WriteByte(byte(b))
+				p.pos += 2
+			default:
+				return nil, p.errorf(p.pos-2, `invalid escape \%c; only \", \\ and \xHH are allowed, or use a raw string r"..."`, escape)
+			}
+		default:
+			value.WriteByte(c)
+		}
+	}
+}
+
+// parseBareValue parses an unquoted value: an integer, an IP address or CIDR range, a range of either, true or false,
+// or a list reference.
+func (p *parser) parseBareValue() (Node, error) {
+	start := p.pos
+	word := p.scanWord()
+	if word == "" {
+		return nil, p.errorf(start, "expected a value, found %s", p.describeNext())
+	}
+	switch {
+	case word == "true" || word == "false":
+		return &BoolLiteral{Offset: start, Value: word == "true"}, nil
+	case strings.HasPrefix(word, "$"):
+		name := word[1:]
+		if name == "" || strings.TrimLeft(name, "abcdefghijklmnopqrstuvwxyz0123456789_.") != "" {
+			return nil, p.errorf(start, "invalid list name %q", word)
+		}
+		return &ListRef{Offset: start, Name: name}, nil
+	}
+
+	if from, to, found := strings.Cut(word, ".."); found {
+		fromInt, fromErr := strconv.ParseInt(from, 10, 64)
+		toInt, toErr := strconv.ParseInt(to, 10, 64)
+		if fromErr == nil && toErr == nil {
+			if fromInt > toInt {
+				return nil, p.errorf(start, "the range %s is empty", word)
+			}
+			return &IntRange{Offset: start, From: fromInt, To: toInt}, nil
+		}
+		fromAddr, fromErr := parseAddr(from)
+		toAddr, toErr := parseAddr(to)
+		if fromErr == nil && toErr == nil {
+			if fromAddr.Is4() != toAddr.Is4() || fromAddr.Compare(toAddr) > 0 {
+				return nil, p.errorf(start, "the range %s is empty", word)
+			}
+			return &IPRange{Offset: start, From: fromAddr, To: toAddr}, nil
+		}
+		return nil, p.errorf(start, "invalid range %q", word)
+	}
+
+	if value, err := strconv.ParseInt(word, 10, 64); err == nil {
+		return &IntLiteral{Offset: start, Value: value}, nil
+	}
+	if strings.Contains(word, "/") {
+		prefix, err := netip.ParsePrefix(word)
+		if err != nil {
+			return nil, p.errorf(start, "invalid CIDR range %q", word)
+		}
+		return &IPLiteral{Offset: start, Prefix: prefix.Masked()}, nil
+	}
+	if addr, err := parseAddr(word); err == nil {
+		return &IPLiteral{Offset: start, Prefix: netip.PrefixFrom(addr, addr.BitLen())}, nil
+	}
+	return nil, p.errorf(start, "invalid value %q; strings must be quoted", word)
+}
+
+func parseAddr(s string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(s)
+	if err == nil && addr.Zone() != "" {
+		err = fmt.Errorf("zoned addresses are not supported")
+	}
+	return addr, err
+}
+
+// acceptOperator consumes the word or the symbol of an operator.
+func (p *parser) acceptOperator(op operator) bool {
+	if op.symbol != "" && p.peekString(op.symbol) {
+		p.pos += len(op.symbol)
+		return true
+	}
+	if op.word == OpStrictWildcard {
+		start := p.pos
+		if p.acceptWord("strict") {
+			p.skipSpace()
+			if p.acceptWord(OpWildcard) {
+				return true
+			}
+		}
+		p.pos = start
+		return false
+	}
+	return p.acceptWord(op.word)
+}
+
+// acceptWord consumes a word that is not the start of a longer identifier.
+func (p *parser) acceptWord(word string) bool {
+	if !p.peekString(word) {
+		return false
+	}
+	end := p.pos + len(word)
+	if end < len(p.src) && isIdentifierChar(p.src[end]) {
+		return false
+	}
+	p.pos = end
+	return true
+}
+
+// scanIdentifier consumes a field, function or keyword name.
+func (p *parser) scanIdentifier() string {
+	start := p.pos
+	if p.eof() || !(isLetter(p.src[p.pos]) || p.src[p.pos] == '_') {
+		return ""
+	}
+	for !p.eof() && isIdentifierChar(p.src[p.pos]) {
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+// scanWord consumes an unquoted value.
+func (p *parser) scanWord() string {
+	start := p.pos
+	for !p.eof() && !strings.ContainsRune(" \t\r\n(){}[]\",", rune(p.src[p.pos])) {
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+func (p *parser) skipSpace() {
+	for !p.eof() && strings.ContainsRune(" \t\r\n", rune(p.src[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *parser) peekString(s string) bool {
+	return strings.HasPrefix(p.src[p.pos:], s)
+}
+
+func (p *parser) peekRawString() bool {
+	return p.peekString(`r"`) || p.peekString(`r#`)
+}
+
+// describeNext describes the text at the current position for error messages.
+func (p *parser) describeNext() string {
+	if p.eof() {
+		return "the end of the expression"
+	}
+	start := p.pos
+	word := p.scanIdentifier()
+	if word == "" {
+		word = p.scanWord()
+	}
+	if word == "" {
+		word = p.src[start : start+1]
+	}
+	p.pos = start
+	return strconv.Quote(word)
+}
+
+func (p *parser) errorf(offset int, format string, args ...interface{}) error {
+	return &ParseError{Offset: offset, Err: fmt.Errorf(format, args...)}
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentifierChar(c byte) bool {
+	return isLetter(c) || isDigit(c) || c == '_' || c == '.'
+}
@@ -0,0 +1,92 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firewallexpression
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	expression, err := Parse(`(http.request.uri.path contains "/admin" and ip.src ne 1.2.3.4)`)
+	assert.Nil(t, err)
+	logical := expression.Root.(*LogicalExpr)
+	assert.Equal(t, OpAnd, logical.Op)
+	assert.Equal(t, &ComparisonExpr{
+		Offset: 1,
+		Left:   &FieldExpr{Offset: 1, Name: "http.request.uri.path"},
+		Op:     OpContains,
+		Right:  &StringLiteral{Offset: 32, Value: "/admin"},
+	}, logical.Operands[0])
+	assert.Equal(t, &IPLiteral{Offset: 55, Prefix: netip.MustParsePrefix("1.2.3.4/32")}, logical.Operands[1].(*ComparisonExpr).Right)
+	assert.Equal(t, []string{"http.request.uri.path", "ip.src"}, expression.Fields())
+
+	expression, err = Parse(`any(http.request.headers["x-api-key"][*] in $api_keys) and ip.src in {192.0.2.0/24 2001:db8::1..2001:db8::ff} and cf.edge.server_port in {80 8000..8999}`)
+	assert.Nil(t, err)
+	operands := expression.Root.(*LogicalExpr).Operands
+	field := operands[0].(*CallExpr).Args[0].(*ComparisonExpr).Left.(*FieldExpr)
+	assert.Equal(t, "x-api-key", *field.Indexes[0].Key)
+	assert.True(t, field.Indexes[1].All)
+	assert.Equal(t, &IPRange{Offset: 83, From: netip.MustParseAddr("2001:db8::1"), To: netip.MustParseAddr("2001:db8::ff")},
+		operands[1].(*ComparisonExpr).Right.(*SetLiteral).Elements[1])
+	assert.Equal(t, &IntRange{Offset: 141, From: 8000, To: 8999}, operands[2].(*ComparisonExpr).Right.(*SetLiteral).Elements[1])
+	assert.Equal(t, []string{"api_keys"}, expression.Lists())
+}
+
+func TestFormat(t *testing.T) {
+	for input, expected := range map[string]string{
+		`(http.request.uri.path contains "/admin" and ip.src ne 1.2.3.4)`:                     `http.request.uri.path contains "/admin" and ip.src ne 1.2.3.4`,
+		`http.host == "a" || (ip.src in {10.1.2.3/8} && !ssl) ^^ cf.threat_score>=10`:         `http.host eq "a" or ip.src in {10.0.0.0/8} and not ssl xor cf.threat_score ge 10`,
+		`(a or b) and not (c and d) and (e and (f or g))`:                                     `(a or b) and not (c and d) and e and (f or g)`,
+		`http.user_agent ~ r#"say "hi""#  and http.request.uri.path matches r"^/\d+$"`:        `http.user_agent matches r#"say "hi""# and http.request.uri.path matches r"^/\d+$"`,
+		"http.host   strict\n  wildcard \"*.Example.com\" and http.cookie eq \"a\\\"b\\x01\"": `http.host strict wildcard "*.Example.com" and http.cookie eq "a\"b\x01"`,
+		"http.cookie eq \"\x7fcafé\"":                                                         `http.cookie eq "\x7fcafé"`,
+		`starts_with(lower(http.request.headers["User-Agent"][0]),"curl")`:                    `starts_with(lower(http.request.headers["User-Agent"][0]), "curl")`,
+		`true`: `true`,
+	} {
+		formatted, err := Format(input)
+		assert.Nil(t, err, input)
+		assert.Equal(t, expected, formatted)
+		reformatted, err := Format(formatted)
+		assert.Nil(t, err)
+		assert.Equal(t, formatted, reformatted)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for input, expected := range map[string]string{
+		``:                                "firewallexpression: offset 0: the expression is empty",
+		`http.host eq example.com`:        `firewallexpression: offset 13: invalid value "example.com"; strings must be quoted`,
+		`http.host eq "a`:                 "firewallexpression: offset 13: unterminated string",
+		`(http.host eq "a"`:               "firewallexpression: offset 17: expected ), found the end of the expression",
+		`http.host eq "a" and`:            "firewallexpression: offset 20: expected a field or function, found the end of the expression",
+		`http.host eq "a" http.host`:      `firewallexpression: offset 17: unexpected "http.host"`,
+		`ip.src in {}`:                    "firewallexpression: offset 10: empty set",
+		`cf.edge.server_port in {90..80}`: "firewallexpression: offset 24: the range 90..80 is empty",
+		`http.host eq "\d"`:               `firewallexpression: offset 14: invalid escape \d; only \", \\ and \xHH are allowed, or use a raw string r"..."`,
+		`lower(http.host eq "a"`:          "firewallexpression: offset 22: expected , or ) in the arguments of lower, found the end of the expression",
+	} {
+		_, err := Parse(input)
+		var parseErr *ParseError
+		if assert.True(t, errors.As(err, &parseErr), input) {
+			assert.Equal(t, expected, err.Error())
+		}
+	}
+}
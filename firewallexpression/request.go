@@ -0,0 +1,353 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firewallexpression
+
+import (
+	"fmt"
+	"math"
+	"mime"
+	"net/netip"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// Request is a synthetic request to evaluate expressions against, such as a fixture of a rule review test. The fields
+// of the expressions are derived from it the way the edge derives them from a real request; a field the request
+// doesn't provide has its zero value, such as "" or 0.
+type Request struct {
+	// The method, GET by default.
+	Method string `json:"method,omitempty"`
+
+	// The full URL, such as https://www.example.com/login?next=%2F.
+	URL string `json:"url"`
+
+	// The HTTP version, HTTP/1.1 by default.
+	Version string `json:"version,omitempty"`
+
+	// The client IP address.
+	IP string `json:"ip,omitempty"`
+
+	// The ISO 3166-1 alpha-2 country code and the continent code of the client IP address.
+	Country   string `json:"country,omitempty"`
+	Continent string `json:"continent,omitempty"`
+
+	// The autonomous system number of the client IP address.
+	ASN int64 `json:"asn,omitempty"`
+
+	// The User-Agent header; it can also be given in Headers.
+	UserAgent string `json:"user_agent,omitempty"`
+
+	// The request headers, by name. Names are case-insensitive.
+	Headers map[string][]string `json:"headers,omitempty"`
+
+	// The request body.
+	Body string `json:"body,omitempty"`
+
+	// Any other field, such as cf.threat_score or cf.bot_management.score, by name. A value given here overrides the
+	// value derived from the rest of the request. Values can be given as decoded from JSON: numbers, strings for IP
+	// addresses, and arrays and objects for arrays and maps.
+	Fields map[string]interface{} `json:"fields,omitempty"`
+}
+
+// values returns the values of the fields, by name.
+func (request *Request) values() (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	for name, typ := range Fields {
+		values[name] = zeroValue(typ)
+	}
+
+	method := strings.ToUpper(request.Method)
+	if method == "" {
+		method = "GET"
+	}
+	values["http.request.method"] = method
+	values["http.request.version"] = "HTTP/1.1"
+	if request.Version != "" {
+		values["http.request.version"] = request.Version
+	}
+
+	if request.URL != "" {
+		u, err := url.Parse(request.URL)
+		if err != nil {
+			return nil, fmt.Errorf("firewallexpression: invalid request URL: %w", err)
+		}
+		setURLValues(values, u)
+	}
+
+	if request.IP != "" {
+		ip, err := netip.ParseAddr(request.IP)
+		if err != nil {
+			return nil, fmt.Errorf("firewallexpression: invalid request IP address %q", request.IP)
+		}
+		values["ip.src"] = ip.Unmap()
+	}
+	for _, name := range []string{"ip.src.country", "ip.geoip.country"} {
+		values[name] = request.Country
+	}
+	for _, name := range []string{"ip.src.continent", "ip.geoip.continent"} {
+		values[name] = request.Continent
+	}
+	for _, name := range []string{"ip.src.asnum", "ip.geoip.asnum"} {
+		values[name] = request.ASN
+	}
+
+	setHeaderValues(values, request.headers())
+
+	values["http.request.body.raw"] = request.Body
+	values["http.request.body.size"] = int64(len(request.Body))
+
+	for name, value := range request.Fields {
+		typ, ok := Fields[name]
+		if !ok {
+			return nil, fmt.Errorf("firewallexpression: unknown field %s in the request", name)
+		}
+		converted, ok := convertValue(typ, value)
+		if !ok {
+			return nil, fmt.Errorf("firewallexpression: the value of %s in the request is not %s", name, describeType(valueType{typ: typ}))
+		}
+		values[name] = converted
+	}
+	return values, nil
+}
+
+// headers returns the headers of the request with lower-case names, including the User-Agent.
+func (request *Request) headers() map[string][]string {
+	headers := map[string][]string{}
+	for name, values := range request.Headers {
+		name = strings.ToLower(name)
+		headers[name] = append(headers[name], values...)
+	}
+	if request.UserAgent != "" {
+		headers["user-agent"] = []string{request.UserAgent}
+	}
+	return headers
+}
+
+// setURLValues sets the values of the fields derived from the URL.
+func setURLValues(values map[string]interface{}, u *url.URL) {
+	values["http.host"] = strings.ToLower(u.Hostname())
+	values["ssl"] = u.Scheme == "https"
+	port := int64(80)
+	if u.Scheme == "https" {
+		port = 443
+	}
+	if u.Port() != "" {
+		port, _ = strconv.ParseInt(u.Port(), 10, 64)
+	}
+	values["cf.edge.server_port"] = port
+
+	rawPath := u.EscapedPath()
+	if rawPath == "" {
+		rawPath = "/"
+	}
+	decodedPath := u.Path
+	if decodedPath == "" {
+		decodedPath = "/"
+	}
+	uri := rawPath
+	if u.RawQuery != "" {
+		uri += "?" + u.RawQuery
+	}
+	fullURI := u.Scheme + "://" + u.Host + uri
+
+	values["http.request.uri"] = uri
+	values["http.request.uri.path"] = decodedPath
+	values["http.request.uri.query"] = u.RawQuery
+	values["http.request.full_uri"] = fullURI
+	values["raw.http.request.uri"] = uri
+	values["raw.http.request.uri.path"] = rawPath
+	values["raw.http.request.uri.query"] = u.RawQuery
+	values["raw.http.request.full_uri"] = fullURI
+	values["http.request.uri.path.extension"] = strings.ToLower(strings.TrimPrefix(path.Ext(decodedPath), "."))
+
+	args, rawArgs := map[string][]string{}, map[string][]string{}
+	var names, argValues []string
+	for _, pair := range strings.FieldsFunc(u.RawQuery, func(r rune) bool { return r == '&' }) {
+		rawName, rawValue, _ := strings.Cut(pair, "=")
+		name, value := queryUnescape(rawName), queryUnescape(rawValue)
+		args[name] = append(args[name], value)
+		rawArgs[rawName] = append(rawArgs[rawName], rawValue)
+		names = append(names, name)
+		argValues = append(argValues, value)
+	}
+	values["http.request.uri.args"] = args
+	values["raw.http.request.uri.args"] = rawArgs
+	values["http.request.uri.args.names"] = names
+	values["http.request.uri.args.values"] = argValues
+}
+
+// setHeaderValues sets the values of the fields derived from the headers, which have lower-case names.
+func setHeaderValues(values map[string]interface{}, headers map[string][]string) {
+	first := func(name string) string {
+		if len(headers[name]) == 0 {
+			return ""
+		}
+		return headers[name][0]
+	}
+
+	var headerNames, headerValues []string
+	for _, name := range sortedKeys(headers) {
+		for _, value := range headers[name] {
+			headerNames = append(headerNames, name)
+			headerValues = append(headerValues, value)
+		}
+	}
+	values["http.request.headers"] = headers
+	values["http.request.headers.names"] = headerNames
+	values["http.request.headers.values"] = headerValues
+
+	values["http.user_agent"] = first("user-agent")
+	values["http.referer"] = first("referer")
+	values["http.x_forwarded_for"] = strings.Join(headers["x-forwarded-for"], ", ")
+
+	cookie := strings.Join(headers["cookie"], "; ")
+	cookies := map[string][]string{}
+	for _, pair := range strings.Split(cookie, ";") {
+		name, value, _ := strings.Cut(strings.TrimSpace(pair), "=")
+		if name != "" {
+			cookies[name] = append(cookies[name], value)
+		}
+	}
+	values["http.cookie"] = cookie
+	values["http.request.cookies"] = cookies
+
+	var languages []string
+	for _, header := range headers["accept-language"] {
+		for _, language := range strings.Split(header, ",") {
+			language, _, _ = strings.Cut(language, ";")
+			if language = strings.TrimSpace(language); language != "" {
+				languages = append(languages, language)
+			}
+		}
+	}
+	values["http.request.accepted_languages"] = languages
+
+	if mediaType, _, err := mime.ParseMediaType(first("content-type")); err == nil {
+		values["http.request.body.mime"] = mediaType
+	}
+}
+
+// queryUnescape decodes a query string name or value, keeping it as is when it isn't properly encoded.
+func queryUnescape(s string) string {
+	if unescaped, err := url.QueryUnescape(s); err == nil {
+		return unescaped
+	}
+	return s
+}
+
+// zeroValue returns the value of a field of the given type that the request doesn't provide.
+func zeroValue(typ string) interface{} {
+	switch typ {
+	case TypeString:
+		return ""
+	case TypeInt:
+		return int64(0)
+	case TypeBool:
+		return false
+	case TypeIP:
+		return netip.Addr{}
+	case TypeArrayString:
+		return []string(nil)
+	case TypeArrayBool:
+		return []bool(nil)
+	case TypeMapArrayString:
+		return map[string][]string{}
+	}
+	return nil
+}
+
+// convertValue converts a value of Request.Fields to the representation of the given type.
+func convertValue(typ string, value interface{}) (interface{}, bool) {
+	switch typ {
+	case TypeString:
+		s, ok := value.(string)
+		return s, ok
+	case TypeInt:
+		switch value := value.(type) {
+		case int:
+			return int64(value), true
+		case int64:
+			return value, true
+		case float64:
+			return int64(value), value == math.Trunc(value)
+		}
+	case TypeBool:
+		b, ok := value.(bool)
+		return b, ok
+	case TypeIP:
+		switch value := value.(type) {
+		case netip.Addr:
+			return value.Unmap(), true
+		case string:
+			ip, err := netip.ParseAddr(value)
+			return ip.Unmap(), err == nil
+		}
+	case TypeArrayString:
+		return convertStrings(value)
+	case TypeArrayBool:
+		switch value := value.(type) {
+		case []bool:
+			return value, true
+		case []interface{}:
+			bools := make([]bool, len(value))
+			for i, element := range value {
+				b, ok := element.(bool)
+				if !ok {
+					return nil, false
+				}
+				bools[i] = b
+			}
+			return bools, true
+		}
+	case TypeMapArrayString:
+		switch value := value.(type) {
+		case map[string][]string:
+			return value, true
+		case map[string]interface{}:
+			converted := map[string][]string{}
+			for key, element := range value {
+				elements, ok := convertStrings(element)
+				if !ok {
+					return nil, false
+				}
+				converted[key] = elements
+			}
+			return converted, true
+		}
+	}
+	return nil, false
+}
+
+func convertStrings(value interface{}) ([]string, bool) {
+	switch value := value.(type) {
+	case []string:
+		return value, true
+	case []interface{}:
+		converted := make([]string, len(value))
+		for i, element := range value {
+			s, ok := element.(string)
+			if !ok {
+				return nil, false
+			}
+			converted[i] = s
+		}
+		return converted, true
+	}
+	return nil, false
+}
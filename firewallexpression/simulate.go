@@ -0,0 +1,236 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firewallexpression
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/IBM/networking-go-sdk/firewallrulesv1"
+	"github.com/IBM/networking-go-sdk/rulesetsv1"
+)
+
+// Actions of rules. Allow and the challenge and block actions end the evaluation of the rules; log records the match
+// and continues, and skip continues without the rules it skips.
+const (
+	ActionAllow            = "allow"
+	ActionBlock            = "block"
+	ActionChallenge        = "challenge"
+	ActionJsChallenge      = "js_challenge"
+	ActionLog              = "log"
+	ActionManagedChallenge = "managed_challenge"
+	ActionSkip             = "skip"
+)
+
+// terminalActions are the actions that end the evaluation of the rules.
+var terminalActions = map[string]bool{
+	ActionAllow:            true,
+	ActionBlock:            true,
+	ActionChallenge:        true,
+	ActionJsChallenge:      true,
+	ActionManagedChallenge: true,
+}
+
+// actionOrder is the order in which firewall rules without a priority run, by action.
+var actionOrder = []string{ActionLog, ActionAllow, ActionManagedChallenge, ActionChallenge, ActionJsChallenge, ActionBlock}
+
+// Rule is a rule to simulate: a firewallrulesv1 firewall rule with its filter, or a rulesetsv1 rule.
+type Rule struct {
+	ID          string
+	Description string
+	Expression  string
+	Action      string
+
+	// Rules with a priority run first, the lowest priority first; the other rules run after them in the order of their
+	// actions: log, allow, managed_challenge, challenge, js_challenge and block.
+	Priority *int64
+
+	// A paused or disabled rule is not evaluated.
+	Paused bool
+
+	// For a skip rule, whether it skips all the remaining rules, and the IDs of the rules it skips otherwise.
+	SkipRemaining bool
+	SkipRuleIDs   []string
+}
+
+// FirewallRules returns the rules to simulate for firewall rules.
+func FirewallRules(firewallRules []firewallrulesv1.FirewallRuleObject) []*Rule {
+	rules := make([]*Rule, len(firewallRules))
+	for i, firewallRule := range firewallRules {
+		rule := &Rule{
+			ID:          stringValue(firewallRule.ID),
+			Description: stringValue(firewallRule.Description),
+			Action:      stringValue(firewallRule.Action),
+			Priority:    firewallRule.Priority,
+			Paused:      firewallRule.Paused != nil && *firewallRule.Paused,
+		}
+		if filter := firewallRule.Filter; filter != nil {
+			rule.Expression = stringValue(filter.Expression)
+			rule.Paused = rule.Paused || (filter.Paused != nil && *filter.Paused)
+		}
+		rules[i] = rule
+	}
+	return rules
+}
+
+// RulesetRules returns the rules to simulate for the rules of a ruleset, which run in order.
+func RulesetRules(rulesetRules []rulesetsv1.RuleDetails) []*Rule {
+	rules := make([]*Rule, len(rulesetRules))
+	for i, rulesetRule := range rulesetRules {
+		priority := int64(i + 1)
+		rule := &Rule{
+			ID:          stringValue(rulesetRule.ID),
+			Description: stringValue(rulesetRule.Description),
+			Expression:  stringValue(rulesetRule.Expression),
+			Action:      stringValue(rulesetRule.Action),
+			Priority:    &priority,
+			Paused:      rulesetRule.Enabled != nil && !*rulesetRule.Enabled,
+		}
+		if parameters := rulesetRule.ActionParameters; parameters != nil {
+			rule.SkipRemaining = stringValue(parameters.Ruleset) == "current" || containsString(parameters.Rulesets, "current")
+			for _, ruleIDs := range parameters.Rules {
+				rule.SkipRuleIDs = append(rule.SkipRuleIDs, ruleIDs...)
+			}
+		}
+		rules[i] = rule
+	}
+	return rules
+}
+
+// Simulator runs rules against requests.
+type Simulator struct {
+	// The rules, in the order they run.
+	Rules []*Rule
+
+	// The lists the expressions reference, by name, with their items: IP addresses, CIDR ranges, ASNs or hostnames.
+	Lists map[string][]string
+
+	expressions map[*Rule]*Expression
+}
+
+// NewSimulator : Instantiate Simulator
+// It orders the rules and parses their expressions, returning an error for a rule whose expression has a syntax error
+// or lint errors.
+func NewSimulator(rules []*Rule) (*Simulator, error) {
+	simulator := &Simulator{
+		Rules:       append([]*Rule{}, rules...),
+		expressions: map[*Rule]*Expression{},
+	}
+	for _, rule := range simulator.Rules {
+		expression, err := Parse(rule.Expression)
+		if err == nil {
+			err = expression.check()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("rule %s: %w", rule.ID, err)
+		}
+		simulator.expressions[rule] = expression
+	}
+	SortRules(simulator.Rules)
+	return simulator, nil
+}
+
+// SortRules sorts rules in the order they run: the rules with a priority first, the lowest priority first, then the
+// others in the order of their actions. Rules that tie keep their order.
+func SortRules(rules []*Rule) {
+	sort.SliceStable(rules, func(i, j int) bool {
+		a, b := rules[i], rules[j]
+		switch {
+		case a.Priority != nil && b.Priority != nil:
+			return *a.Priority < *b.Priority
+		case a.Priority != nil || b.Priority != nil:
+			return a.Priority != nil
+		}
+		return actionRank(a.Action) < actionRank(b.Action)
+	})
+}
+
+func actionRank(action string) int {
+	for rank, ordered := range actionOrder {
+		if action == ordered {
+			return rank
+		}
+	}
+	return len(actionOrder)
+}
+
+// Result is the outcome of simulating the rules against a request.
+type Result struct {
+	// The rule whose action ended the evaluation, and its action; nil and empty when the request passed all the rules.
+	Rule   *Rule
+	Action string
+
+	// The rules that matched the request, in the order they ran, including log and skip rules and Rule.
+	Matched []*Rule
+
+	// The rules that were not evaluated because a skip rule matched.
+	Skipped []*Rule
+}
+
+// Simulate runs the rules against a request, in order, until one with a terminal action matches it.
+func (simulator *Simulator) Simulate(request *Request) (*Result, error) {
+	values, err := request.values()
+	if err != nil {
+		return nil, err
+	}
+	evaluator := &evaluator{values: values, lists: simulator.Lists, regexps: map[string]*regexp.Regexp{}}
+
+	result := &Result{}
+	skipped := map[string]bool{}
+	skipRemaining := false
+	for _, rule := range simulator.Rules {
+		if rule.Paused {
+			continue
+		}
+		if skipRemaining || skipped[rule.ID] {
+			result.Skipped = append(result.Skipped, rule)
+			continue
+		}
+		expression, ok := simulator.expressions[rule]
+		if !ok {
+			return nil, fmt.Errorf("rule %s was added to the simulator after it was created", rule.ID)
+		}
+		matched, err := evaluator.condition(expression.Root)
+		if err != nil {
+			return nil, fmt.Errorf("rule %s: %w", rule.ID, err)
+		}
+		if !matched {
+			continue
+		}
+		result.Matched = append(result.Matched, rule)
+		switch {
+		case terminalActions[rule.Action]:
+			result.Rule = rule
+			result.Action = rule.Action
+			return result, nil
+		case rule.Action == ActionSkip:
+			skipRemaining = rule.SkipRemaining
+			for _, id := range rule.SkipRuleIDs {
+				skipped[id] = true
+			}
+		}
+	}
+	return result, nil
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
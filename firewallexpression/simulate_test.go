@@ -0,0 +1,167 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firewallexpression
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/firewallrulesv1"
+	"github.com/IBM/networking-go-sdk/rulesetsv1"
+	"github.com/stretchr/testify/assert"
+)
+
+func ruleIDs(rules []*Rule) (ids []string) {
+	for _, rule := range rules {
+		ids = append(ids, rule.ID)
+	}
+	return
+}
+
+func TestSimulateFirewallRules(t *testing.T) {
+	var firewallRules []firewallrulesv1.FirewallRuleObject
+	err := json.Unmarshal([]byte(`[
+		{"id": "block-admin", "paused": false, "action": "block",
+		 "filter": {"id": "f1", "paused": false, "expression": "http.request.uri.path contains \"/admin\""}},
+		{"id": "allow-office", "paused": false, "action": "allow",
+		 "filter": {"id": "f2", "paused": false, "expression": "ip.src in $office_networks"}},
+		{"id": "log-curl", "paused": false, "action": "log",
+		 "filter": {"id": "f3", "paused": false, "expression": "http.user_agent contains \"curl\""}},
+		{"id": "challenge-countries", "paused": false, "action": "challenge",
+		 "filter": {"id": "f4", "paused": true, "expression": "ip.geoip.country in {\"CN\" \"RU\"}"}},
+		{"id": "js-challenge-asn", "paused": false, "action": "js_challenge",
+		 "filter": {"id": "f5", "paused": false, "expression": "ip.geoip.asnum eq 64496"}}
+	]`), &firewallRules)
+	assert.Nil(t, err)
+
+	rules := FirewallRules(firewallRules)
+	assert.True(t, rules[3].Paused)
+
+	// Without priorities the rules run in the order of their actions.
+	simulator, err := NewSimulator(rules)
+	assert.Nil(t, err)
+	simulator.Lists = map[string][]string{"office_networks": {"192.0.2.0/24"}}
+	assert.Equal(t, []string{"log-curl", "allow-office", "challenge-countries", "js-challenge-asn", "block-admin"}, ruleIDs(simulator.Rules))
+
+	result, err := simulator.Simulate(&Request{URL: "https://example.com/admin", IP: "192.0.2.1", UserAgent: "curl/8.4.0"})
+	assert.Nil(t, err)
+	assert.Equal(t, "allow-office", result.Rule.ID)
+	assert.Equal(t, ActionAllow, result.Action)
+	assert.Equal(t, []string{"log-curl", "allow-office"}, ruleIDs(result.Matched))
+
+	result, err = simulator.Simulate(&Request{URL: "https://example.com/admin", IP: "203.0.113.1", Country: "CN", ASN: 64496})
+	assert.Nil(t, err)
+	assert.Equal(t, ActionJsChallenge, result.Action)
+	assert.Equal(t, []string{"js-challenge-asn"}, ruleIDs(result.Matched))
+
+	result, err = simulator.Simulate(&Request{URL: "https://example.com/", IP: "203.0.113.1"})
+	assert.Nil(t, err)
+	assert.Nil(t, result.Rule)
+	assert.Equal(t, "", result.Action)
+	assert.Empty(t, result.Matched)
+
+	// Rules with a priority run first.
+	rules[0].Priority = core.Int64Ptr(1)
+	simulator, err = NewSimulator(rules)
+	assert.Nil(t, err)
+	simulator.Lists = map[string][]string{"office_networks": {"192.0.2.0/24"}}
+	result, err = simulator.Simulate(&Request{URL: "https://example.com/admin", IP: "192.0.2.1", UserAgent: "curl/8.4.0"})
+	assert.Nil(t, err)
+	assert.Equal(t, "block-admin", result.Rule.ID)
+	assert.Equal(t, ActionBlock, result.Action)
+}
+
+func TestSimulateFirewallRulesWithPriorities(t *testing.T) {
+	var firewallRules []firewallrulesv1.FirewallRuleObject
+	err := json.Unmarshal([]byte(`[
+		{"id": "log-curl", "paused": false, "action": "log", "priority": 20,
+		 "filter": {"id": "f1", "paused": false, "expression": "http.user_agent contains \"curl\""}},
+		{"id": "allow-office", "paused": false, "action": "allow",
+		 "filter": {"id": "f2", "paused": false, "expression": "ip.src in $office_networks"}},
+		{"id": "block-admin", "paused": false, "action": "block", "priority": 10,
+		 "filter": {"id": "f3", "paused": false, "expression": "http.request.uri.path contains \"/admin\""}}
+	]`), &firewallRules)
+	assert.Nil(t, err)
+
+	// The priorities returned by the API are kept, and rules run in the order of them before the others.
+	rules := FirewallRules(firewallRules)
+	assert.Equal(t, core.Int64Ptr(20), rules[0].Priority)
+	assert.Nil(t, rules[1].Priority)
+	SortRules(rules)
+	assert.Equal(t, []string{"block-admin", "log-curl", "allow-office"}, ruleIDs(rules))
+
+	simulator, err := NewSimulator(FirewallRules(firewallRules))
+	assert.Nil(t, err)
+	simulator.Lists = map[string][]string{"office_networks": {"192.0.2.0/24"}}
+	result, err := simulator.Simulate(&Request{URL: "https://example.com/admin", IP: "192.0.2.1", UserAgent: "curl/8.4.0"})
+	assert.Nil(t, err)
+	assert.Equal(t, "block-admin", result.Rule.ID)
+	assert.Equal(t, []string{"block-admin"}, ruleIDs(result.Matched))
+}
+
+func TestSimulateRulesetRules(t *testing.T) {
+	var rulesetRules []rulesetsv1.RuleDetails
+	err := json.Unmarshal([]byte(`[
+		{"id": "skip-health", "action": "skip", "expression": "http.request.uri.path eq \"/health\"",
+		 "action_parameters": {"ruleset": "current"}},
+		{"id": "skip-partner", "action": "skip", "expression": "http.request.headers[\"x-partner\"][0] eq \"acme\"",
+		 "action_parameters": {"rules": {"managed": ["block-bots"]}}},
+		{"id": "block-bots", "action": "block", "expression": "cf.client.bot and not cf.bot_management.verified_bot"},
+		{"id": "disabled", "action": "block", "expression": "true", "enabled": false},
+		{"id": "managed-challenge-all", "action": "managed_challenge", "expression": "not ssl"}
+	]`), &rulesetRules)
+	assert.Nil(t, err)
+
+	simulator, err := NewSimulator(RulesetRules(rulesetRules))
+	assert.Nil(t, err)
+
+	result, err := simulator.Simulate(&Request{URL: "http://example.com/health", Fields: map[string]interface{}{"cf.client.bot": true}})
+	assert.Nil(t, err)
+	assert.Nil(t, result.Rule)
+	assert.Equal(t, []string{"skip-health"}, ruleIDs(result.Matched))
+	assert.Equal(t, []string{"skip-partner", "block-bots", "managed-challenge-all"}, ruleIDs(result.Skipped))
+
+	result, err = simulator.Simulate(&Request{
+		URL:     "http://example.com/",
+		Headers: map[string][]string{"X-Partner": {"acme"}},
+		Fields:  map[string]interface{}{"cf.client.bot": true},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, ActionManagedChallenge, result.Action)
+	assert.Equal(t, []string{"skip-partner", "managed-challenge-all"}, ruleIDs(result.Matched))
+	assert.Equal(t, []string{"block-bots"}, ruleIDs(result.Skipped))
+
+	result, err = simulator.Simulate(&Request{URL: "https://example.com/", Fields: map[string]interface{}{"cf.client.bot": true}})
+	assert.Nil(t, err)
+	assert.Equal(t, "block-bots", result.Rule.ID)
+}
+
+func TestSimulatorErrors(t *testing.T) {
+	_, err := NewSimulator([]*Rule{{ID: "typo", Expression: `http.hots eq "a"`, Action: ActionBlock}})
+	var lintErr *LintError
+	assert.True(t, errors.As(err, &lintErr))
+	assert.Equal(t, "rule typo: firewallexpression: offset 0: error: unknown field http.hots; did you mean http.host? (unknown-field)", err.Error())
+
+	simulator, err := NewSimulator([]*Rule{{ID: "list", Expression: `ip.src in $missing`, Action: ActionBlock}})
+	assert.Nil(t, err)
+	_, err = simulator.Simulate(&Request{IP: "192.0.2.1"})
+	var evalErr *EvaluationError
+	assert.True(t, errors.As(err, &evalErr))
+	assert.Equal(t, "rule list: firewallexpression: offset 10: the list $missing wasn't given", err.Error())
+}
@@ -159,6 +159,12 @@ func (firewallRules *FirewallRulesV1) DisableRetries() {
 	firewallRules.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (firewallRules *FirewallRulesV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(firewallRules.Service, policy)
+}
+
 // ListAllFirewallRules : List all firewall rules for a zone
 // List all firewall rules for a zone.
 func (firewallRules *FirewallRulesV1) ListAllFirewallRules(listAllFirewallRulesOptions *ListAllFirewallRulesOptions) (result *ListFirewallRulesResp, response *core.DetailedResponse, err error) {
@@ -210,6 +216,7 @@ func (firewallRules *FirewallRulesV1) ListAllFirewallRulesWithContext(ctx contex
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -282,6 +289,7 @@ func (firewallRules *FirewallRulesV1) CreateFirewallRulesWithContext(ctx context
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -354,6 +362,7 @@ func (firewallRules *FirewallRulesV1) UpdateFirewllRulesWithContext(ctx context.
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -420,6 +429,7 @@ func (firewallRules *FirewallRulesV1) DeleteFirewallRulesWithContext(ctx context
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -485,6 +495,7 @@ func (firewallRules *FirewallRulesV1) DeleteFirewallRuleWithContext(ctx context.
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -550,6 +561,7 @@ func (firewallRules *FirewallRulesV1) GetFirewallRuleWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -637,6 +649,7 @@ func (firewallRules *FirewallRulesV1) UpdateFirewallRuleWithContext(ctx context.
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1538,6 +1551,9 @@ type FirewallRuleObject struct {
 	// An existing filter.
 	Filter *FirewallRuleObjectFilter `json:"filter" validate:"required"`
 
+	// The priority of the firewall rule; rules with a lower priority run first. Omitted when the rule has none.
+	Priority *int64 `json:"priority,omitempty"`
+
 	// The creation date-time of the filter.
 	CreatedOn *string `json:"created_on" validate:"required"`
 
@@ -1578,6 +1594,10 @@ func UnmarshalFirewallRuleObject(m map[string]json.RawMessage, result interface{
 	if err != nil {
 		return
 	}
+	err = core.UnmarshalPrimitive(m, "priority", &obj.Priority)
+	if err != nil {
+		return
+	}
 	err = core.UnmarshalPrimitive(m, "created_on", &obj.CreatedOn)
 	if err != nil {
 		return
@@ -19,6 +19,7 @@ package firewallrulesv1_test
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -2286,6 +2287,39 @@ var _ = Describe(`FirewallRulesV1`, func() {
 			})
 		})
 	})
+	Describe(`Model unmarshaling tests`, func() {
+		It(`Invoke UnmarshalFirewallRuleObject successfully`, func() {
+			// Construct an instance of the model.
+			filter := new(firewallrulesv1.FirewallRuleObjectFilter)
+			filter.ID = core.StringPtr("6f58318e7fa2477a23112e8118c66f61")
+			filter.Paused = core.BoolPtr(false)
+			filter.Description = core.StringPtr("Login from office")
+			filter.Expression = core.StringPtr("ip.src eq 93.184.216.0 and (http.request.uri.path ~ \"^.*/wp-login.php$\" or http.request.uri.path ~ \"^.*/xmlrpc.php$\")")
+
+			model := new(firewallrulesv1.FirewallRuleObject)
+			model.ID = core.StringPtr("52161eb6af4241bb9d4b32394be72fdf")
+			model.Paused = core.BoolPtr(false)
+			model.Description = core.StringPtr("JS challenge site")
+			model.Action = core.StringPtr("js_challenge")
+			model.Filter = filter
+			model.Priority = core.Int64Ptr(int64(10))
+			model.CreatedOn = core.StringPtr("2019-01-01T12:00:00.000Z")
+			model.ModifiedOn = core.StringPtr("2019-01-01T12:00:00.000Z")
+
+			b, err := json.Marshal(model)
+			Expect(err).To(BeNil())
+
+			var raw map[string]json.RawMessage
+			err = json.Unmarshal(b, &raw)
+			Expect(err).To(BeNil())
+
+			var result *firewallrulesv1.FirewallRuleObject
+			err = firewallrulesv1.UnmarshalFirewallRuleObject(raw, &result)
+			Expect(err).To(BeNil())
+			Expect(result).ToNot(BeNil())
+			Expect(result).To(Equal(model))
+		})
+	})
 	Describe(`Utility function tests`, func() {
 		It(`Invoke CreateMockByteArray() successfully`, func() {
 			mockByteArray := CreateMockByteArray("This is a test")
@@ -172,6 +172,12 @@ func (globalLoadBalancerEvents *GlobalLoadBalancerEventsV1) DisableRetries() {
 	globalLoadBalancerEvents.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (globalLoadBalancerEvents *GlobalLoadBalancerEventsV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(globalLoadBalancerEvents.Service, policy)
+}
+
 // GetLoadBalancerEvents : List all load balancer events
 // Get load balancer events for all origins.
 func (globalLoadBalancerEvents *GlobalLoadBalancerEventsV1) GetLoadBalancerEvents(getLoadBalancerEventsOptions *GetLoadBalancerEventsOptions) (result *ListEventsResp, response *core.DetailedResponse, err error) {
@@ -215,6 +221,7 @@ func (globalLoadBalancerEvents *GlobalLoadBalancerEventsV1) GetLoadBalancerEvent
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancerEvents.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListEventsResp)
@@ -171,6 +171,12 @@ func (globalLoadBalancerMonitor *GlobalLoadBalancerMonitorV1) DisableRetries() {
 	globalLoadBalancerMonitor.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (globalLoadBalancerMonitor *GlobalLoadBalancerMonitorV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(globalLoadBalancerMonitor.Service, policy)
+}
+
 // ListAllLoadBalancerMonitors : List all load balancer monitors
 // List configured load balancer monitors for a user.
 func (globalLoadBalancerMonitor *GlobalLoadBalancerMonitorV1) ListAllLoadBalancerMonitors(listAllLoadBalancerMonitorsOptions *ListAllLoadBalancerMonitorsOptions) (result *ListMonitorResp, response *core.DetailedResponse, err error) {
@@ -214,6 +220,7 @@ func (globalLoadBalancerMonitor *GlobalLoadBalancerMonitorV1) ListAllLoadBalance
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancerMonitor.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListMonitorResp)
@@ -314,6 +321,7 @@ func (globalLoadBalancerMonitor *GlobalLoadBalancerMonitorV1) CreateLoadBalancer
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancerMonitor.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalMonitorResp)
@@ -419,6 +427,7 @@ func (globalLoadBalancerMonitor *GlobalLoadBalancerMonitorV1) EditLoadBalancerMo
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancerMonitor.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalMonitorResp)
@@ -478,6 +487,7 @@ func (globalLoadBalancerMonitor *GlobalLoadBalancerMonitorV1) DeleteLoadBalancer
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancerMonitor.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDeleteMonitorResp)
@@ -537,6 +547,7 @@ func (globalLoadBalancerMonitor *GlobalLoadBalancerMonitorV1) GetLoadBalancerMon
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancerMonitor.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalMonitorResp)
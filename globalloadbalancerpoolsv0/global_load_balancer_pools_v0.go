@@ -171,6 +171,12 @@ func (globalLoadBalancerPools *GlobalLoadBalancerPoolsV0) DisableRetries() {
 	globalLoadBalancerPools.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (globalLoadBalancerPools *GlobalLoadBalancerPoolsV0) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(globalLoadBalancerPools.Service, policy)
+}
+
 // ListAllLoadBalancerPools : List all pools
 // List all configured load balancer pools.
 func (globalLoadBalancerPools *GlobalLoadBalancerPoolsV0) ListAllLoadBalancerPools(listAllLoadBalancerPoolsOptions *ListAllLoadBalancerPoolsOptions) (result *ListLoadBalancerPoolsResp, response *core.DetailedResponse, err error) {
@@ -214,6 +220,7 @@ func (globalLoadBalancerPools *GlobalLoadBalancerPoolsV0) ListAllLoadBalancerPoo
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancerPools.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListLoadBalancerPoolsResp)
@@ -299,6 +306,7 @@ func (globalLoadBalancerPools *GlobalLoadBalancerPoolsV0) CreateLoadBalancerPool
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancerPools.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLoadBalancerPoolResp)
@@ -358,6 +366,7 @@ func (globalLoadBalancerPools *GlobalLoadBalancerPoolsV0) GetLoadBalancerPoolWit
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancerPools.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLoadBalancerPoolResp)
@@ -417,6 +426,7 @@ func (globalLoadBalancerPools *GlobalLoadBalancerPoolsV0) DeleteLoadBalancerPool
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancerPools.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDeleteLoadBalancerPoolResp)
@@ -507,6 +517,7 @@ func (globalLoadBalancerPools *GlobalLoadBalancerPoolsV0) EditLoadBalancerPoolWi
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancerPools.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLoadBalancerPoolResp)
@@ -111,6 +111,12 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) SetServiceURL(url string) erro
 	return globalLoadBalancers.Service.SetServiceURL(url)
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (globalLoadBalancers *GlobalLoadBalancersV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(globalLoadBalancers.Service, policy)
+}
+
 // ListLoadBalancers : List load balancers
 // List the Global Load Balancers for a given DNS zone.
 func (globalLoadBalancers *GlobalLoadBalancersV1) ListLoadBalancers(listLoadBalancersOptions *ListLoadBalancersOptions) (result *ListLoadBalancers, response *core.DetailedResponse, err error) {
@@ -153,6 +159,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) ListLoadBalancers(listLoadBala
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancers.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListLoadBalancers)
@@ -234,6 +241,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) CreateLoadBalancer(createLoadB
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancers.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLoadBalancer)
@@ -284,6 +292,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) DeleteLoadBalancer(deleteLoadB
 	}
 
 	response, err = globalLoadBalancers.Service.Request(request, nil)
+	err = common.NewAPIError(err, response)
 
 	return
 }
@@ -330,6 +339,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) GetLoadBalancer(getLoadBalance
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancers.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLoadBalancer)
@@ -411,6 +421,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) UpdateLoadBalancer(updateLoadB
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancers.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLoadBalancer)
@@ -464,6 +475,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) ListPools(listPoolsOptions *Li
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancers.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListPools)
@@ -551,6 +563,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) CreatePool(createPoolOptions *
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancers.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPool)
@@ -601,6 +614,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) DeletePool(deletePoolOptions *
 	}
 
 	response, err = globalLoadBalancers.Service.Request(request, nil)
+	err = common.NewAPIError(err, response)
 
 	return
 }
@@ -647,6 +661,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) GetPool(getPoolOptions *GetPoo
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancers.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPool)
@@ -734,6 +749,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) UpdatePool(updatePoolOptions *
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancers.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPool)
@@ -787,6 +803,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) ListMonitors(listMonitorsOptio
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancers.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListMonitors)
@@ -886,6 +903,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) CreateMonitor(createMonitorOpt
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancers.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalMonitor)
@@ -936,6 +954,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) DeleteMonitor(deleteMonitorOpt
 	}
 
 	response, err = globalLoadBalancers.Service.Request(request, nil)
+	err = common.NewAPIError(err, response)
 
 	return
 }
@@ -982,6 +1001,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) GetMonitor(getMonitorOptions *
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancers.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalMonitor)
@@ -1081,6 +1101,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) UpdateMonitor(updateMonitorOpt
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancers.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalMonitor)
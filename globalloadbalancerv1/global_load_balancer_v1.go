@@ -178,6 +178,12 @@ func (globalLoadBalancer *GlobalLoadBalancerV1) DisableRetries() {
 	globalLoadBalancer.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (globalLoadBalancer *GlobalLoadBalancerV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(globalLoadBalancer.Service, policy)
+}
+
 // ListAllLoadBalancers : List all load balancers
 // List configured load balancers.
 func (globalLoadBalancer *GlobalLoadBalancerV1) ListAllLoadBalancers(listAllLoadBalancersOptions *ListAllLoadBalancersOptions) (result *ListLoadBalancersResp, response *core.DetailedResponse, err error) {
@@ -222,6 +228,7 @@ func (globalLoadBalancer *GlobalLoadBalancerV1) ListAllLoadBalancersWithContext(
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancer.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListLoadBalancersResp)
@@ -317,6 +324,7 @@ func (globalLoadBalancer *GlobalLoadBalancerV1) CreateLoadBalancerWithContext(ct
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancer.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLoadBalancersResp)
@@ -417,6 +425,7 @@ func (globalLoadBalancer *GlobalLoadBalancerV1) EditLoadBalancerWithContext(ctx
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancer.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLoadBalancersResp)
@@ -477,6 +486,7 @@ func (globalLoadBalancer *GlobalLoadBalancerV1) DeleteLoadBalancerWithContext(ct
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancer.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDeleteLoadBalancersResp)
@@ -537,6 +547,7 @@ func (globalLoadBalancer *GlobalLoadBalancerV1) GetLoadBalancerSettingsWithConte
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancer.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLoadBalancersResp)
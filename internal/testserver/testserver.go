@@ -0,0 +1,99 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package testserver serves canned API responses to the tests of the helpers built on the service clients.
+package testserver
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Route : The response of a Server to the requests of a method and a path.
+type Route struct {
+	// The method of the requests; every method when empty.
+	Method string
+
+	// The path of the requests, without the prefix of the server; every path when empty.
+	Path string
+
+	// The status code of the response; 200 when 0.
+	StatusCode int
+
+	// The JSON body of the response.
+	Body string
+
+	// Writes the response instead of StatusCode and Body when set.
+	Handler http.HandlerFunc
+}
+
+// Server : An httptest.Server answering the requests of a test from routes. It records the body of the last request
+// to each path.
+type Server struct {
+	*httptest.Server
+
+	mutex  sync.Mutex
+	bodies map[string]string
+}
+
+// New starts a Server, closed at the end of the test, answering each request with the first route matching its
+// method and its path once "prefix" is trimmed. A request no route matches fails the test.
+func New(t *testing.T, prefix string, routes ...Route) *Server {
+	server := &Server{bodies: map[string]string{}}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		data, _ := io.ReadAll(req.Body)
+		if len(data) > 0 {
+			server.mutex.Lock()
+			server.bodies[req.URL.Path] = string(data)
+			server.mutex.Unlock()
+		}
+		req.Body = io.NopCloser(strings.NewReader(string(data)))
+
+		res.Header().Set("Content-type", "application/json")
+		path := strings.TrimPrefix(req.URL.Path, prefix)
+		for _, route := range routes {
+			if (route.Method != "" && route.Method != req.Method) || (route.Path != "" && route.Path != path) {
+				continue
+			}
+			if route.Handler != nil {
+				route.Handler(res, req)
+				return
+			}
+			if route.StatusCode != 0 {
+				res.WriteHeader(route.StatusCode)
+			}
+			fmt.Fprint(res, route.Body)
+			return
+		}
+		t.Errorf("unexpected request %s %s", req.Method, req.URL)
+		res.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "not found"}]}`)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// Body returns the body of the last request to "path", the full path of the request; empty when there was none.
+func (server *Server) Body(path string) string {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return server.bodies[path]
+}
@@ -203,6 +203,12 @@ func (listsApi *ListsApiV1) DisableRetries() {
 	listsApi.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (listsApi *ListsApiV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(listsApi.Service, policy)
+}
+
 // GetManagedLists : List Managed Lists
 // List available managed lists for your instance.
 func (listsApi *ListsApiV1) GetManagedLists(getManagedListsOptions *GetManagedListsOptions) (result *ManagedListsResp, response *core.DetailedResponse, err error) {
@@ -251,6 +257,7 @@ func (listsApi *ListsApiV1) GetManagedListsWithContext(ctx context.Context, getM
 	var rawResponse map[string]json.RawMessage
 	response, err = listsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_managed_lists", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -315,6 +322,7 @@ func (listsApi *ListsApiV1) GetCustomListsWithContext(ctx context.Context, getCu
 	var rawResponse map[string]json.RawMessage
 	response, err = listsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_custom_lists", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -396,6 +404,7 @@ func (listsApi *ListsApiV1) CreateCustomListsWithContext(ctx context.Context, cr
 	var rawResponse map[string]json.RawMessage
 	response, err = listsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_custom_lists", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -461,6 +470,7 @@ func (listsApi *ListsApiV1) GetCustomListWithContext(ctx context.Context, getCus
 	var rawResponse map[string]json.RawMessage
 	response, err = listsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_custom_list", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -537,6 +547,7 @@ func (listsApi *ListsApiV1) UpdateCustomListWithContext(ctx context.Context, upd
 	var rawResponse map[string]json.RawMessage
 	response, err = listsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_custom_list", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -602,6 +613,7 @@ func (listsApi *ListsApiV1) DeleteCustomListWithContext(ctx context.Context, del
 	var rawResponse map[string]json.RawMessage
 	response, err = listsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_custom_list", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -677,6 +689,7 @@ func (listsApi *ListsApiV1) GetListItemsWithContext(ctx context.Context, getList
 	var rawResponse map[string]json.RawMessage
 	response, err = listsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_list_items", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -752,6 +765,7 @@ func (listsApi *ListsApiV1) CreateListItemsWithContext(ctx context.Context, crea
 	var rawResponse map[string]json.RawMessage
 	response, err = listsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_list_items", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -829,6 +843,7 @@ func (listsApi *ListsApiV1) DeleteListItemsWithContext(ctx context.Context, dele
 	var rawResponse map[string]json.RawMessage
 	response, err = listsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_list_items", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -904,6 +919,7 @@ func (listsApi *ListsApiV1) UpdateListItemsWithContext(ctx context.Context, upda
 	var rawResponse map[string]json.RawMessage
 	response, err = listsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_list_items", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -970,6 +986,7 @@ func (listsApi *ListsApiV1) GetListItemWithContext(ctx context.Context, getListI
 	var rawResponse map[string]json.RawMessage
 	response, err = listsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_list_item", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1035,6 +1052,7 @@ func (listsApi *ListsApiV1) GetOperationStatusWithContext(ctx context.Context, g
 	var rawResponse map[string]json.RawMessage
 	response, err = listsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_operation_status", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -195,6 +195,12 @@ func (logpushJobsApi *LogpushJobsApiV1) DisableRetries() {
 	logpushJobsApi.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (logpushJobsApi *LogpushJobsApiV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(logpushJobsApi.Service, policy)
+}
+
 // GetLogpushJobsV2 : List logpush jobs
 // List configured logpush jobs for your domain.
 func (logpushJobsApi *LogpushJobsApiV1) GetLogpushJobsV2(getLogpushJobsV2Options *GetLogpushJobsV2Options) (result *ListLogpushJobsResp, response *core.DetailedResponse, err error) {
@@ -244,6 +250,7 @@ func (logpushJobsApi *LogpushJobsApiV1) GetLogpushJobsV2WithContext(ctx context.
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_logpush_jobs_v2", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -318,6 +325,7 @@ func (logpushJobsApi *LogpushJobsApiV1) CreateLogpushJobV2WithContext(ctx contex
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_logpush_job_v2", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -389,6 +397,7 @@ func (logpushJobsApi *LogpushJobsApiV1) GetLogpushJobV2WithContext(ctx context.C
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_logpush_job_v2", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -469,6 +478,7 @@ func (logpushJobsApi *LogpushJobsApiV1) UpdateLogpushJobV2WithContext(ctx contex
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_logpush_job_v2", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -540,6 +550,7 @@ func (logpushJobsApi *LogpushJobsApiV1) DeleteLogpushJobV2WithContext(ctx contex
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_logpush_job_v2", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -616,6 +627,7 @@ func (logpushJobsApi *LogpushJobsApiV1) GetLogpushOwnershipV2WithContext(ctx con
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_logpush_ownership_v2", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -695,6 +707,7 @@ func (logpushJobsApi *LogpushJobsApiV1) ValidateLogpushOwnershipChallengeV2WithC
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "validate_logpush_ownership_challenge_v2", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -761,6 +774,7 @@ func (logpushJobsApi *LogpushJobsApiV1) ListFieldsForDatasetV2WithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_fields_for_dataset_v2", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -827,6 +841,7 @@ func (logpushJobsApi *LogpushJobsApiV1) ListLogpushJobsForDatasetV2WithContext(c
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_logpush_jobs_for_dataset_v2", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -892,6 +907,7 @@ func (logpushJobsApi *LogpushJobsApiV1) GetLogsRetentionWithContext(ctx context.
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_logs_retention", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -968,6 +984,7 @@ func (logpushJobsApi *LogpushJobsApiV1) CreateLogRetentionWithContext(ctx contex
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_log_retention", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -0,0 +1,195 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package managedrulesets deploys the CIS managed rulesets in the
+// http_request_firewall_managed phase with typed overrides.
+//
+// A Catalog lists the managed rulesets of a zone or an instance and fetches
+// one with its rules and their categories. Overrides expresses the changes to
+// a managed ruleset, such as
+//
+//	managedrulesets.NewOverrides(owasp).
+//		SetCategoryAction("wordpress", "block").
+//		SetRuleEnabled("5de7edfa648c4d6891dc3e7f84534ffa", false).
+//		SetParanoiaLevel(2)
+//
+// and validates that every rule and category it references exists before the
+// Catalog deploys it with UpdateZoneEntrypointRuleset or
+// UpdateInstanceEntrypointRuleset.
+package managedrulesets
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/rulesetsv1"
+)
+
+// PhaseFirewallManaged is the phase the managed rulesets are deployed in.
+const PhaseFirewallManaged = rulesetsv1.UpdateZoneEntrypointRulesetOptions_RulesetPhase_HttpRequestFirewallManaged
+
+const (
+	actionExecute = "execute"
+	kindManaged   = "managed"
+)
+
+// Catalog lists, fetches and deploys the managed rulesets of a zone or an instance.
+type Catalog struct {
+	Service *rulesetsv1.RulesetsV1
+	Options *rulesetsv1.ScopeOptions
+}
+
+// NewCatalog returns a Catalog of the managed rulesets visible to "service". A nil "options" uses the defaults.
+func NewCatalog(service *rulesetsv1.RulesetsV1, options *rulesetsv1.ScopeOptions) (catalog *Catalog, err error) {
+	if service == nil {
+		err = core.SDKErrorf(nil, "the rulesets client is required", "missing-client", common.GetComponentInfo())
+		return
+	}
+	if options == nil {
+		options = rulesetsv1.NewScopeOptions()
+	}
+	err = options.Validate()
+	if err != nil {
+		return
+	}
+	catalog = &Catalog{Service: service, Options: options}
+	return
+}
+
+// List : List the managed rulesets
+// List the managed rulesets available to the zone or the instance.
+func (catalog *Catalog) List() (result []rulesetsv1.ListedRuleset, err error) {
+	result, err = catalog.ListWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListWithContext is an alternate form of the List method which supports a Context parameter
+func (catalog *Catalog) ListWithContext(ctx context.Context) (result []rulesetsv1.ListedRuleset, err error) {
+	var rulesets *rulesetsv1.ListRulesetsResp
+	if catalog.Options.Scope == rulesetsv1.ScopeOptions_Scope_Instance {
+		rulesets, _, err = catalog.Service.GetInstanceRulesetsWithContext(ctx, &rulesetsv1.GetInstanceRulesetsOptions{Headers: catalog.Options.Headers})
+	} else {
+		rulesets, _, err = catalog.Service.GetZoneRulesetsWithContext(ctx, &rulesetsv1.GetZoneRulesetsOptions{Headers: catalog.Options.Headers})
+	}
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-rulesets-error")
+		return
+	}
+	for _, ruleset := range rulesets.Result {
+		if stringValue(ruleset.Kind) == kindManaged {
+			result = append(result, ruleset)
+		}
+	}
+	return
+}
+
+// Get : Get a managed ruleset
+// Get the managed ruleset with the given ID, with its rules and their categories.
+func (catalog *Catalog) Get(rulesetID string) (result *ManagedRuleset, err error) {
+	result, err = catalog.GetWithContext(context.Background(), rulesetID)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetWithContext is an alternate form of the Get method which supports a Context parameter
+func (catalog *Catalog) GetWithContext(ctx context.Context, rulesetID string) (result *ManagedRuleset, err error) {
+	var ruleset *rulesetsv1.RulesetResp
+	if catalog.Options.Scope == rulesetsv1.ScopeOptions_Scope_Instance {
+		ruleset, _, err = catalog.Service.GetInstanceRulesetWithContext(ctx, &rulesetsv1.GetInstanceRulesetOptions{
+			RulesetID: core.StringPtr(rulesetID),
+			Headers:   catalog.Options.Headers,
+		})
+	} else {
+		ruleset, _, err = catalog.Service.GetZoneRulesetWithContext(ctx, &rulesetsv1.GetZoneRulesetOptions{
+			RulesetID: core.StringPtr(rulesetID),
+			Headers:   catalog.Options.Headers,
+		})
+	}
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-ruleset-error")
+		return
+	}
+	if ruleset.Result == nil || stringValue(ruleset.Result.Kind) != kindManaged {
+		err = core.SDKErrorf(nil, "ruleset "+rulesetID+" is not a managed ruleset", "not-managed", common.GetComponentInfo())
+		return
+	}
+	result = NewManagedRuleset(ruleset.Result)
+	return
+}
+
+// Deploy : Deploy managed rulesets
+// Validate the overrides, then publish the execute rules deploying their managed rulesets in the entrypoint ruleset
+// of the http_request_firewall_managed phase. An execute rule already deploying one of the managed rulesets is
+// replaced in place, keeping its ID; the others are added after the existing rules, which are kept.
+func (catalog *Catalog) Deploy(overrides ...*Overrides) (result *rulesetsv1.RulesetResp, err error) {
+	result, err = catalog.DeployWithContext(context.Background(), overrides...)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeployWithContext is an alternate form of the Deploy method which supports a Context parameter
+func (catalog *Catalog) DeployWithContext(ctx context.Context, overrides ...*Overrides) (result *rulesetsv1.RulesetResp, err error) {
+	deployed := map[string]*rulesetsv1.RuleCreate{}
+	var order []string
+	for _, override := range overrides {
+		err = core.ValidateNotNil(override, "overrides cannot be nil")
+		if err != nil {
+			err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+			return
+		}
+		var rule *rulesetsv1.RuleCreate
+		rule, err = override.RuleCreate()
+		if err != nil {
+			return
+		}
+		id := override.Ruleset.ID()
+		if _, ok := deployed[id]; !ok {
+			order = append(order, id)
+		}
+		deployed[id] = rule
+	}
+
+	existing, err := catalog.Service.GetScopedEntrypointRulesWithContext(ctx, catalog.Options, PhaseFirewallManaged)
+	if err != nil {
+		return
+	}
+
+	rules := []rulesetsv1.RuleCreate{}
+	for _, rule := range existing {
+		id := ""
+		if stringValue(rule.Action) == actionExecute && rule.ActionParameters != nil {
+			id = stringValue(rule.ActionParameters.ID)
+		}
+		if replacement, ok := deployed[id]; ok {
+			replacement.ID = rule.ID
+			replacement.Ref = rule.Ref
+			rules = append(rules, *replacement)
+			delete(deployed, id)
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	for _, id := range order {
+		if rule, ok := deployed[id]; ok {
+			rules = append(rules, *rule)
+		}
+	}
+
+	result, err = catalog.Service.UpdateScopedEntrypointRulesWithContext(ctx, catalog.Options, PhaseFirewallManaged, nil, rules)
+	return
+}
@@ -0,0 +1,188 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package managedrulesets
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/internal/testserver"
+	"github.com/IBM/networking-go-sdk/rulesetsv1"
+	"github.com/stretchr/testify/assert"
+)
+
+const rulesetsBody = `{"success": true, "errors": [], "messages": [], "result": [
+	{"id": "owasp", "name": "CIS OWASP Core Ruleset", "description": "", "kind": "managed", "phase": "http_request_firewall_managed", "version": "10", "last_updated": "x"},
+	{"id": "cis", "name": "CIS Managed Ruleset", "description": "", "kind": "managed", "phase": "http_request_firewall_managed", "version": "52", "last_updated": "x"},
+	{"id": "entry", "name": "zone", "description": "", "kind": "zone", "phase": "http_request_firewall_managed", "version": "3", "last_updated": "x"}
+]}`
+
+const owaspBody = `{"success": true, "errors": [], "messages": [], "result": {
+	"id": "owasp", "name": "CIS OWASP Core Ruleset", "description": "", "kind": "managed", "phase": "http_request_firewall_managed",
+	"version": "10", "last_updated": "x", "rules": [
+		{"id": "r1", "action": "score", "categories": ["paranoia-level-1", "wordpress"], "expression": "true"},
+		{"id": "r2", "action": "score", "categories": ["paranoia-level-2"], "expression": "true"},
+		{"id": "r3", "action": "score", "categories": ["paranoia-level-3"], "expression": "true"},
+		{"id": "r4", "action": "score", "categories": ["paranoia-level-4", "php"], "expression": "true"},
+		{"id": "threshold", "action": "block", "categories": [], "expression": "true"}
+	]}}`
+
+const cisBody = `{"success": true, "errors": [], "messages": [], "result": {
+	"id": "cis", "name": "CIS Managed Ruleset", "description": "", "kind": "managed", "phase": "http_request_firewall_managed",
+	"version": "52", "last_updated": "x", "rules": [
+		{"id": "c1", "action": "block", "categories": ["wordpress"], "expression": "true"},
+		{"id": "c2", "action": "block", "categories": ["joomla"], "expression": "true"}
+	]}}`
+
+const managedEntrypointBody = `{"success": true, "errors": [], "messages": [], "result": {
+	"id": "entry", "name": "zone", "description": "", "kind": "zone", "phase": "http_request_firewall_managed",
+	"version": "3", "last_updated": "x", "rules": [
+		{"id": "skip", "ref": "skip", "action": "skip", "expression": "ip.src eq 192.0.2.1", "action_parameters": {"ruleset": "current"}},
+		{"id": "old-owasp", "ref": "owasp-ref", "action": "execute", "expression": "true", "action_parameters": {"id": "owasp"}}
+	]}}`
+
+// catalogRoutes serve the managed rulesets owasp and cis, and the entrypoint ruleset of the
+// http_request_firewall_managed phase, which deploys owasp.
+var catalogRoutes = []testserver.Route{
+	{Path: "/rulesets", Body: rulesetsBody},
+	{Path: "/rulesets/owasp", Body: owaspBody},
+	{Path: "/rulesets/cis", Body: cisBody},
+	{Path: "/rulesets/entry", Body: managedEntrypointBody},
+	{Method: http.MethodGet, Path: "/rulesets/phases/http_request_firewall_managed/entrypoint", Body: managedEntrypointBody},
+	{Method: http.MethodPut, Path: "/rulesets/phases/http_request_firewall_managed/entrypoint",
+		Body: strings.Replace(managedEntrypointBody, `"version": "3"`, `"version": "4"`, 1)},
+}
+
+func newTestCatalog(t *testing.T, url string, scope string) *Catalog {
+	service, err := rulesetsv1.NewRulesetsV1(&rulesetsv1.RulesetsV1Options{
+		URL: url, Authenticator: &core.NoAuthAuthenticator{}, Crn: core.StringPtr("crn1"), ZoneIdentifier: core.StringPtr("zone1"),
+	})
+	assert.Nil(t, err)
+	catalog, err := NewCatalog(service, rulesetsv1.NewScopeOptions().SetScope(scope))
+	assert.Nil(t, err)
+	return catalog
+}
+
+func TestCatalog(t *testing.T) {
+	server := testserver.New(t, "/v1/crn1/zones/zone1", catalogRoutes...)
+	catalog := newTestCatalog(t, server.URL, rulesetsv1.ScopeOptions_Scope_Zone)
+
+	rulesets, err := catalog.List()
+	assert.Nil(t, err)
+	if assert.Len(t, rulesets, 2) {
+		assert.Equal(t, "owasp", *rulesets[0].ID)
+	}
+
+	owasp, err := catalog.Get("owasp")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"paranoia-level-1", "paranoia-level-2", "paranoia-level-3", "paranoia-level-4", "php", "wordpress"}, owasp.Categories())
+	assert.Equal(t, []string{"r4"}, owasp.CategoryRuleIDs("php"))
+	assert.Equal(t, "block", *owasp.Rule("threshold").Action)
+
+	_, err = catalog.Get("entry")
+	assert.Equal(t, "ruleset entry is not a managed ruleset", err.Error())
+}
+
+func TestOverrides(t *testing.T) {
+	server := testserver.New(t, "/v1/crn1/zones/zone1", catalogRoutes...)
+	owasp, err := newTestCatalog(t, server.URL, rulesetsv1.ScopeOptions_Scope_Zone).Get("owasp")
+	assert.Nil(t, err)
+
+	overrides := NewOverrides(owasp).
+		SetCategoryAction("wordpress", "block").
+		SetRuleEnabled("r2", false).
+		SetRuleAction("r2", "log").
+		SetParanoiaLevel(2).
+		SetCategoryEnabled("paranoia-level-4", true)
+	parameters, err := overrides.ActionParameters()
+	assert.Nil(t, err)
+	data, err := json.Marshal(parameters)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"id": "owasp", "overrides": {
+		"categories": [
+			{"category": "wordpress", "action": "block"},
+			{"category": "paranoia-level-4", "enabled": true},
+			{"category": "paranoia-level-1", "enabled": true},
+			{"category": "paranoia-level-2", "enabled": true},
+			{"category": "paranoia-level-3", "enabled": false}
+		],
+		"rules": [{"id": "r2", "enabled": false, "action": "log"}]
+	}}`, string(data))
+
+	overrides = NewOverrides(owasp).
+		SetCategoryAction("drupal", "block").
+		SetRuleEnabled("X", false).
+		SetParanoiaLevel(5).
+		SetSensitivityLevel("extreme")
+	_, err = overrides.RuleCreate()
+	assert.Equal(t, `sensitivity_level: must be high, medium or low, got "extreme"; `+
+		`categories[0].category: the ruleset has no category "drupal"; rules[0].id: the ruleset has no rule "X"; `+
+		`paranoia_level: must be between 1 and 4, got 5`, err.Error())
+
+	rule, err := NewOverrides(owasp).SetExpression(`http.host eq "example.com"`).RuleCreate()
+	assert.Nil(t, err)
+	assert.Equal(t, "execute", *rule.Action)
+	assert.Equal(t, "Execute CIS OWASP Core Ruleset", *rule.Description)
+	assert.Nil(t, rule.ActionParameters.Overrides)
+}
+
+func TestDeploy(t *testing.T) {
+	for _, scope := range []struct {
+		name   string
+		prefix string
+	}{
+		{rulesetsv1.ScopeOptions_Scope_Zone, "/v1/crn1/zones/zone1"},
+		{rulesetsv1.ScopeOptions_Scope_Instance, "/v1/crn1"},
+	} {
+		server := testserver.New(t, scope.prefix, catalogRoutes...)
+		catalog := newTestCatalog(t, server.URL, scope.name)
+		owasp, err := catalog.Get("owasp")
+		assert.Nil(t, err, scope.name)
+		cis, err := catalog.Get("cis")
+		assert.Nil(t, err, scope.name)
+
+		// Invalid overrides are not deployed.
+		_, err = catalog.Deploy(NewOverrides(cis).SetCategoryAction("php", "block"))
+		assert.NotNil(t, err, scope.name)
+		assert.Empty(t, server.Body(scope.prefix+"/rulesets/phases/http_request_firewall_managed/entrypoint"))
+		_, err = catalog.Deploy(NewOverrides(cis), nil)
+		if assert.NotNil(t, err, scope.name) {
+			assert.Contains(t, err.Error(), "overrides cannot be nil")
+		}
+		assert.Empty(t, server.Body(scope.prefix+"/rulesets/phases/http_request_firewall_managed/entrypoint"))
+
+		result, err := catalog.Deploy(NewOverrides(owasp).SetParanoiaLevel(1), NewOverrides(cis).SetCategoryAction("wordpress", "block"))
+		assert.Nil(t, err, scope.name)
+		assert.Equal(t, "4", *result.Result.Version)
+
+		var body struct {
+			Rules []rulesetsv1.RuleCreate `json:"rules"`
+		}
+		assert.Nil(t, json.Unmarshal([]byte(server.Body(scope.prefix+"/rulesets/phases/http_request_firewall_managed/entrypoint")), &body))
+		if assert.Len(t, body.Rules, 3, scope.name) {
+			assert.Equal(t, "skip", *body.Rules[0].ID)
+			assert.Equal(t, "old-owasp", *body.Rules[1].ID)
+			assert.Equal(t, "owasp-ref", *body.Rules[1].Ref)
+			assert.Len(t, body.Rules[1].ActionParameters.Overrides.Categories, 4)
+			assert.Nil(t, body.Rules[2].ID)
+			assert.Equal(t, "cis", *body.Rules[2].ActionParameters.ID)
+		}
+	}
+}
@@ -0,0 +1,342 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package managedrulesets
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/rulesetsv1"
+)
+
+// The highest paranoia level of the OWASP core ruleset, whose rules are in the paranoia-level-1 to paranoia-level-4
+// categories.
+const maxParanoiaLevel = 4
+
+// paranoiaLevelCategory returns the category of the rules of an OWASP paranoia level.
+func paranoiaLevelCategory(level int) string {
+	return fmt.Sprintf("paranoia-level-%d", level)
+}
+
+// ManagedRuleset : A managed ruleset with its rules, and the categories, or tags, of its rules.
+type ManagedRuleset struct {
+	Ruleset *rulesetsv1.RulesetDetails
+
+	rules      map[string]*rulesetsv1.RuleDetails
+	categories map[string][]string
+}
+
+// NewManagedRuleset returns a ManagedRuleset for the details of a managed ruleset, such as those GetZoneRuleset
+// returns.
+func NewManagedRuleset(ruleset *rulesetsv1.RulesetDetails) *ManagedRuleset {
+	managed := &ManagedRuleset{Ruleset: ruleset, rules: map[string]*rulesetsv1.RuleDetails{}, categories: map[string][]string{}}
+	for i, rule := range ruleset.Rules {
+		id := stringValue(rule.ID)
+		managed.rules[id] = &ruleset.Rules[i]
+		for _, category := range rule.Categories {
+			managed.categories[category] = append(managed.categories[category], id)
+		}
+	}
+	return managed
+}
+
+// ID returns the ID of the managed ruleset.
+func (managed *ManagedRuleset) ID() string {
+	return stringValue(managed.Ruleset.ID)
+}
+
+// Rule returns the rule with the given ID, or nil.
+func (managed *ManagedRuleset) Rule(id string) *rulesetsv1.RuleDetails {
+	return managed.rules[id]
+}
+
+// Categories returns the categories of the rules, sorted.
+func (managed *ManagedRuleset) Categories() []string {
+	categories := make([]string, 0, len(managed.categories))
+	for category := range managed.categories {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+	return categories
+}
+
+// CategoryRuleIDs returns the IDs of the rules in a category, in the order of the ruleset.
+func (managed *ManagedRuleset) CategoryRuleIDs(category string) []string {
+	return managed.categories[category]
+}
+
+// Overrides : The overrides of a managed ruleset deployed by an execute rule.
+// The setters record the overrides in the order they are called; setting the same rule or category again changes
+// its existing override. Validate checks them against the rules and categories of the managed ruleset.
+type Overrides struct {
+	Ruleset *ManagedRuleset
+
+	// The expression of the execute rule. By default "true", which runs the managed ruleset for every request.
+	Expression string
+
+	// The description of the execute rule.
+	Description string
+
+	// The overrides of the whole ruleset: the action and the enablement of all its rules, and its sensitivity level.
+	Action           string
+	Enabled          *bool
+	SensitivityLevel string
+
+	Categories []rulesetsv1.CategoriesOverride
+	Rules      []rulesetsv1.RulesOverride
+
+	// The OWASP paranoia level, from 1 to 4; 0 when the paranoia level is not overridden.
+	ParanoiaLevel int
+}
+
+// NewOverrides : Instantiate Overrides
+func NewOverrides(ruleset *ManagedRuleset) *Overrides {
+	return &Overrides{Ruleset: ruleset, Expression: "true"}
+}
+
+// SetExpression : Allow user to set Expression
+func (overrides *Overrides) SetExpression(expression string) *Overrides {
+	overrides.Expression = expression
+	return overrides
+}
+
+// SetDescription : Allow user to set Description
+func (overrides *Overrides) SetDescription(description string) *Overrides {
+	overrides.Description = description
+	return overrides
+}
+
+// SetAction : Allow user to set the action of all the rules
+func (overrides *Overrides) SetAction(action string) *Overrides {
+	overrides.Action = action
+	return overrides
+}
+
+// SetEnabled : Allow user to enable or disable all the rules
+func (overrides *Overrides) SetEnabled(enabled bool) *Overrides {
+	overrides.Enabled = core.BoolPtr(enabled)
+	return overrides
+}
+
+// SetSensitivityLevel : Allow user to set the sensitivity level of the ruleset
+func (overrides *Overrides) SetSensitivityLevel(sensitivityLevel string) *Overrides {
+	overrides.SensitivityLevel = sensitivityLevel
+	return overrides
+}
+
+// SetCategoryAction : Allow user to set the action of the rules of a category
+func (overrides *Overrides) SetCategoryAction(category string, action string) *Overrides {
+	overrides.category(category).Action = core.StringPtr(action)
+	return overrides
+}
+
+// SetCategoryEnabled : Allow user to enable or disable the rules of a category
+func (overrides *Overrides) SetCategoryEnabled(category string, enabled bool) *Overrides {
+	overrides.category(category).Enabled = core.BoolPtr(enabled)
+	return overrides
+}
+
+// SetRuleAction : Allow user to set the action of a rule
+func (overrides *Overrides) SetRuleAction(id string, action string) *Overrides {
+	overrides.rule(id).Action = core.StringPtr(action)
+	return overrides
+}
+
+// SetRuleEnabled : Allow user to enable or disable a rule
+func (overrides *Overrides) SetRuleEnabled(id string, enabled bool) *Overrides {
+	overrides.rule(id).Enabled = core.BoolPtr(enabled)
+	return overrides
+}
+
+// SetRuleSensitivityLevel : Allow user to set the sensitivity level of a rule
+func (overrides *Overrides) SetRuleSensitivityLevel(id string, sensitivityLevel string) *Overrides {
+	overrides.rule(id).SensitivityLevel = core.StringPtr(sensitivityLevel)
+	return overrides
+}
+
+// SetRuleScoreThreshold : Allow user to set the score threshold of a rule
+func (overrides *Overrides) SetRuleScoreThreshold(id string, scoreThreshold int64) *Overrides {
+	overrides.rule(id).ScoreThreshold = core.Int64Ptr(scoreThreshold)
+	return overrides
+}
+
+// SetParanoiaLevel : Allow user to set the OWASP paranoia level
+// The rules of the paranoia levels above "level" are disabled, and those of the levels up to "level" are enabled.
+func (overrides *Overrides) SetParanoiaLevel(level int) *Overrides {
+	overrides.ParanoiaLevel = level
+	return overrides
+}
+
+func (overrides *Overrides) category(category string) *rulesetsv1.CategoriesOverride {
+	for i := range overrides.Categories {
+		if stringValue(overrides.Categories[i].Category) == category {
+			return &overrides.Categories[i]
+		}
+	}
+	overrides.Categories = append(overrides.Categories, rulesetsv1.CategoriesOverride{Category: core.StringPtr(category)})
+	return &overrides.Categories[len(overrides.Categories)-1]
+}
+
+func (overrides *Overrides) rule(id string) *rulesetsv1.RulesOverride {
+	for i := range overrides.Rules {
+		if stringValue(overrides.Rules[i].ID) == id {
+			return &overrides.Rules[i]
+		}
+	}
+	overrides.Rules = append(overrides.Rules, rulesetsv1.RulesOverride{ID: core.StringPtr(id)})
+	return &overrides.Rules[len(overrides.Rules)-1]
+}
+
+// sensitivityLevels are the sensitivity levels of the ruleset and rule overrides.
+var sensitivityLevels = map[string]bool{
+	rulesetsv1.Overrides_SensitivityLevel_High:   true,
+	rulesetsv1.Overrides_SensitivityLevel_Low:    true,
+	rulesetsv1.Overrides_SensitivityLevel_Medium: true,
+}
+
+// overrideActions are the actions the rules of a managed ruleset can be overridden with.
+var overrideActions = map[string]bool{
+	"block":             true,
+	"challenge":         true,
+	"js_challenge":      true,
+	"log":               true,
+	"managed_challenge": true,
+	"score":             true,
+}
+
+// Validate checks the overrides and returns a common.ValidationErrors listing every override that references a
+// rule or a category the managed ruleset doesn't have, or has an unknown action, an unknown sensitivity level or an
+// invalid paranoia level.
+func (overrides *Overrides) Validate() error {
+	var validationErrs common.ValidationErrors
+	validateAction := func(field string, action *string) {
+		if action != nil && !overrideActions[*action] {
+			validationErrs.Add(field, "must be one of block, challenge, js_challenge, log, managed_challenge or score, got %q", *action)
+		}
+	}
+	validateSensitivityLevel := func(field string, level string) {
+		if level != "" && !sensitivityLevels[level] {
+			validationErrs.Add(field, "must be high, medium or low, got %q", level)
+		}
+	}
+
+	hasRuleset := overrides.Ruleset != nil && overrides.Ruleset.Ruleset != nil
+	if !hasRuleset {
+		validationErrs.Add("ruleset", "the managed ruleset is required")
+	}
+	if overrides.Action != "" {
+		validateAction("action", &overrides.Action)
+	}
+	validateSensitivityLevel("sensitivity_level", overrides.SensitivityLevel)
+	for i, category := range overrides.Categories {
+		field := fmt.Sprintf("categories[%d]", i)
+		if hasRuleset && len(overrides.Ruleset.CategoryRuleIDs(stringValue(category.Category))) == 0 {
+			validationErrs.Add(field+".category", "the ruleset has no category %q", stringValue(category.Category))
+		}
+		validateAction(field+".action", category.Action)
+	}
+	for i, rule := range overrides.Rules {
+		field := fmt.Sprintf("rules[%d]", i)
+		if hasRuleset && overrides.Ruleset.Rule(stringValue(rule.ID)) == nil {
+			validationErrs.Add(field+".id", "the ruleset has no rule %q", stringValue(rule.ID))
+		}
+		validateAction(field+".action", rule.Action)
+		validateSensitivityLevel(field+".sensitivity_level", stringValue(rule.SensitivityLevel))
+	}
+	if overrides.ParanoiaLevel != 0 {
+		if overrides.ParanoiaLevel < 1 || overrides.ParanoiaLevel > maxParanoiaLevel {
+			validationErrs.Add("paranoia_level", "must be between 1 and %d, got %d", maxParanoiaLevel, overrides.ParanoiaLevel)
+		} else if hasRuleset && len(overrides.Ruleset.categories[paranoiaLevelCategory(1)]) == 0 {
+			validationErrs.Add("paranoia_level", "the ruleset has no paranoia levels")
+		}
+	}
+	return validationErrs.Err()
+}
+
+// ActionParameters returns the action parameters of the execute rule deploying the managed ruleset with the
+// overrides, after validating them.
+func (overrides *Overrides) ActionParameters() (*rulesetsv1.ActionParameters, error) {
+	if err := overrides.Validate(); err != nil {
+		return nil, err
+	}
+	parameters := &rulesetsv1.ActionParameters{ID: overrides.Ruleset.Ruleset.ID}
+	result := &rulesetsv1.Overrides{
+		Enabled:    overrides.Enabled,
+		Categories: append([]rulesetsv1.CategoriesOverride{}, overrides.Categories...),
+		Rules:      overrides.Rules,
+	}
+	if overrides.Action != "" {
+		result.Action = core.StringPtr(overrides.Action)
+	}
+	if overrides.SensitivityLevel != "" {
+		result.SensitivityLevel = core.StringPtr(overrides.SensitivityLevel)
+	}
+	// The paranoia level enables the categories of the levels up to it and disables the ones above it, unless the
+	// category has an explicit override.
+	for level := 1; overrides.ParanoiaLevel != 0 && level <= maxParanoiaLevel; level++ {
+		category := paranoiaLevelCategory(level)
+		if len(overrides.Ruleset.categories[category]) == 0 || overrides.hasCategory(category) {
+			continue
+		}
+		result.Categories = append(result.Categories, rulesetsv1.CategoriesOverride{
+			Category: core.StringPtr(category),
+			Enabled:  core.BoolPtr(level <= overrides.ParanoiaLevel),
+		})
+	}
+	if result.Action != nil || result.Enabled != nil || result.SensitivityLevel != nil || len(result.Categories) > 0 || len(result.Rules) > 0 {
+		parameters.Overrides = result
+	}
+	return parameters, nil
+}
+
+func (overrides *Overrides) hasCategory(category string) bool {
+	for _, override := range overrides.Categories {
+		if stringValue(override.Category) == category {
+			return true
+		}
+	}
+	return false
+}
+
+// RuleCreate returns the execute rule deploying the managed ruleset with the overrides, after validating them.
+func (overrides *Overrides) RuleCreate() (*rulesetsv1.RuleCreate, error) {
+	parameters, err := overrides.ActionParameters()
+	if err != nil {
+		return nil, err
+	}
+	rule := &rulesetsv1.RuleCreate{
+		Action:           core.StringPtr(actionExecute),
+		ActionParameters: parameters,
+		Enabled:          core.BoolPtr(true),
+		Expression:       core.StringPtr(overrides.Expression),
+	}
+	if overrides.Description != "" {
+		rule.Description = core.StringPtr(overrides.Description)
+	} else if name := stringValue(overrides.Ruleset.Ruleset.Name); name != "" {
+		rule.Description = core.StringPtr("Execute " + name)
+	}
+	return rule, nil
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,91 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package managedrulesets
+
+import (
+	"encoding/json"
+	"testing"
+
+	common "github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/rulesetsv1"
+	"github.com/stretchr/testify/assert"
+)
+
+func managedRuleset(t *testing.T, body string) *ManagedRuleset {
+	var ruleset rulesetsv1.RulesetResp
+	assert.Nil(t, json.Unmarshal([]byte(body), &ruleset))
+	return NewManagedRuleset(ruleset.Result)
+}
+
+func validationFields(t *testing.T, err error) (fields []string) {
+	validationErrs, ok := common.AsValidationErrors(err)
+	assert.True(t, ok, "%v", err)
+	for _, validationErr := range validationErrs {
+		fields = append(fields, validationErr.Field)
+	}
+	return
+}
+
+func TestOverridesValidate(t *testing.T) {
+	owasp := managedRuleset(t, owaspBody)
+	cis := managedRuleset(t, cisBody)
+
+	assert.Nil(t, NewOverrides(owasp).
+		SetAction("log").
+		SetSensitivityLevel("medium").
+		SetCategoryAction("wordpress", "managed_challenge").
+		SetRuleAction("r1", "block").
+		SetRuleSensitivityLevel("r1", "low").
+		SetParanoiaLevel(3).
+		Validate())
+
+	// Invalid categories and category actions.
+	err := NewOverrides(cis).
+		SetCategoryAction("wordpress", "blok").
+		SetCategoryEnabled("php", false).
+		SetCategoryAction("joomla", "block").
+		Validate()
+	assert.Equal(t, []string{"categories[0].action", "categories[1].category"}, validationFields(t, err))
+	assert.Contains(t, err.Error(), `categories[0].action: must be one of block, challenge, js_challenge, log, managed_challenge or score, got "blok"`)
+	assert.Contains(t, err.Error(), `categories[1].category: the ruleset has no category "php"`)
+
+	// Invalid rules, rule actions and sensitivity levels.
+	err = NewOverrides(cis).
+		SetRuleEnabled("c1", false).
+		SetRuleAction("c2", "deny").
+		SetRuleSensitivityLevel("c3", "extreme").
+		Validate()
+	assert.Equal(t, []string{"rules[1].action", "rules[2].id", "rules[2].sensitivity_level"}, validationFields(t, err))
+
+	// Overrides of the whole ruleset.
+	err = NewOverrides(cis).SetAction("allow").SetParanoiaLevel(2).Validate()
+	assert.Equal(t, []string{"action", "paranoia_level"}, validationFields(t, err))
+	assert.Contains(t, err.Error(), "paranoia_level: the ruleset has no paranoia levels")
+
+	err = NewOverrides(nil).SetRuleEnabled("r1", false).Validate()
+	assert.Equal(t, []string{"ruleset"}, validationFields(t, err))
+}
+
+func TestOverridesRuleCreateIsValidated(t *testing.T) {
+	owasp := managedRuleset(t, owaspBody)
+
+	_, err := NewOverrides(owasp).SetRuleAction("r1", "blok").RuleCreate()
+	assert.Equal(t, []string{"rules[0].action"}, validationFields(t, err))
+
+	_, err = NewOverrides(owasp).SetCategoryEnabled("drupal", true).ActionParameters()
+	assert.Equal(t, []string{"categories[0].category"}, validationFields(t, err))
+}
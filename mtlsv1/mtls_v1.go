@@ -171,6 +171,12 @@ func (mtls *MtlsV1) DisableRetries() {
 	mtls.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (mtls *MtlsV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(mtls.Service, policy)
+}
+
 // ListAccessCertificates : List access certificates
 // List access certificates.
 func (mtls *MtlsV1) ListAccessCertificates(listAccessCertificatesOptions *ListAccessCertificatesOptions) (result *ListAccessCertsResp, response *core.DetailedResponse, err error) {
@@ -219,6 +225,7 @@ func (mtls *MtlsV1) ListAccessCertificatesWithContext(ctx context.Context, listA
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -296,6 +303,7 @@ func (mtls *MtlsV1) CreateAccessCertificateWithContext(ctx context.Context, crea
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -358,6 +366,7 @@ func (mtls *MtlsV1) GetAccessCertificateWithContext(ctx context.Context, getAcce
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -433,6 +442,7 @@ func (mtls *MtlsV1) UpdateAccessCertificateWithContext(ctx context.Context, upda
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -495,6 +505,7 @@ func (mtls *MtlsV1) DeleteAccessCertificateWithContext(ctx context.Context, dele
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -556,6 +567,7 @@ func (mtls *MtlsV1) ListAccessApplicationsWithContext(ctx context.Context, listA
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -633,6 +645,7 @@ func (mtls *MtlsV1) CreateAccessApplicationWithContext(ctx context.Context, crea
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -695,6 +708,7 @@ func (mtls *MtlsV1) GetAccessApplicationWithContext(ctx context.Context, getAcce
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -773,6 +787,7 @@ func (mtls *MtlsV1) UpdateAccessApplicationWithContext(ctx context.Context, upda
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -835,6 +850,7 @@ func (mtls *MtlsV1) DeleteAccessApplicationWithContext(ctx context.Context, dele
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -897,6 +913,7 @@ func (mtls *MtlsV1) ListAccessPoliciesWithContext(ctx context.Context, listAcces
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -975,6 +992,7 @@ func (mtls *MtlsV1) CreateAccessPolicyWithContext(ctx context.Context, createAcc
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1038,6 +1056,7 @@ func (mtls *MtlsV1) GetAccessPolicyWithContext(ctx context.Context, getAccessPol
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1117,6 +1136,7 @@ func (mtls *MtlsV1) UpdateAccessPolicyWithContext(ctx context.Context, updateAcc
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1180,6 +1200,7 @@ func (mtls *MtlsV1) DeleteAccessPolicyWithContext(ctx context.Context, deleteAcc
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1241,6 +1262,7 @@ func (mtls *MtlsV1) GetAccessCertSettingsWithContext(ctx context.Context, getAcc
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1312,6 +1334,7 @@ func (mtls *MtlsV1) UpdateAccessCertSettingsWithContext(ctx context.Context, upd
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1381,6 +1404,7 @@ func (mtls *MtlsV1) CreateAccessOrganizationWithContext(ctx context.Context, cre
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -178,6 +178,12 @@ func (pageRuleApi *PageRuleApiV1) DisableRetries() {
 	pageRuleApi.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (pageRuleApi *PageRuleApiV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(pageRuleApi.Service, policy)
+}
+
 // GetPageRule : Get page rule
 // Get a page rule details.
 func (pageRuleApi *PageRuleApiV1) GetPageRule(getPageRuleOptions *GetPageRuleOptions) (result *PageRulesResponseWithoutResultInfo, response *core.DetailedResponse, err error) {
@@ -227,6 +233,7 @@ func (pageRuleApi *PageRuleApiV1) GetPageRuleWithContext(ctx context.Context, ge
 	var rawResponse map[string]json.RawMessage
 	response, err = pageRuleApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPageRulesResponseWithoutResultInfo)
@@ -306,6 +313,7 @@ func (pageRuleApi *PageRuleApiV1) ChangePageRuleWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = pageRuleApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPageRulesResponseWithoutResultInfo)
@@ -385,6 +393,7 @@ func (pageRuleApi *PageRuleApiV1) UpdatePageRuleWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = pageRuleApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPageRulesResponseWithoutResultInfo)
@@ -445,6 +454,7 @@ func (pageRuleApi *PageRuleApiV1) DeletePageRuleWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = pageRuleApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPageRulesDeleteResponse)
@@ -513,6 +523,7 @@ func (pageRuleApi *PageRuleApiV1) ListPageRulesWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = pageRuleApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPageRulesResponseListAll)
@@ -587,6 +598,7 @@ func (pageRuleApi *PageRuleApiV1) CreatePageRuleWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = pageRuleApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPageRulesResponseWithoutResultInfo)
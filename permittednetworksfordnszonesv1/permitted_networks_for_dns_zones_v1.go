@@ -107,6 +107,12 @@ func (permittedNetworksForDnsZones *PermittedNetworksForDnsZonesV1) SetServiceUR
 	return permittedNetworksForDnsZones.Service.SetServiceURL(url)
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (permittedNetworksForDnsZones *PermittedNetworksForDnsZonesV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(permittedNetworksForDnsZones.Service, policy)
+}
+
 // ListPermittedNetworks : List permitted networks
 // List the permitted networks for a given DNS zone.
 func (permittedNetworksForDnsZones *PermittedNetworksForDnsZonesV1) ListPermittedNetworks(listPermittedNetworksOptions *ListPermittedNetworksOptions) (result *ListPermittedNetworks, response *core.DetailedResponse, err error) {
@@ -156,6 +162,7 @@ func (permittedNetworksForDnsZones *PermittedNetworksForDnsZonesV1) ListPermitte
 	var rawResponse map[string]json.RawMessage
 	response, err = permittedNetworksForDnsZones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListPermittedNetworks)
@@ -222,6 +229,7 @@ func (permittedNetworksForDnsZones *PermittedNetworksForDnsZonesV1) CreatePermit
 	var rawResponse map[string]json.RawMessage
 	response, err = permittedNetworksForDnsZones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPermittedNetwork)
@@ -275,6 +283,7 @@ func (permittedNetworksForDnsZones *PermittedNetworksForDnsZonesV1) DeletePermit
 	var rawResponse map[string]json.RawMessage
 	response, err = permittedNetworksForDnsZones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPermittedNetwork)
@@ -328,6 +337,7 @@ func (permittedNetworksForDnsZones *PermittedNetworksForDnsZonesV1) GetPermitted
 	var rawResponse map[string]json.RawMessage
 	response, err = permittedNetworksForDnsZones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPermittedNetwork)
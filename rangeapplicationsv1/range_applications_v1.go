@@ -179,6 +179,12 @@ func (rangeApplications *RangeApplicationsV1) DisableRetries() {
 	rangeApplications.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (rangeApplications *RangeApplicationsV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(rangeApplications.Service, policy)
+}
+
 // ListRangeApps : List range applications
 // Get a list of currently existing Range Applications inside a zone.
 func (rangeApplications *RangeApplicationsV1) ListRangeApps(listRangeAppsOptions *ListRangeAppsOptions) (result *RangeApplications, response *core.DetailedResponse, err error) {
@@ -236,6 +242,7 @@ func (rangeApplications *RangeApplicationsV1) ListRangeAppsWithContext(ctx conte
 	var rawResponse map[string]json.RawMessage
 	response, err = rangeApplications.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalRangeApplications)
@@ -332,6 +339,7 @@ func (rangeApplications *RangeApplicationsV1) CreateRangeAppWithContext(ctx cont
 	var rawResponse map[string]json.RawMessage
 	response, err = rangeApplications.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalRangeApplicationResp)
@@ -392,6 +400,7 @@ func (rangeApplications *RangeApplicationsV1) GetRangeAppWithContext(ctx context
 	var rawResponse map[string]json.RawMessage
 	response, err = rangeApplications.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalRangeApplicationResp)
@@ -489,6 +498,7 @@ func (rangeApplications *RangeApplicationsV1) UpdateRangeAppWithContext(ctx cont
 	var rawResponse map[string]json.RawMessage
 	response, err = rangeApplications.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalRangeApplicationResp)
@@ -549,6 +559,7 @@ func (rangeApplications *RangeApplicationsV1) DeleteRangeAppWithContext(ctx cont
 	var rawResponse map[string]json.RawMessage
 	response, err = rangeApplications.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalRangeApplicationResp)
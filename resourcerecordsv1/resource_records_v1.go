@@ -107,6 +107,12 @@ func (resourceRecords *ResourceRecordsV1) SetServiceURL(url string) error {
 	return resourceRecords.Service.SetServiceURL(url)
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (resourceRecords *ResourceRecordsV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(resourceRecords.Service, policy)
+}
+
 // ListResourceRecords : List Resource Records
 // List the Resource Records for a given DNS zone.
 func (resourceRecords *ResourceRecordsV1) ListResourceRecords(listResourceRecordsOptions *ListResourceRecordsOptions) (result *ListResourceRecords, response *core.DetailedResponse, err error) {
@@ -156,6 +162,7 @@ func (resourceRecords *ResourceRecordsV1) ListResourceRecords(listResourceRecord
 	var rawResponse map[string]json.RawMessage
 	response, err = resourceRecords.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListResourceRecords)
@@ -234,6 +241,7 @@ func (resourceRecords *ResourceRecordsV1) CreateResourceRecord(createResourceRec
 	var rawResponse map[string]json.RawMessage
 	response, err = resourceRecords.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResourceRecord)
@@ -284,6 +292,7 @@ func (resourceRecords *ResourceRecordsV1) DeleteResourceRecord(deleteResourceRec
 	}
 
 	response, err = resourceRecords.Service.Request(request, nil)
+	err = common.NewAPIError(err, response)
 
 	return
 }
@@ -330,6 +339,7 @@ func (resourceRecords *ResourceRecordsV1) GetResourceRecord(getResourceRecordOpt
 	var rawResponse map[string]json.RawMessage
 	response, err = resourceRecords.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResourceRecord)
@@ -405,6 +415,7 @@ func (resourceRecords *ResourceRecordsV1) UpdateResourceRecord(updateResourceRec
 	var rawResponse map[string]json.RawMessage
 	response, err = resourceRecords.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResourceRecord)
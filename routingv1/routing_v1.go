@@ -179,6 +179,12 @@ func (routing *RoutingV1) DisableRetries() {
 	routing.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (routing *RoutingV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(routing.Service, policy)
+}
+
 // GetSmartRouting : Get Routing feature smart routing setting
 // Get Routing feature smart routing setting for a zone.
 func (routing *RoutingV1) GetSmartRouting(getSmartRoutingOptions *GetSmartRoutingOptions) (result *SmartRoutingResp, response *core.DetailedResponse, err error) {
@@ -223,6 +229,7 @@ func (routing *RoutingV1) GetSmartRoutingWithContext(ctx context.Context, getSma
 	var rawResponse map[string]json.RawMessage
 	response, err = routing.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSmartRoutingResp)
@@ -288,6 +295,7 @@ func (routing *RoutingV1) UpdateSmartRoutingWithContext(ctx context.Context, upd
 	var rawResponse map[string]json.RawMessage
 	response, err = routing.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSmartRoutingResp)
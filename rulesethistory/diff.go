@@ -0,0 +1,274 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rulesethistory
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/networking-go-sdk/rulesetsv1"
+)
+
+// Constants associated with the RuleDiff.Change property.
+// A modified rule may also have moved, which RuleDiff.Moved reports; a moved rule only changed position.
+const (
+	RuleDiff_Change_Added    = "added"
+	RuleDiff_Change_Modified = "modified"
+	RuleDiff_Change_Moved    = "moved"
+	RuleDiff_Change_Removed  = "removed"
+)
+
+// The rule fields compared, as reported in RuleDiff.Fields.
+const (
+	Field_Action           = "action"
+	Field_ActionParameters = "action_parameters"
+	Field_Description      = "description"
+	Field_Enabled          = "enabled"
+	Field_Expression       = "expression"
+	Field_Logging          = "logging"
+	Field_Ratelimit        = "ratelimit"
+)
+
+// Diff : The differences between the rules of two versions of a ruleset.
+type Diff struct {
+	// The removed rules first, in their former order, then the other differences in the order of the new rules.
+	Rules []RuleDiff `json:"rules"`
+}
+
+// RuleDiff : The difference of a rule between two versions of a ruleset.
+type RuleDiff struct {
+	// The ref of the rule, which identifies it across versions; its ID when it has no ref.
+	Ref string `json:"ref"`
+
+	// The change, one of the RuleDiff_Change_* constants.
+	Change string `json:"change"`
+
+	// The rule before and after the change; nil when it was added or removed.
+	From *rulesetsv1.RuleDetails `json:"from,omitempty"`
+	To   *rulesetsv1.RuleDetails `json:"to,omitempty"`
+
+	// The 1-based positions of the rule before and after the change; 0 when it was added or removed.
+	FromPosition int `json:"from_position,omitempty"`
+	ToPosition   int `json:"to_position,omitempty"`
+
+	// Whether a modified rule moved relative to the rules kept.
+	Moved bool `json:"moved,omitempty"`
+
+	// The fields of a modified rule that changed, sorted.
+	Fields []string `json:"fields,omitempty"`
+}
+
+// Compare returns the differences between two lists of rules. Rules are matched by ref, or by ID when they have no
+// ref. A rule moved when its order relative to the other rules kept changed, so inserting or removing a rule doesn't
+// move the rules after it.
+func Compare(from []rulesetsv1.RuleDetails, to []rulesetsv1.RuleDetails) *Diff {
+	diff := &Diff{Rules: []RuleDiff{}}
+	fromPositions := positions(from)
+	toPositions := positions(to)
+
+	var fromKept, toKept []string
+	for i, rule := range from {
+		key := ruleKey(rule)
+		if matched(key, i, fromPositions, toPositions) {
+			fromKept = append(fromKept, key)
+			continue
+		}
+		diff.Rules = append(diff.Rules, RuleDiff{Ref: key, Change: RuleDiff_Change_Removed, From: &from[i], FromPosition: i + 1})
+	}
+	for i, rule := range to {
+		if key := ruleKey(rule); matched(key, i, toPositions, fromPositions) {
+			toKept = append(toKept, key)
+		}
+	}
+	stayed := longestCommonSubsequence(fromKept, toKept)
+
+	for i, rule := range to {
+		key := ruleKey(rule)
+		if !matched(key, i, toPositions, fromPositions) {
+			diff.Rules = append(diff.Rules, RuleDiff{Ref: key, Change: RuleDiff_Change_Added, To: &to[i], ToPosition: i + 1})
+			continue
+		}
+		j := fromPositions[key]
+		ruleDiff := RuleDiff{
+			Ref:          key,
+			From:         &from[j],
+			To:           &to[i],
+			FromPosition: j + 1,
+			ToPosition:   i + 1,
+			Moved:        !stayed[key],
+			Fields:       changedFields(from[j], to[i]),
+		}
+		switch {
+		case len(ruleDiff.Fields) > 0:
+			ruleDiff.Change = RuleDiff_Change_Modified
+		case ruleDiff.Moved:
+			ruleDiff.Change = RuleDiff_Change_Moved
+		default:
+			continue
+		}
+		diff.Rules = append(diff.Rules, ruleDiff)
+	}
+	return diff
+}
+
+// DesiredRules returns the rules to create as the rules of a ruleset version, to compare a version with them.
+func DesiredRules(rules []rulesetsv1.RuleCreate) []rulesetsv1.RuleDetails {
+	details := make([]rulesetsv1.RuleDetails, len(rules))
+	for i, rule := range rules {
+		details[i] = rulesetsv1.RuleDetails{
+			ID:               rule.ID,
+			Action:           rule.Action,
+			ActionParameters: rule.ActionParameters,
+			Ratelimit:        rule.Ratelimit,
+			Description:      rule.Description,
+			Enabled:          rule.Enabled,
+			Expression:       rule.Expression,
+			Logging:          rule.Logging,
+			Ref:              rule.Ref,
+		}
+	}
+	return details
+}
+
+// Empty returns whether the rules are the same.
+func (diff *Diff) Empty() bool {
+	return len(diff.Rules) == 0
+}
+
+// String returns the differences, one rule per line.
+func (diff *Diff) String() string {
+	var builder strings.Builder
+	for _, rule := range diff.Rules {
+		switch rule.Change {
+		case RuleDiff_Change_Added:
+			fmt.Fprintf(&builder, "+ %s at %d: %s when %s\n", rule.Ref, rule.ToPosition, stringValue(rule.To.Action), stringValue(rule.To.Expression))
+		case RuleDiff_Change_Removed:
+			fmt.Fprintf(&builder, "- %s at %d: %s when %s\n", rule.Ref, rule.FromPosition, stringValue(rule.From.Action), stringValue(rule.From.Expression))
+		case RuleDiff_Change_Moved:
+			fmt.Fprintf(&builder, "> %s moved from %d to %d\n", rule.Ref, rule.FromPosition, rule.ToPosition)
+		case RuleDiff_Change_Modified:
+			fmt.Fprintf(&builder, "~ %s at %d: %s changed", rule.Ref, rule.ToPosition, strings.Join(rule.Fields, ", "))
+			if rule.Moved {
+				fmt.Fprintf(&builder, ", moved from %d", rule.FromPosition)
+			}
+			builder.WriteString("\n")
+		}
+	}
+	return builder.String()
+}
+
+// ruleKey returns what identifies a rule across versions: its ref, which is its ID by default.
+func ruleKey(rule rulesetsv1.RuleDetails) string {
+	if ref := stringValue(rule.Ref); ref != "" {
+		return ref
+	}
+	return stringValue(rule.ID)
+}
+
+// positions returns the index of the first rule with each key. Rules without a key are never matched.
+func positions(rules []rulesetsv1.RuleDetails) map[string]int {
+	result := map[string]int{}
+	for i, rule := range rules {
+		if key := ruleKey(rule); key != "" {
+			if _, ok := result[key]; !ok {
+				result[key] = i
+			}
+		}
+	}
+	return result
+}
+
+// matched returns whether the rule with the given key at index i of a list, whose positions are "own", matches a rule
+// of the other list. Only the first rule with a key is matched.
+func matched(key string, i int, own map[string]int, other map[string]int) bool {
+	_, ok := other[key]
+	return ok && own[key] == i
+}
+
+// longestCommonSubsequence returns the keys of a longest common subsequence of a and b: the rules that kept their
+// relative order.
+func longestCommonSubsequence(a []string, b []string) map[string]bool {
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+	result := map[string]bool{}
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] == b[j]:
+			result[a[i]] = true
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return result
+}
+
+// changedFields returns the fields that differ between two versions of a rule. A rule without enabled is enabled.
+func changedFields(from rulesetsv1.RuleDetails, to rulesetsv1.RuleDetails) (fields []string) {
+	if stringValue(from.Action) != stringValue(to.Action) {
+		fields = append(fields, Field_Action)
+	}
+	if !sameJSON(from.ActionParameters, to.ActionParameters) {
+		fields = append(fields, Field_ActionParameters)
+	}
+	if stringValue(from.Description) != stringValue(to.Description) {
+		fields = append(fields, Field_Description)
+	}
+	if (from.Enabled == nil || *from.Enabled) != (to.Enabled == nil || *to.Enabled) {
+		fields = append(fields, Field_Enabled)
+	}
+	if stringValue(from.Expression) != stringValue(to.Expression) {
+		fields = append(fields, Field_Expression)
+	}
+	if !sameJSON(from.Logging, to.Logging) {
+		fields = append(fields, Field_Logging)
+	}
+	if !sameJSON(from.Ratelimit, to.Ratelimit) {
+		fields = append(fields, Field_Ratelimit)
+	}
+	return
+}
+
+// sameJSON returns whether two models have the same JSON encoding.
+func sameJSON(a interface{}, b interface{}) bool {
+	encodedA, errA := json.Marshal(a)
+	encodedB, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(encodedA, encodedB)
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
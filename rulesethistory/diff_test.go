@@ -0,0 +1,75 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rulesethistory
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/rulesetsv1"
+	"github.com/stretchr/testify/assert"
+)
+
+func rules(t *testing.T, body string) (result []rulesetsv1.RuleDetails) {
+	assert.Nil(t, json.Unmarshal([]byte(body), &result))
+	return
+}
+
+func TestCompare(t *testing.T) {
+	from := rules(t, `[
+		{"id": "a", "action": "block", "expression": "ip.src eq 192.0.2.1"},
+		{"id": "b", "action": "log", "expression": "true"},
+		{"id": "c", "action": "skip", "expression": "ssl", "action_parameters": {"ruleset": "current"}},
+		{"id": "d", "action": "challenge", "expression": "not ssl"},
+		{"id": "e", "action": "block", "expression": "ip.src eq 192.0.2.5", "enabled": true}
+	]`)
+	to := rules(t, `[
+		{"id": "x", "action": "block", "expression": "ip.src eq 192.0.2.9"},
+		{"id": "a", "action": "block", "expression": "ip.src eq 192.0.2.1"},
+		{"id": "d", "action": "challenge", "expression": "not ssl"},
+		{"id": "c", "action": "skip", "expression": "ssl", "action_parameters": {"ruleset": "current", "phases": ["http_ratelimit"]}},
+		{"id": "e", "action": "managed_challenge", "expression": "ip.src eq 192.0.2.5", "enabled": false}
+	]`)
+
+	diff := Compare(from, to)
+	assert.False(t, diff.Empty())
+	assert.Equal(t, `- b at 2: log when true
++ x at 1: block when ip.src eq 192.0.2.9
+~ c at 4: action_parameters changed, moved from 3
+~ e at 5: action, enabled changed
+`, diff.String())
+	assert.Equal(t, RuleDiff_Change_Modified, diff.Rules[2].Change)
+	assert.True(t, diff.Rules[2].Moved)
+	assert.Equal(t, []string{"http_ratelimit"}, diff.Rules[2].To.ActionParameters.Phases)
+
+	// Moving a rule up is reported as a move of that rule only.
+	diff = Compare(from, []rulesetsv1.RuleDetails{from[4], from[0], from[1], from[2], from[3]})
+	assert.Equal(t, "> e moved from 5 to 1\n", diff.String())
+
+	assert.True(t, Compare(from, from).Empty())
+
+	// Desired rules without IDs are matched by ref, and the ones without a ref either are added.
+	desired := DesiredRules([]rulesetsv1.RuleCreate{
+		{Ref: core.StringPtr("a"), Action: core.StringPtr("block"), Expression: core.StringPtr("ip.src eq 192.0.2.1")},
+		{Action: core.StringPtr("log"), Expression: core.StringPtr("true")},
+	})
+	diff = Compare(from[:1], desired)
+	assert.Len(t, diff.Rules, 1)
+	assert.Equal(t, RuleDiff_Change_Added, diff.Rules[0].Change)
+	assert.Equal(t, 2, diff.Rules[0].ToPosition)
+}
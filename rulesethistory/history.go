@@ -0,0 +1,223 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package rulesethistory compares and restores the versions of rulesets.
+//
+// Every update of a rulesetsv1 ruleset creates a version. Compare returns the
+// rule-level differences between two lists of rules: the rules added,
+// removed, moved and modified, with the fields that changed. A History reads
+// the versions of the rulesets of a zone or of an instance, compares two
+// versions or a version with a desired list of rules, and rolls an entrypoint
+// ruleset back by publishing the rules of an older version as its new
+// version.
+package rulesethistory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/rulesetsv1"
+)
+
+// History reads, compares and restores the versions of the rulesets of a zone or an instance.
+type History struct {
+	Service *rulesetsv1.RulesetsV1
+	Options *rulesetsv1.ScopeOptions
+}
+
+// NewHistory returns a History of the rulesets visible to "service". A nil "options" uses the defaults.
+func NewHistory(service *rulesetsv1.RulesetsV1, options *rulesetsv1.ScopeOptions) (history *History, err error) {
+	if service == nil {
+		err = core.SDKErrorf(nil, "the rulesets client is required", "missing-client", common.GetComponentInfo())
+		return
+	}
+	if options == nil {
+		options = rulesetsv1.NewScopeOptions()
+	}
+	err = options.Validate()
+	if err != nil {
+		return
+	}
+	history = &History{Service: service, Options: options}
+	return
+}
+
+// Versions : List the versions of a ruleset
+// List the versions of the ruleset with the given ID.
+func (history *History) Versions(rulesetID string) (result []rulesetsv1.ListedRuleset, err error) {
+	result, err = history.VersionsWithContext(context.Background(), rulesetID)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// VersionsWithContext is an alternate form of the Versions method which supports a Context parameter
+func (history *History) VersionsWithContext(ctx context.Context, rulesetID string) (result []rulesetsv1.ListedRuleset, err error) {
+	var versions *rulesetsv1.ListRulesetsResp
+	if history.Options.Scope == rulesetsv1.ScopeOptions_Scope_Instance {
+		versions, _, err = history.Service.GetInstanceRulesetVersionsWithContext(ctx, &rulesetsv1.GetInstanceRulesetVersionsOptions{
+			RulesetID: core.StringPtr(rulesetID),
+			Headers:   history.Options.Headers,
+		})
+	} else {
+		versions, _, err = history.Service.GetZoneRulesetVersionsWithContext(ctx, &rulesetsv1.GetZoneRulesetVersionsOptions{
+			RulesetID: core.StringPtr(rulesetID),
+			Headers:   history.Options.Headers,
+		})
+	}
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-versions-error")
+		return
+	}
+	result = versions.Result
+	return
+}
+
+// Version : Get a version of a ruleset
+// Get the given version of the ruleset with the given ID, or its current version when "version" is empty.
+func (history *History) Version(rulesetID string, version string) (result *rulesetsv1.RulesetDetails, err error) {
+	result, err = history.VersionWithContext(context.Background(), rulesetID, version)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// VersionWithContext is an alternate form of the Version method which supports a Context parameter
+func (history *History) VersionWithContext(ctx context.Context, rulesetID string, version string) (result *rulesetsv1.RulesetDetails, err error) {
+	var ruleset *rulesetsv1.RulesetResp
+	switch {
+	case history.Options.Scope == rulesetsv1.ScopeOptions_Scope_Instance && version == "":
+		ruleset, _, err = history.Service.GetInstanceRulesetWithContext(ctx, &rulesetsv1.GetInstanceRulesetOptions{
+			RulesetID: core.StringPtr(rulesetID),
+			Headers:   history.Options.Headers,
+		})
+	case history.Options.Scope == rulesetsv1.ScopeOptions_Scope_Instance:
+		ruleset, _, err = history.Service.GetInstanceRulesetVersionWithContext(ctx, &rulesetsv1.GetInstanceRulesetVersionOptions{
+			RulesetID:      core.StringPtr(rulesetID),
+			RulesetVersion: core.StringPtr(version),
+			Headers:        history.Options.Headers,
+		})
+	case version == "":
+		ruleset, _, err = history.Service.GetZoneRulesetWithContext(ctx, &rulesetsv1.GetZoneRulesetOptions{
+			RulesetID: core.StringPtr(rulesetID),
+			Headers:   history.Options.Headers,
+		})
+	default:
+		ruleset, _, err = history.Service.GetZoneRulesetVersionWithContext(ctx, &rulesetsv1.GetZoneRulesetVersionOptions{
+			RulesetID:      core.StringPtr(rulesetID),
+			RulesetVersion: core.StringPtr(version),
+			Headers:        history.Options.Headers,
+		})
+	}
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-version-error")
+		return
+	}
+	if ruleset.Result == nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("version %q of ruleset %s has no details", version, rulesetID), "missing-version", common.GetComponentInfo())
+		return
+	}
+	result = ruleset.Result
+	return
+}
+
+// DiffVersions : Compare two versions of a ruleset
+// Compare the rules of two versions of the ruleset with the given ID. An empty version is the current version.
+func (history *History) DiffVersions(rulesetID string, fromVersion string, toVersion string) (result *Diff, err error) {
+	result, err = history.DiffVersionsWithContext(context.Background(), rulesetID, fromVersion, toVersion)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DiffVersionsWithContext is an alternate form of the DiffVersions method which supports a Context parameter
+func (history *History) DiffVersionsWithContext(ctx context.Context, rulesetID string, fromVersion string, toVersion string) (result *Diff, err error) {
+	from, err := history.VersionWithContext(ctx, rulesetID, fromVersion)
+	if err != nil {
+		return
+	}
+	to, err := history.VersionWithContext(ctx, rulesetID, toVersion)
+	if err != nil {
+		return
+	}
+	result = Compare(from.Rules, to.Rules)
+	return
+}
+
+// DiffDesired : Compare a version of a ruleset with the desired rules
+// Compare the rules of a version of the ruleset with the given ID, the current version when "version" is empty, with
+// the rules that would replace them, such as the rules of an UpdateZoneEntrypointRulesetOptions.
+func (history *History) DiffDesired(rulesetID string, version string, desired []rulesetsv1.RuleCreate) (result *Diff, err error) {
+	result, err = history.DiffDesiredWithContext(context.Background(), rulesetID, version, desired)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DiffDesiredWithContext is an alternate form of the DiffDesired method which supports a Context parameter
+func (history *History) DiffDesiredWithContext(ctx context.Context, rulesetID string, version string, desired []rulesetsv1.RuleCreate) (result *Diff, err error) {
+	from, err := history.VersionWithContext(ctx, rulesetID, version)
+	if err != nil {
+		return
+	}
+	result = Compare(from.Rules, DesiredRules(desired))
+	return
+}
+
+// Rollback : Restore a version of an entrypoint ruleset
+// Publish the rules of an older version of the entrypoint ruleset with the given ID as the new version of the
+// entrypoint ruleset of its phase. The rules keep their refs, so later versions can still be compared with the older
+// ones; the versions in between are kept.
+func (history *History) Rollback(rulesetID string, version string) (result *rulesetsv1.RulesetResp, err error) {
+	result, err = history.RollbackWithContext(context.Background(), rulesetID, version)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// RollbackWithContext is an alternate form of the Rollback method which supports a Context parameter
+func (history *History) RollbackWithContext(ctx context.Context, rulesetID string, version string) (result *rulesetsv1.RulesetResp, err error) {
+	if version == "" {
+		err = core.SDKErrorf(nil, "the version to roll back to is required", "missing-version", common.GetComponentInfo())
+		return
+	}
+	older, err := history.VersionWithContext(ctx, rulesetID, version)
+	if err != nil {
+		return
+	}
+	entrypointKind := rulesetsv1.UpdateZoneEntrypointRulesetOptions_Kind_Zone
+	if history.Options.Scope == rulesetsv1.ScopeOptions_Scope_Instance {
+		entrypointKind = rulesetsv1.UpdateInstanceEntrypointRulesetOptions_Kind_Root
+	}
+	if stringValue(older.Kind) != entrypointKind || stringValue(older.Phase) == "" {
+		err = core.SDKErrorf(nil, fmt.Sprintf("ruleset %s is a %s ruleset, not an entrypoint ruleset", rulesetID, stringValue(older.Kind)),
+			"not-entrypoint", common.GetComponentInfo())
+		return
+	}
+
+	result, err = history.Service.UpdateScopedEntrypointRulesWithContext(ctx, history.Options, stringValue(older.Phase), older.Description,
+		RestoredRules(older.Rules))
+	return
+}
+
+// RestoredRules returns the rules to create to publish the rules of a version again. The rules get new IDs, since the
+// rules of the version may have been deleted since, and keep their refs, which default to their former IDs.
+func RestoredRules(rules []rulesetsv1.RuleDetails) []rulesetsv1.RuleCreate {
+	restored := make([]rulesetsv1.RuleCreate, len(rules))
+	for i, rule := range rules {
+		restored[i] = rule.AsRuleCreate()
+		restored[i].ID = nil
+		restored[i].Ref = core.StringPtr(ruleKey(rule))
+	}
+	return restored
+}
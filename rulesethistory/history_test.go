@@ -0,0 +1,130 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rulesethistory
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/internal/testserver"
+	"github.com/IBM/networking-go-sdk/rulesetsv1"
+	"github.com/stretchr/testify/assert"
+)
+
+func rulesetBody(kind string, version string, rules string) string {
+	return fmt.Sprintf(`{"success": true, "errors": [], "messages": [], "result": {
+		"id": "r1", "name": "entrypoint", "description": "Custom rules", "kind": %q, "phase": "http_request_firewall_custom",
+		"version": %q, "last_updated": "x", "rules": %s}}`, kind, version, rules)
+}
+
+const version1Rules = `[
+	{"id": "a", "action": "block", "expression": "ip.src eq 192.0.2.1", "ratelimit": {"characteristics": ["ip.src"], "period": 60}},
+	{"id": "b", "ref": "office", "action": "skip", "expression": "ip.src in $office", "action_parameters": {"ruleset": "current"}}
+]`
+
+const version2Rules = `[
+	{"id": "b2", "ref": "office", "action": "skip", "expression": "ip.src in $office", "action_parameters": {"ruleset": "current"}},
+	{"id": "c", "action": "log", "expression": "true"}
+]`
+
+// historyRoutes serve two versions of ruleset r1 of the given kind, with version 2 current, and the update of the
+// entrypoint ruleset of the http_request_firewall_custom phase.
+func historyRoutes(kind string) []testserver.Route {
+	return []testserver.Route{
+		{Path: "/rulesets/r1/versions", Body: `{"success": true, "errors": [], "messages": [], "result": [
+			{"id": "r1", "name": "entrypoint", "description": "", "kind": "zone", "phase": "http_request_firewall_custom", "version": "1", "last_updated": "x"},
+			{"id": "r1", "name": "entrypoint", "description": "", "kind": "zone", "phase": "http_request_firewall_custom", "version": "2", "last_updated": "x"}
+		]}`},
+		{Path: "/rulesets/r1/versions/1", Body: rulesetBody(kind, "1", version1Rules)},
+		{Path: "/rulesets/r1/versions/2", Body: rulesetBody(kind, "2", version2Rules)},
+		{Path: "/rulesets/r1", Body: rulesetBody(kind, "2", version2Rules)},
+		{Method: http.MethodPut, Path: "/rulesets/phases/http_request_firewall_custom/entrypoint", Body: rulesetBody(kind, "3", version1Rules)},
+	}
+}
+
+func newTestHistory(t *testing.T, url string, scope string) *History {
+	service, err := rulesetsv1.NewRulesetsV1(&rulesetsv1.RulesetsV1Options{
+		URL: url, Authenticator: &core.NoAuthAuthenticator{}, Crn: core.StringPtr("crn1"), ZoneIdentifier: core.StringPtr("zone1"),
+	})
+	assert.Nil(t, err)
+	history, err := NewHistory(service, rulesetsv1.NewScopeOptions().SetScope(scope))
+	assert.Nil(t, err)
+	return history
+}
+
+func TestDiffVersions(t *testing.T) {
+	server := testserver.New(t, "/v1/crn1/zones/zone1", historyRoutes("zone")...)
+	history := newTestHistory(t, server.URL, rulesetsv1.ScopeOptions_Scope_Zone)
+
+	versions, err := history.Versions("r1")
+	assert.Nil(t, err)
+	assert.Len(t, versions, 2)
+
+	diff, err := history.DiffVersions("r1", "1", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "- a at 1: block when ip.src eq 192.0.2.1\n+ c at 2: log when true\n", diff.String())
+
+	diff, err = history.DiffDesired("r1", "2", []rulesetsv1.RuleCreate{
+		{Ref: core.StringPtr("office"), Action: core.StringPtr("skip"), Expression: core.StringPtr("ip.src in $office"),
+			ActionParameters: &rulesetsv1.ActionParameters{Ruleset: core.StringPtr("current")}},
+		{ID: core.StringPtr("c"), Action: core.StringPtr("log"), Expression: core.StringPtr("true"), Enabled: core.BoolPtr(false)},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "~ c at 2: enabled changed\n", diff.String())
+}
+
+func TestRollback(t *testing.T) {
+	for _, scope := range []struct {
+		name   string
+		kind   string
+		prefix string
+	}{
+		{rulesetsv1.ScopeOptions_Scope_Zone, "zone", "/v1/crn1/zones/zone1"},
+		{rulesetsv1.ScopeOptions_Scope_Instance, "root", "/v1/crn1"},
+	} {
+		server := testserver.New(t, scope.prefix, historyRoutes(scope.kind)...)
+		history := newTestHistory(t, server.URL, scope.name)
+
+		result, err := history.Rollback("r1", "1")
+		assert.Nil(t, err, scope.name)
+		assert.Equal(t, "3", *result.Result.Version)
+
+		var body struct {
+			Description string                  `json:"description"`
+			Rules       []rulesetsv1.RuleCreate `json:"rules"`
+		}
+		assert.Nil(t, json.Unmarshal([]byte(server.Body(scope.prefix+"/rulesets/phases/http_request_firewall_custom/entrypoint")), &body), scope.name)
+		assert.Equal(t, "Custom rules", body.Description)
+		if assert.Len(t, body.Rules, 2, scope.name) {
+			assert.Nil(t, body.Rules[0].ID)
+			assert.Equal(t, "a", *body.Rules[0].Ref)
+			assert.Equal(t, int64(60), *body.Rules[0].Ratelimit.Period)
+			assert.Equal(t, "office", *body.Rules[1].Ref)
+		}
+	}
+
+	// Only entrypoint rulesets can be rolled back.
+	server := testserver.New(t, "/v1/crn1/zones/zone1", historyRoutes("custom")...)
+	_, err := newTestHistory(t, server.URL, rulesetsv1.ScopeOptions_Scope_Zone).Rollback("r1", "1")
+	assert.Equal(t, "ruleset r1 is a custom ruleset, not an entrypoint ruleset", err.Error())
+
+	_, err = NewHistory(nil, nil)
+	assert.NotNil(t, err)
+}
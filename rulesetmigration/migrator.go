@@ -0,0 +1,274 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package rulesetmigration moves the firewall rules and page rules of a zone
+// to the entrypoint rulesets of its phases.
+//
+// Translate turns firewallrulesv1 rules, with their filtersv1 filters, into
+// rules of the http_request_firewall_custom phase, and pageruleapiv1 rules
+// into rules of the http_request_dynamic_redirect and
+// http_request_cache_settings phases, keeping the order in which they run and
+// disabling the paused ones. The constructs rulesets can't express are
+// reported instead of being dropped silently. A Migrator reads the rules of a
+// zone, translates them and publishes the result with
+// UpdateZoneEntrypointRuleset, keeping the rules already in the entrypoint
+// rulesets; in dry-run mode it returns the updates without making them.
+package rulesetmigration
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/filtersv1"
+	"github.com/IBM/networking-go-sdk/firewallrulesv1"
+	"github.com/IBM/networking-go-sdk/pageruleapiv1"
+	"github.com/IBM/networking-go-sdk/rulesetsv1"
+)
+
+// Migrator migrates the firewall rules and page rules of the zone of a rulesets client.
+type Migrator struct {
+	Service *rulesetsv1.RulesetsV1
+	Options *MigratorOptions
+}
+
+// MigratorOptions : The options of a Migrator.
+type MigratorOptions struct {
+	// The client the firewall rules are read with. By default no firewall rule is migrated.
+	FirewallRules *firewallrulesv1.FirewallRulesV1
+
+	// The client the filters of firewall rules listed without their expression are read with.
+	Filters *filtersv1.FiltersV1
+
+	// The client the page rules are read with. It must be configured for the zone of the rulesets client. By default no
+	// page rule is migrated.
+	PageRules *pageruleapiv1.PageRuleApiV1
+
+	// The IBM Cloud user IAM token that the firewall rules and filters APIs require.
+	XAuthUserToken *string
+
+	// Whether Apply returns the updates of the entrypoint rulesets without making them.
+	DryRun bool
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewMigratorOptions : Instantiate MigratorOptions
+func NewMigratorOptions() *MigratorOptions {
+	return &MigratorOptions{}
+}
+
+// SetFirewallRules : Allow user to set FirewallRules
+func (_options *MigratorOptions) SetFirewallRules(firewallRules *firewallrulesv1.FirewallRulesV1) *MigratorOptions {
+	_options.FirewallRules = firewallRules
+	return _options
+}
+
+// SetFilters : Allow user to set Filters
+func (_options *MigratorOptions) SetFilters(filters *filtersv1.FiltersV1) *MigratorOptions {
+	_options.Filters = filters
+	return _options
+}
+
+// SetPageRules : Allow user to set PageRules
+func (_options *MigratorOptions) SetPageRules(pageRules *pageruleapiv1.PageRuleApiV1) *MigratorOptions {
+	_options.PageRules = pageRules
+	return _options
+}
+
+// SetXAuthUserToken : Allow user to set XAuthUserToken
+func (_options *MigratorOptions) SetXAuthUserToken(xAuthUserToken string) *MigratorOptions {
+	_options.XAuthUserToken = core.StringPtr(xAuthUserToken)
+	return _options
+}
+
+// SetDryRun : Allow user to set DryRun
+func (_options *MigratorOptions) SetDryRun(dryRun bool) *MigratorOptions {
+	_options.DryRun = dryRun
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (_options *MigratorOptions) SetHeaders(param map[string]string) *MigratorOptions {
+	_options.Headers = param
+	return _options
+}
+
+// NewMigrator returns a Migrator publishing to the entrypoint rulesets of the zone of "service". A nil "options" uses
+// the defaults.
+func NewMigrator(service *rulesetsv1.RulesetsV1, options *MigratorOptions) (migrator *Migrator, err error) {
+	if service == nil {
+		err = core.SDKErrorf(nil, "the rulesets client is required", "missing-client", common.GetComponentInfo())
+		return
+	}
+	if options == nil {
+		options = NewMigratorOptions()
+	}
+	migrator = &Migrator{Service: service, Options: options}
+	return
+}
+
+// Convert : Translate the rules of the zone
+// List the firewall rules, with their filters, and the page rules of the zone, and translate them into the rules of
+// the entrypoint rulesets.
+func (migrator *Migrator) Convert() (result *Migration, err error) {
+	result, err = migrator.ConvertWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ConvertWithContext is an alternate form of the Convert method which supports a Context parameter
+func (migrator *Migrator) ConvertWithContext(ctx context.Context) (result *Migration, err error) {
+	var firewallRules []firewallrulesv1.FirewallRuleObject
+	if migrator.Options.FirewallRules != nil {
+		firewallRules, err = migrator.listFirewallRules(ctx)
+		if err != nil {
+			return
+		}
+	}
+
+	var pageRules []pageruleapiv1.PageRuleResult
+	if migrator.Options.PageRules != nil {
+		var list *pageruleapiv1.PageRulesResponseListAll
+		list, _, err = migrator.Options.PageRules.ListPageRulesWithContext(ctx, &pageruleapiv1.ListPageRulesOptions{Headers: migrator.Options.Headers})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "list-page-rules-error")
+			return
+		}
+		pageRules = list.Result
+	}
+
+	result = Translate(firewallRules, pageRules)
+	return
+}
+
+// listFirewallRules lists the firewall rules of the zone and, with a filters client configured, completes the ones
+// listed without the expression of their filter.
+func (migrator *Migrator) listFirewallRules(ctx context.Context) (firewallRules []firewallrulesv1.FirewallRuleObject, err error) {
+	list, _, err := migrator.Options.FirewallRules.ListAllFirewallRulesWithContext(ctx, &firewallrulesv1.ListAllFirewallRulesOptions{
+		XAuthUserToken: migrator.Options.XAuthUserToken,
+		Crn:            migrator.Service.Crn,
+		ZoneIdentifier: migrator.Service.ZoneIdentifier,
+		Headers:        migrator.Options.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-firewall-rules-error")
+		return
+	}
+	firewallRules = list.Result
+
+	incomplete := false
+	for _, firewallRule := range firewallRules {
+		incomplete = incomplete || (firewallRule.Filter != nil && stringValue(firewallRule.Filter.Expression) == "")
+	}
+	if !incomplete || migrator.Options.Filters == nil {
+		return
+	}
+	filters, _, err := migrator.Options.Filters.ListAllFiltersWithContext(ctx, &filtersv1.ListAllFiltersOptions{
+		XAuthUserToken: migrator.Options.XAuthUserToken,
+		Crn:            migrator.Service.Crn,
+		ZoneIdentifier: migrator.Service.ZoneIdentifier,
+		Headers:        migrator.Options.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-filters-error")
+		return
+	}
+	byID := map[string]filtersv1.FilterObject{}
+	for _, filter := range filters.Result {
+		byID[stringValue(filter.ID)] = filter
+	}
+	for i := range firewallRules {
+		ruleFilter := firewallRules[i].Filter
+		if ruleFilter == nil || stringValue(ruleFilter.Expression) != "" {
+			continue
+		}
+		if filter, ok := byID[stringValue(ruleFilter.ID)]; ok {
+			firewallRules[i].Filter = &firewallrulesv1.FirewallRuleObjectFilter{
+				ID:          filter.ID,
+				Paused:      filter.Paused,
+				Description: filter.Description,
+				Expression:  filter.Expression,
+			}
+		}
+	}
+	return
+}
+
+// Change : The update of the entrypoint ruleset of a phase.
+type Change struct {
+	Phase string `json:"phase"`
+
+	// The rules already in the entrypoint ruleset, then the migrated rules.
+	Options *rulesetsv1.UpdateZoneEntrypointRulesetOptions `json:"options"`
+
+	// The updated entrypoint ruleset; nil in dry-run mode.
+	Result *rulesetsv1.RulesetResp `json:"result,omitempty"`
+}
+
+// Apply : Publish a migration
+// Add the rules of a migration to the entrypoint rulesets of their phases, after the rules already there. Rules added
+// by an earlier migration of the same firewall rule or page rule are replaced, so a migration can be applied again.
+// In dry-run mode the changes are returned without updating the rulesets.
+func (migrator *Migrator) Apply(migration *Migration) (result []*Change, err error) {
+	result, err = migrator.ApplyWithContext(context.Background(), migration)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ApplyWithContext is an alternate form of the Apply method which supports a Context parameter
+func (migrator *Migrator) ApplyWithContext(ctx context.Context, migration *Migration) (result []*Change, err error) {
+	err = core.ValidateNotNil(migration, "migration cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	for _, phase := range migration.PhaseNames() {
+		migrated := migration.Phases[phase]
+		refs := map[string]bool{}
+		for _, rule := range migrated {
+			refs[stringValue(rule.Ref)] = true
+		}
+
+		options := &rulesetsv1.UpdateZoneEntrypointRulesetOptions{
+			RulesetPhase: core.StringPtr(phase),
+			Headers:      migrator.Options.Headers,
+		}
+		var existing []rulesetsv1.RuleCreate
+		existing, err = migrator.Service.GetScopedEntrypointRulesWithContext(ctx, rulesetsv1.NewScopeOptions().SetHeaders(migrator.Options.Headers), phase)
+		if err != nil {
+			return
+		}
+		for _, rule := range existing {
+			if !refs[stringValue(rule.Ref)] {
+				options.Rules = append(options.Rules, rule)
+			}
+		}
+		options.Rules = append(options.Rules, migrated...)
+
+		change := &Change{Phase: phase, Options: options}
+		if !migrator.Options.DryRun {
+			change.Result, _, err = migrator.Service.UpdateZoneEntrypointRulesetWithContext(ctx, options)
+			if err != nil {
+				err = core.RepurposeSDKProblem(err, "update-entrypoint-ruleset-error")
+				return
+			}
+		}
+		result = append(result, change)
+	}
+	return
+}
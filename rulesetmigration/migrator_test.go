@@ -0,0 +1,208 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rulesetmigration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/filtersv1"
+	"github.com/IBM/networking-go-sdk/firewallrulesv1"
+	"github.com/IBM/networking-go-sdk/internal/testserver"
+	"github.com/IBM/networking-go-sdk/pageruleapiv1"
+	"github.com/IBM/networking-go-sdk/rulesetsv1"
+	"github.com/stretchr/testify/assert"
+)
+
+const firewallRulesBody = `{"success": true, "errors": [], "messages": [], "result": [
+	{"id": "block-admin", "paused": false, "action": "block", "priority": 2,
+	 "filter": {"id": "f1", "paused": false, "description": "", "expression": "http.request.uri.path contains \"/admin\""}},
+	{"id": "allow-office", "paused": false, "action": "allow", "description": "Office",
+	 "filter": {"id": "f2", "paused": false, "description": "", "expression": ""}},
+	{"id": "log-curl", "paused": true, "action": "log",
+	 "filter": {"id": "f3", "paused": false, "description": "", "expression": "http.user_agent contains \"curl\""}},
+	{"id": "bypass-waf", "paused": false, "action": "bypass", "products": ["waf"],
+	 "filter": {"id": "f4", "paused": false, "description": "", "expression": "ip.src eq 192.0.2.1"}},
+	{"id": "typo", "paused": false, "action": "block",
+	 "filter": {"id": "f5", "paused": false, "description": "", "expression": "http.hots eq \"a\""}}
+]}`
+
+const filtersBody = `{"success": true, "errors": [], "messages": [], "result": [
+	{"id": "f2", "paused": false, "description": "", "expression": "ip.src in $office_networks", "created_on": "2026-01-01T00:00:00Z", "modified_on": "2026-01-01T00:00:00Z"}
+]}`
+
+const pageRulesBody = `{"success": true, "errors": [], "messages": [], "result": [
+	{"id": "p-docs", "priority": 1, "status": "active", "created_on": "x", "modified_on": "x",
+	 "targets": [{"target": "url", "constraint": {"operator": "matches", "value": "example.com/docs/*"}}],
+	 "actions": [{"id": "forwarding_url", "value": {"url": "https://docs.example.com/$1", "status_code": 302}}]},
+	{"id": "p-static", "priority": 2, "status": "disabled", "created_on": "x", "modified_on": "x",
+	 "targets": [{"target": "url", "constraint": {"operator": "matches", "value": "https://example.com/static/*"}}],
+	 "actions": [
+		{"id": "cache_level", "value": "cache_everything"},
+		{"id": "edge_cache_ttl", "value": 7200},
+		{"id": "browser_cache_ttl", "value": 0},
+		{"id": "bypass_cache_on_cookie", "value": "session=.*"},
+		{"id": "security_level", "value": "high"}
+	 ]},
+	{"id": "p-https", "priority": 3, "status": "active", "created_on": "x", "modified_on": "x",
+	 "targets": [{"target": "url", "constraint": {"operator": "matches", "value": "*example.com"}}],
+	 "actions": [{"id": "always_use_https"}, {"id": "browser_cache_ttl", "value": 600}]}
+]}`
+
+const firewallCustomEntrypointBody = `{"success": true, "errors": [], "messages": [], "result": {
+	"id": "r1", "name": "zone", "description": "", "kind": "zone", "phase": "http_request_firewall_custom",
+	"version": "3", "last_updated": "x", "rules": [
+		{"id": "keep", "action": "block", "expression": "ip.src eq 198.51.100.1", "ref": "keep"},
+		{"id": "old", "action": "block", "expression": "http.request.uri.path contains \"/adm\"", "ref": "migrated_firewall_rule_block-admin"}
+	]}}`
+
+// migrationRoutes serve the firewall rules, filters and page rules of zone1, and the entrypoint ruleset of its
+// http_request_firewall_custom phase; the other phases have none.
+var migrationRoutes = []testserver.Route{
+	{Path: "/v1/crn1/zones/zone1/firewall/rules", Body: firewallRulesBody},
+	{Path: "/v1/crn1/zones/zone1/filters", Body: filtersBody},
+	{Path: "/v1/crn1/zones/zone1/pagerules", Body: pageRulesBody},
+	{Method: http.MethodGet, Path: "/v1/crn1/zones/zone1/rulesets/phases/http_request_firewall_custom/entrypoint", Body: firewallCustomEntrypointBody},
+	{Method: http.MethodGet, StatusCode: 404,
+		Body: `{"success": false, "errors": [{"code": 10000, "message": "not found"}], "messages": [], "result": null}`},
+	{Method: http.MethodPut, Body: `{"success": true, "errors": [], "messages": [], "result": {
+		"id": "r1", "name": "zone", "description": "", "kind": "zone", "phase": "x", "version": "4", "last_updated": "x", "rules": []}}`},
+}
+
+// updatedRefs returns the refs of the rules of the last update of the entrypoint ruleset of a phase.
+func updatedRefs(t *testing.T, server *testserver.Server, phase string) (result []string) {
+	data := server.Body("/v1/crn1/zones/zone1/rulesets/phases/" + phase + "/entrypoint")
+	if data == "" {
+		return
+	}
+	var body struct {
+		Rules []rulesetsv1.RuleCreate `json:"rules"`
+	}
+	assert.Nil(t, json.Unmarshal([]byte(data), &body))
+	return refs(body.Rules)
+}
+
+func newTestMigrator(t *testing.T, url string, dryRun bool) *Migrator {
+	rulesets, err := rulesetsv1.NewRulesetsV1(&rulesetsv1.RulesetsV1Options{
+		URL: url, Authenticator: &core.NoAuthAuthenticator{}, Crn: core.StringPtr("crn1"), ZoneIdentifier: core.StringPtr("zone1"),
+	})
+	assert.Nil(t, err)
+	firewallRules, err := firewallrulesv1.NewFirewallRulesV1(&firewallrulesv1.FirewallRulesV1Options{URL: url, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	filters, err := filtersv1.NewFiltersV1(&filtersv1.FiltersV1Options{URL: url, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	pageRules, err := pageruleapiv1.NewPageRuleApiV1(&pageruleapiv1.PageRuleApiV1Options{
+		URL: url, Authenticator: &core.NoAuthAuthenticator{}, Crn: core.StringPtr("crn1"), ZoneID: core.StringPtr("zone1"),
+	})
+	assert.Nil(t, err)
+
+	options := NewMigratorOptions().SetFirewallRules(firewallRules).SetFilters(filters).SetPageRules(pageRules).
+		SetXAuthUserToken("token").SetDryRun(dryRun)
+	migrator, err := NewMigrator(rulesets, options)
+	assert.Nil(t, err)
+	return migrator
+}
+
+func refs(rules []rulesetsv1.RuleCreate) (result []string) {
+	for _, rule := range rules {
+		result = append(result, stringValue(rule.Ref))
+	}
+	return
+}
+
+func TestConvert(t *testing.T) {
+	server := testserver.New(t, "", migrationRoutes...)
+
+	migration, err := newTestMigrator(t, server.URL, true).Convert()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{PhaseCacheSettings, PhaseDynamicRedirect, PhaseFirewallCustom}, migration.PhaseNames())
+
+	// Firewall rules keep their order, the paused ones are disabled and allow becomes a skip.
+	custom := migration.Phases[PhaseFirewallCustom]
+	assert.Equal(t, []string{"migrated_firewall_rule_block-admin", "migrated_firewall_rule_log-curl", "migrated_firewall_rule_allow-office"}, refs(custom))
+	assert.Equal(t, "block", *custom[0].Action)
+	assert.False(t, *custom[1].Enabled)
+	assert.Equal(t, "skip", *custom[2].Action)
+	assert.Equal(t, "ip.src in $office_networks", *custom[2].Expression)
+	assert.Equal(t, "current", *custom[2].ActionParameters.Ruleset)
+	assert.Equal(t, "Office", *custom[2].Description)
+
+	// Page rules with a higher priority come first, and the others leave out the URLs they match.
+	redirects := migration.Phases[PhaseDynamicRedirect]
+	assert.Equal(t, []string{"migrated_page_rule_p-https", "migrated_page_rule_p-docs"}, refs(redirects))
+	assert.Equal(t, `http.request.full_uri wildcard "http*://*example.com/" and not ssl`, *redirects[0].Expression)
+	assert.Equal(t, `http.request.full_uri wildcard "http*://example.com/docs/*" and not http.request.full_uri wildcard "http*://*example.com/"`,
+		*redirects[1].Expression)
+	assert.Equal(t, `wildcard_replace(http.request.full_uri, "http*://example.com/docs/*", "https://docs.example.com/${2}")`,
+		*redirects[1].ActionParameters.FromValue.TargetURL.Expression)
+	assert.Equal(t, int64(302), *redirects[1].ActionParameters.FromValue.StatusCode)
+
+	cache := migration.Phases[PhaseCacheSettings]
+	assert.Equal(t, []string{"migrated_page_rule_p-https", "migrated_page_rule_p-static", "migrated_page_rule_p-static_bypass_cache_on_cookie"}, refs(cache))
+	assert.Equal(t, int64(600), *cache[0].ActionParameters.BrowserTTL.Default)
+	assert.False(t, *cache[1].Enabled)
+	assert.True(t, *cache[1].ActionParameters.Cache)
+	assert.Equal(t, int64(7200), *cache[1].ActionParameters.EdgeTTL.Default)
+	assert.Equal(t, "respect_origin", *cache[1].ActionParameters.BrowserTTL.Mode)
+	assert.Equal(t, `http.request.full_uri wildcard "https://example.com/static/*" and not http.request.full_uri wildcard "http*://*example.com/" and http.cookie matches "session=.*"`,
+		*cache[2].Expression)
+	assert.False(t, *cache[2].ActionParameters.Cache)
+
+	var untranslated []string
+	for _, issue := range migration.Untranslated {
+		untranslated = append(untranslated, issue.Source+" "+issue.ID+" "+issue.Construct)
+	}
+	assert.Equal(t, []string{"firewall_rule typo ", "firewall_rule bypass-waf bypass", "page_rule p-static security_level"}, untranslated)
+	assert.Len(t, migration.Warnings, 2)
+}
+
+func TestApply(t *testing.T) {
+	server := testserver.New(t, "", migrationRoutes...)
+
+	migrator := newTestMigrator(t, server.URL, true)
+	migration, err := migrator.Convert()
+	assert.Nil(t, err)
+
+	// In dry-run mode nothing is updated. The existing rules are kept, except the one an earlier migration added.
+	changes, err := migrator.Apply(migration)
+	assert.Nil(t, err)
+	assert.Len(t, changes, 3)
+	for _, phase := range migration.PhaseNames() {
+		assert.Empty(t, updatedRefs(t, server, phase), phase)
+	}
+	assert.Equal(t, PhaseFirewallCustom, changes[2].Phase)
+	assert.Equal(t, []string{"keep", "migrated_firewall_rule_block-admin", "migrated_firewall_rule_log-curl", "migrated_firewall_rule_allow-office"},
+		refs(changes[2].Options.Rules))
+	assert.Equal(t, "keep", *changes[2].Options.Rules[0].ID)
+	assert.Nil(t, changes[2].Result)
+
+	migrator.Options.SetDryRun(false)
+	changes, err = migrator.Apply(migration)
+	assert.Nil(t, err)
+	assert.Equal(t, "4", *changes[0].Result.Result.Version)
+	assert.Equal(t, refs(changes[2].Options.Rules), updatedRefs(t, server, PhaseFirewallCustom))
+	assert.Equal(t, refs(migration.Phases[PhaseCacheSettings]), updatedRefs(t, server, PhaseCacheSettings))
+
+	_, err = migrator.Apply(nil)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "migration cannot be nil")
+
+	_, err = NewMigrator(nil, nil)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,486 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rulesetmigration
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/firewallexpression"
+	"github.com/IBM/networking-go-sdk/firewallrulesv1"
+	"github.com/IBM/networking-go-sdk/pageruleapiv1"
+	"github.com/IBM/networking-go-sdk/rulesetsv1"
+)
+
+// The phases the rules are migrated to.
+const (
+	PhaseCacheSettings   = rulesetsv1.UpdateZoneEntrypointRulesetOptions_RulesetPhase_HttpRequestCacheSettings
+	PhaseDynamicRedirect = rulesetsv1.UpdateZoneEntrypointRulesetOptions_RulesetPhase_HttpRequestDynamicRedirect
+	PhaseFirewallCustom  = rulesetsv1.UpdateZoneEntrypointRulesetOptions_RulesetPhase_HttpRequestFirewallCustom
+)
+
+// Constants associated with the Issue.Source property.
+const (
+	Issue_Source_FirewallRule = "firewall_rule"
+	Issue_Source_PageRule     = "page_rule"
+)
+
+// Actions of the migrated rules that firewall rules don't have.
+const (
+	actionRedirect         = "redirect"
+	actionSetCacheSettings = "set_cache_settings"
+)
+
+// refPrefix starts the refs of migrated rules, which identify them when a migration is applied again.
+const refPrefix = "migrated_"
+
+// Migration : The rules to create in each phase, and the constructs that could not be translated.
+type Migration struct {
+	// The rules of each phase, by phase, in the order they run.
+	Phases map[string][]rulesetsv1.RuleCreate `json:"phases"`
+
+	// The rules and actions that were not migrated.
+	Untranslated []Issue `json:"untranslated,omitempty"`
+
+	// The rules and actions that were migrated with a different behavior, which should be reviewed.
+	Warnings []Issue `json:"warnings,omitempty"`
+}
+
+// Issue : A rule or an action of a rule that could not be translated or was translated approximately.
+type Issue struct {
+	// The kind of rule, one of the Issue_Source_* constants.
+	Source string `json:"source"`
+
+	// The ID of the firewall rule or page rule.
+	ID string `json:"id"`
+
+	// The page rule action or firewall rule action concerned; empty when the issue is about the whole rule.
+	Construct string `json:"construct,omitempty"`
+
+	Message string `json:"message"`
+}
+
+func (issue Issue) String() string {
+	if issue.Construct == "" {
+		return fmt.Sprintf("%s %s: %s", issue.Source, issue.ID, issue.Message)
+	}
+	return fmt.Sprintf("%s %s: %s: %s", issue.Source, issue.ID, issue.Construct, issue.Message)
+}
+
+// PhaseNames returns the phases that have rules to create, sorted.
+func (migration *Migration) PhaseNames() (phases []string) {
+	for phase, rules := range migration.Phases {
+		if len(rules) > 0 {
+			phases = append(phases, phase)
+		}
+	}
+	sort.Strings(phases)
+	return
+}
+
+func (migration *Migration) add(phase string, rule rulesetsv1.RuleCreate) {
+	migration.Phases[phase] = append(migration.Phases[phase], rule)
+}
+
+func (migration *Migration) untranslated(source string, id string, construct string, format string, args ...interface{}) {
+	migration.Untranslated = append(migration.Untranslated, Issue{Source: source, ID: id, Construct: construct, Message: fmt.Sprintf(format, args...)})
+}
+
+func (migration *Migration) warn(source string, id string, construct string, format string, args ...interface{}) {
+	migration.Warnings = append(migration.Warnings, Issue{Source: source, ID: id, Construct: construct, Message: fmt.Sprintf(format, args...)})
+}
+
+// Translate translates firewall rules, with their filters, and page rules into the rules of the
+// http_request_firewall_custom, http_request_dynamic_redirect and http_request_cache_settings phases.
+//
+// Firewall rules keep the order in which they run: by priority, then by action. A paused rule or a rule with a paused
+// filter becomes a disabled rule, and allow, which rulesets don't have, becomes a skip of the remaining custom rules,
+// the rate limiting rules and the managed rules. Page rules become redirect and cache settings rules matching their
+// URL pattern, except the URLs that an enabled page rule with a higher priority matches too, since only the first
+// page rule matching a request applies. Page rule settings that rulesets can't express, such as security_level or
+// ssl, are reported in Untranslated.
+func Translate(firewallRules []firewallrulesv1.FirewallRuleObject, pageRules []pageruleapiv1.PageRuleResult) *Migration {
+	migration := &Migration{Phases: map[string][]rulesetsv1.RuleCreate{}}
+	translateFirewallRules(migration, firewallRules)
+	translatePageRules(migration, pageRules)
+	return migration
+}
+
+// firewallActions are the firewall rule actions that custom rules have too.
+var firewallActions = map[string]bool{
+	firewallexpression.ActionBlock:            true,
+	firewallexpression.ActionChallenge:        true,
+	firewallexpression.ActionJsChallenge:      true,
+	firewallexpression.ActionLog:              true,
+	firewallexpression.ActionManagedChallenge: true,
+}
+
+func translateFirewallRules(migration *Migration, firewallRules []firewallrulesv1.FirewallRuleObject) {
+	rules := firewallexpression.FirewallRules(firewallRules)
+	firewallexpression.SortRules(rules)
+	for _, rule := range rules {
+		if rule.Expression == "" {
+			migration.untranslated(Issue_Source_FirewallRule, rule.ID, "", "the rule has no filter expression")
+			continue
+		}
+		if err := firewallexpression.Check(rule.Expression); err != nil {
+			migration.untranslated(Issue_Source_FirewallRule, rule.ID, "", "the filter expression is invalid: %s", err)
+			continue
+		}
+
+		created := rulesetsv1.RuleCreate{
+			Action:      core.StringPtr(rule.Action),
+			Description: core.StringPtr(rule.Description),
+			Enabled:     core.BoolPtr(!rule.Paused),
+			Expression:  core.StringPtr(rule.Expression),
+			Ref:         core.StringPtr(refPrefix + "firewall_rule_" + rule.ID),
+		}
+		if rule.Description == "" {
+			created.Description = core.StringPtr("Migrated from firewall rule " + rule.ID)
+		}
+		switch {
+		case firewallActions[rule.Action]:
+		case rule.Action == firewallexpression.ActionAllow:
+			created.Action = core.StringPtr(firewallexpression.ActionSkip)
+			created.ActionParameters = &rulesetsv1.ActionParameters{
+				Ruleset: core.StringPtr("current"),
+				Phases: []string{
+					rulesetsv1.UpdateZoneEntrypointRulesetOptions_RulesetPhase_HttpRatelimit,
+					rulesetsv1.UpdateZoneEntrypointRulesetOptions_RulesetPhase_HttpRequestFirewallManaged,
+				},
+			}
+			created.Logging = &rulesetsv1.Logging{Enabled: core.BoolPtr(true)}
+			migration.warn(Issue_Source_FirewallRule, rule.ID, rule.Action,
+				"allow became a skip of the remaining custom rules, the rate limiting rules and the managed rules; other security features still apply")
+		default:
+			migration.untranslated(Issue_Source_FirewallRule, rule.ID, rule.Action, "custom rules have no equivalent action")
+			continue
+		}
+		migration.add(PhaseFirewallCustom, created)
+	}
+}
+
+// pageRuleAction is a page rule action with its value undecoded.
+type pageRuleAction struct {
+	ID    string          `json:"id"`
+	Value json.RawMessage `json:"value"`
+}
+
+// pageRuleCacheActions are the page rule actions that become cache settings.
+var pageRuleCacheActions = map[string]bool{
+	pageruleapiv1.PageRulesBodyActionsItem_ID_BrowserCacheTTL:     true,
+	pageruleapiv1.PageRulesBodyActionsItem_ID_BypassCacheOnCookie: true,
+	pageruleapiv1.PageRulesBodyActionsItem_ID_CacheLevel:          true,
+	pageruleapiv1.PageRulesBodyActionsItem_ID_EdgeCacheTTL:        true,
+}
+
+func translatePageRules(migration *Migration, pageRules []pageruleapiv1.PageRuleResult) {
+	// Only the first page rule matching a request applies, and page rules with a higher priority come first, but
+	// every matching cache settings rule applies. So the rules of a page rule leave out the URLs of the enabled page
+	// rules before it that overlap it.
+	sorted := append([]pageruleapiv1.PageRuleResult{}, pageRules...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return int64Value(sorted[i].Priority) > int64Value(sorted[j].Priority)
+	})
+	var higher []string
+	var cacheRules []rulesetsv1.RuleCreate
+	for _, pageRule := range sorted {
+		cacheRules = append(cacheRules, translatePageRule(migration, pageRule, higher)...)
+		if pattern, _, ok := pageRulePattern(pageRule.Targets); ok && stringValue(pageRule.Status) != "disabled" {
+			higher = append(higher, pattern)
+		}
+	}
+	for _, rule := range cacheRules {
+		migration.add(PhaseCacheSettings, rule)
+	}
+}
+
+// translatePageRule adds the redirect of a page rule to the migration and returns its cache settings rules. The rules
+// don't match the URLs that the patterns of the page rules with a higher priority match.
+func translatePageRule(migration *Migration, pageRule pageruleapiv1.PageRuleResult, higher []string) (cacheRules []rulesetsv1.RuleCreate) {
+	id := stringValue(pageRule.ID)
+	pattern, prefixWildcards, ok := pageRulePattern(pageRule.Targets)
+	if !ok {
+		migration.untranslated(Issue_Source_PageRule, id, "", "the rule has no URL target with the matches operator")
+		return
+	}
+	operands := []firewallexpression.Node{urlMatch(pattern)}
+	for _, other := range higher {
+		if wildcardsOverlap(pattern, other) {
+			operands = append(operands, &firewallexpression.NotExpr{Operand: urlMatch(other)})
+		}
+	}
+	match := operands[0]
+	if len(operands) > 1 {
+		match = &firewallexpression.LogicalExpr{Op: firewallexpression.OpAnd, Operands: operands}
+	}
+	enabled := stringValue(pageRule.Status) != "disabled"
+	newRule := func(action string, expression firewallexpression.Node, ref string, description string) rulesetsv1.RuleCreate {
+		return rulesetsv1.RuleCreate{
+			Action:      core.StringPtr(action),
+			Description: core.StringPtr(fmt.Sprintf("Migrated from page rule %s: %s", id, description)),
+			Enabled:     core.BoolPtr(enabled),
+			Expression:  core.StringPtr(expression.String()),
+			Ref:         core.StringPtr(refPrefix + "page_rule_" + id + ref),
+		}
+	}
+
+	var cache *rulesetsv1.ActionParameters
+	for _, item := range pageRule.Actions {
+		var action pageRuleAction
+		if encoded, err := json.Marshal(item); err != nil || json.Unmarshal(encoded, &action) != nil {
+			migration.untranslated(Issue_Source_PageRule, id, "", "an action can't be decoded")
+			continue
+		}
+
+		switch action.ID {
+		case pageruleapiv1.PageRulesBodyActionsItem_ID_ForwardingURL:
+			var value pageruleapiv1.ActionsForwardingUrlValue
+			if json.Unmarshal(action.Value, &value) != nil || value.URL == nil {
+				migration.untranslated(Issue_Source_PageRule, id, action.ID, "the value has no URL")
+				continue
+			}
+			rule := newRule(actionRedirect, match, "", "forward to "+*value.URL)
+			rule.ActionParameters = &rulesetsv1.ActionParameters{
+				FromValue: &rulesetsv1.ActionParametersFromValue{
+					TargetURL:           forwardingTarget(pattern, prefixWildcards, *value.URL),
+					StatusCode:          core.Int64Ptr(301),
+					PreserveQueryString: core.BoolPtr(false),
+				},
+			}
+			if value.StatusCode != nil {
+				rule.ActionParameters.FromValue.StatusCode = value.StatusCode
+			}
+			migration.add(PhaseDynamicRedirect, rule)
+
+		case pageruleapiv1.PageRulesBodyActionsItem_ID_AlwaysUseHttps:
+			expression := &firewallexpression.LogicalExpr{
+				Op:       firewallexpression.OpAnd,
+				Operands: []firewallexpression.Node{match, &firewallexpression.NotExpr{Operand: &firewallexpression.FieldExpr{Name: "ssl"}}},
+			}
+			rule := newRule(actionRedirect, expression, "", "always use HTTPS")
+			rule.ActionParameters = &rulesetsv1.ActionParameters{
+				FromValue: &rulesetsv1.ActionParametersFromValue{
+					TargetURL: &rulesetsv1.ActionParametersFromValueTargetURL{
+						Expression: core.StringPtr(`concat("https://", http.host, http.request.uri.path)`),
+					},
+					StatusCode:          core.Int64Ptr(301),
+					PreserveQueryString: core.BoolPtr(true),
+				},
+			}
+			migration.add(PhaseDynamicRedirect, rule)
+
+		case pageruleapiv1.PageRulesBodyActionsItem_ID_BypassCacheOnCookie:
+			var cookies string
+			if json.Unmarshal(action.Value, &cookies) != nil || cookies == "" {
+				migration.untranslated(Issue_Source_PageRule, id, action.ID, "the value is not a cookie pattern")
+				continue
+			}
+			expression := &firewallexpression.LogicalExpr{
+				Op: firewallexpression.OpAnd,
+				Operands: []firewallexpression.Node{match, &firewallexpression.ComparisonExpr{
+					Left:  &firewallexpression.FieldExpr{Name: "http.cookie"},
+					Op:    firewallexpression.OpMatches,
+					Right: &firewallexpression.StringLiteral{Value: cookies},
+				}},
+			}
+			rule := newRule(actionSetCacheSettings, expression, "_bypass_cache_on_cookie", "bypass the cache on cookie")
+			rule.ActionParameters = &rulesetsv1.ActionParameters{Cache: core.BoolPtr(false)}
+			// The bypass comes after the other cache settings of the page rule, so it overrides them.
+			cacheRules = append(cacheRules, rule)
+
+		case pageruleapiv1.PageRulesBodyActionsItem_ID_CacheLevel, pageruleapiv1.PageRulesBodyActionsItem_ID_EdgeCacheTTL,
+			pageruleapiv1.PageRulesBodyActionsItem_ID_BrowserCacheTTL:
+			if cache == nil {
+				cache = &rulesetsv1.ActionParameters{}
+			}
+			translateCacheAction(migration, id, action, cache)
+
+		default:
+			migration.untranslated(Issue_Source_PageRule, id, action.ID, "rulesets can't express this setting")
+		}
+	}
+
+	if cache != nil && (cache.Cache != nil || cache.EdgeTTL != nil || cache.BrowserTTL != nil) {
+		if cache.Cache == nil {
+			cache.Cache = core.BoolPtr(true)
+			migration.warn(Issue_Source_PageRule, id, "", "cache settings rules set TTLs only for responses eligible for cache, so the rule makes every response eligible, like cache_level cache_everything")
+		}
+		rule := newRule(actionSetCacheSettings, match, "", "cache settings")
+		rule.ActionParameters = cache
+		cacheRules = append([]rulesetsv1.RuleCreate{rule}, cacheRules...)
+	}
+	return
+}
+
+// translateCacheAction sets the cache settings of a cache_level, edge_cache_ttl or browser_cache_ttl action.
+func translateCacheAction(migration *Migration, id string, action pageRuleAction, cache *rulesetsv1.ActionParameters) {
+	if action.ID == pageruleapiv1.PageRulesBodyActionsItem_ID_CacheLevel {
+		var level string
+		_ = json.Unmarshal(action.Value, &level)
+		switch level {
+		case "bypass":
+			cache.Cache = core.BoolPtr(false)
+		case "cache_everything":
+			cache.Cache = core.BoolPtr(true)
+		case "aggressive":
+			migration.warn(Issue_Source_PageRule, id, action.ID, "aggressive is the default cache level, so it was dropped")
+		default:
+			migration.untranslated(Issue_Source_PageRule, id, action.ID, "the %q cache level changes the cache key, which rulesets can't express", level)
+		}
+		return
+	}
+
+	var ttl int64
+	if json.Unmarshal(action.Value, &ttl) != nil || ttl < 0 {
+		migration.untranslated(Issue_Source_PageRule, id, action.ID, "the value is not a number of seconds")
+		return
+	}
+	if action.ID == pageruleapiv1.PageRulesBodyActionsItem_ID_EdgeCacheTTL {
+		cache.EdgeTTL = &rulesetsv1.ActionParametersEdgeTTL{
+			Mode:    core.StringPtr(rulesetsv1.ActionParametersEdgeTTL_Mode_OverrideOrigin),
+			Default: core.Int64Ptr(ttl),
+		}
+		return
+	}
+	// A browser cache TTL of 0 respects the headers of the origin.
+	cache.BrowserTTL = &rulesetsv1.ActionParametersBrowserTTL{Mode: core.StringPtr(rulesetsv1.ActionParametersBrowserTTL_Mode_RespectOrigin)}
+	if ttl > 0 {
+		cache.BrowserTTL.Mode = core.StringPtr(rulesetsv1.ActionParametersBrowserTTL_Mode_OverrideOrigin)
+		cache.BrowserTTL.Default = core.Int64Ptr(ttl)
+	}
+}
+
+// pageRulePattern returns the wildcard pattern of the full URI matching the URL target of a page rule. A pattern
+// without a scheme matches both http and https, and one without a path matches the root path, like page rules. It
+// also returns the number of wildcards added before the wildcards of the page rule.
+func pageRulePattern(targets []pageruleapiv1.TargetsItem) (pattern string, prefixWildcards int, ok bool) {
+	for _, target := range targets {
+		if stringValue(target.Target) != "url" || target.Constraint == nil || stringValue(target.Constraint.Operator) != "matches" {
+			continue
+		}
+		pattern = stringValue(target.Constraint.Value)
+		lower := strings.ToLower(pattern)
+		if !strings.HasPrefix(lower, "http://") && !strings.HasPrefix(lower, "https://") && !strings.HasPrefix(lower, "http*://") {
+			pattern = "http*://" + pattern
+			prefixWildcards = 1
+		}
+		if !strings.Contains(pattern[strings.Index(pattern, "://")+3:], "/") {
+			pattern += "/"
+		}
+		return pattern, prefixWildcards, pattern != ""
+	}
+	return "", 0, false
+}
+
+// urlMatch returns the expression matching the full URIs of a wildcard pattern.
+func urlMatch(pattern string) *firewallexpression.ComparisonExpr {
+	return &firewallexpression.ComparisonExpr{
+		Left:  &firewallexpression.FieldExpr{Name: "http.request.full_uri"},
+		Op:    firewallexpression.OpWildcard,
+		Right: &firewallexpression.StringLiteral{Value: pattern},
+	}
+}
+
+// wildcardsOverlap returns whether a URL can match two wildcard patterns, ignoring case. The wildcard of an http*://
+// scheme only matches http and https.
+func wildcardsOverlap(a string, b string) bool {
+	for _, a := range schemes(strings.ToLower(a)) {
+		for _, b := range schemes(strings.ToLower(b)) {
+			if patternsOverlap(a, b) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// schemes returns the patterns of the http and https schemes of a pattern with the http*:// scheme.
+func schemes(pattern string) []string {
+	if rest, ok := strings.CutPrefix(pattern, "http*://"); ok {
+		return []string{"http://" + rest, "https://" + rest}
+	}
+	return []string{pattern}
+}
+
+// patternsOverlap returns whether a string can match two wildcard patterns.
+func patternsOverlap(a string, b string) bool {
+	// overlap[i][j] is whether the rests of the patterns, a[i:] and b[j:], can match the same string.
+	overlap := make([][]bool, len(a)+1)
+	for i := range overlap {
+		overlap[i] = make([]bool, len(b)+1)
+	}
+	for i := len(a); i >= 0; i-- {
+		for j := len(b); j >= 0; j-- {
+			switch {
+			case i == len(a) && j == len(b):
+				overlap[i][j] = true
+			case i < len(a) && a[i] == '*':
+				// The wildcard matches nothing more, or the next character of the other pattern too.
+				overlap[i][j] = overlap[i+1][j] || (j < len(b) && overlap[i][j+1])
+			case j < len(b) && b[j] == '*':
+				overlap[i][j] = overlap[i][j+1] || (i < len(a) && overlap[i+1][j])
+			default:
+				overlap[i][j] = i < len(a) && j < len(b) && a[i] == b[j] && overlap[i+1][j+1]
+			}
+		}
+	}
+	return overlap[0][0]
+}
+
+// pageRuleReference matches the $1 to $9 references of a forwarding URL to the wildcards of the page rule pattern.
+var pageRuleReference = regexp.MustCompile(`\$([1-9])`)
+
+// forwardingTarget returns the target URL of a forwarding URL: a static URL, or an expression replacing the
+// references to the wildcards of the pattern.
+func forwardingTarget(pattern string, prefixWildcards int, url string) *rulesetsv1.ActionParametersFromValueTargetURL {
+	if !pageRuleReference.MatchString(url) {
+		return &rulesetsv1.ActionParametersFromValueTargetURL{Value: core.StringPtr(url)}
+	}
+	replacement := pageRuleReference.ReplaceAllStringFunc(url, func(reference string) string {
+		n, _ := strconv.Atoi(reference[1:])
+		return fmt.Sprintf("${%d}", n+prefixWildcards)
+	})
+	expression := &firewallexpression.CallExpr{
+		Name: "wildcard_replace",
+		Args: []firewallexpression.Node{
+			&firewallexpression.FieldExpr{Name: "http.request.full_uri"},
+			&firewallexpression.StringLiteral{Value: pattern},
+			&firewallexpression.StringLiteral{Value: replacement},
+		},
+	}
+	return &rulesetsv1.ActionParametersFromValueTargetURL{Expression: core.StringPtr(expression.String())}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func int64Value(i *int64) int64 {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
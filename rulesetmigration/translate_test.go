@@ -0,0 +1,60 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rulesetmigration
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/pageruleapiv1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTranslateOverlappingPageRules(t *testing.T) {
+	var pageRules []pageruleapiv1.PageRuleResult
+	err := core.UnmarshalModel(map[string]json.RawMessage{"result": json.RawMessage(`[
+		{"id": "p-images", "priority": 2, "status": "active", "created_on": "x", "modified_on": "x",
+		 "targets": [{"target": "url", "constraint": {"operator": "matches", "value": "example.com/images/*"}}],
+		 "actions": [{"id": "edge_cache_ttl", "value": 86400}]},
+		{"id": "p-site", "priority": 1, "status": "active", "created_on": "x", "modified_on": "x",
+		 "targets": [{"target": "url", "constraint": {"operator": "matches", "value": "example.com/*"}}],
+		 "actions": [{"id": "edge_cache_ttl", "value": 60}, {"id": "browser_cache_ttl", "value": 600}]},
+		{"id": "p-blog", "priority": 3, "status": "active", "created_on": "x", "modified_on": "x",
+		 "targets": [{"target": "url", "constraint": {"operator": "matches", "value": "blog.example.org/*"}}],
+		 "actions": [{"id": "cache_level", "value": "bypass"}]}
+	]`)}, "result", &pageRules, pageruleapiv1.UnmarshalPageRuleResult)
+	assert.Nil(t, err)
+
+	// Only the page rule with the highest priority applies to a URL, so the TTLs of p-site don't apply to the images,
+	// and p-blog, which matches other URLs, is kept out of the others.
+	cache := Translate(nil, pageRules).Phases[PhaseCacheSettings]
+	assert.Equal(t, []string{"migrated_page_rule_p-blog", "migrated_page_rule_p-images", "migrated_page_rule_p-site"}, refs(cache))
+	assert.Equal(t, `http.request.full_uri wildcard "http*://blog.example.org/*"`, *cache[0].Expression)
+	assert.Equal(t, `http.request.full_uri wildcard "http*://example.com/images/*"`, *cache[1].Expression)
+	assert.Equal(t, `http.request.full_uri wildcard "http*://example.com/*" and not http.request.full_uri wildcard "http*://example.com/images/*"`,
+		*cache[2].Expression)
+	assert.Equal(t, int64(600), *cache[2].ActionParameters.BrowserTTL.Default)
+}
+
+func TestWildcardsOverlap(t *testing.T) {
+	assert.True(t, wildcardsOverlap("http*://example.com/*", "https://EXAMPLE.com/images/*"))
+	assert.True(t, wildcardsOverlap("http*://*.example.com/", "http*://www.example.*/"))
+	assert.False(t, wildcardsOverlap("http*://example.com/*", "http*://example.org/*"))
+	assert.False(t, wildcardsOverlap("http://example.com/*", "https://example.com/*"))
+	assert.False(t, wildcardsOverlap("http*://example.com/a/*", "http*://example.com/b/*"))
+}
@@ -0,0 +1,34 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rulesetsv1
+
+// AsRuleCreate returns the rule to create to keep an existing rule when the rules of its ruleset are replaced, e.g.
+// with UpdateZoneEntrypointRuleset. The rule keeps its ID and ref; the read-only fields (version, categories and
+// last_updated) are left out.
+func (ruleDetails *RuleDetails) AsRuleCreate() RuleCreate {
+	return RuleCreate{
+		ID:               ruleDetails.ID,
+		Action:           ruleDetails.Action,
+		ActionParameters: ruleDetails.ActionParameters,
+		Ratelimit:        ruleDetails.Ratelimit,
+		Description:      ruleDetails.Description,
+		Enabled:          ruleDetails.Enabled,
+		Expression:       ruleDetails.Expression,
+		Logging:          ruleDetails.Logging,
+		Ref:              ruleDetails.Ref,
+	}
+}
@@ -0,0 +1,46 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rulesetsv1_test
+
+import (
+	"encoding/json"
+
+	"github.com/IBM/networking-go-sdk/rulesetsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`RuleDetails.AsRuleCreate()`, func() {
+	It(`Keeps every writable field of the rule`, func() {
+		var rule rulesetsv1.RuleDetails
+		Expect(json.Unmarshal([]byte(`{
+			"id": "r1", "version": "3", "categories": ["wordpress"], "last_updated": "2026-01-01T00:00:00Z",
+			"action": "block", "action_parameters": {"response": {"content": "blocked", "content_type": "text/plain", "status_code": 403}},
+			"ratelimit": {"characteristics": ["ip.src"], "counting_expression": "true", "mitigation_timeout": 60, "period": 60, "requests_per_period": 100},
+			"description": "Block", "enabled": false, "expression": "ip.src eq 192.0.2.1", "ref": "block-ref", "logging": {"enabled": true}
+		}`), &rule)).To(Succeed())
+
+		created, err := json.Marshal(rule.AsRuleCreate())
+		Expect(err).To(BeNil())
+		Expect(created).To(MatchJSON(`{
+			"id": "r1",
+			"action": "block", "action_parameters": {"response": {"content": "blocked", "content_type": "text/plain", "status_code": 403}},
+			"ratelimit": {"characteristics": ["ip.src"], "counting_expression": "true", "mitigation_timeout": 60, "period": 60, "requests_per_period": 100},
+			"description": "Block", "enabled": false, "expression": "ip.src eq 192.0.2.1", "ref": "block-ref", "logging": {"enabled": true}
+		}`))
+	})
+})
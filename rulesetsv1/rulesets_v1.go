@@ -188,6 +188,12 @@ func (rulesets *RulesetsV1) DisableRetries() {
 	rulesets.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (rulesets *RulesetsV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(rulesets.Service, policy)
+}
+
 // GetInstanceRulesets : List Instance rulesets
 // List all rulesets at the instance level.
 func (rulesets *RulesetsV1) GetInstanceRulesets(getInstanceRulesetsOptions *GetInstanceRulesetsOptions) (result *ListRulesetsResp, response *core.DetailedResponse, err error) {
@@ -236,6 +242,7 @@ func (rulesets *RulesetsV1) GetInstanceRulesetsWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_instance_rulesets", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -306,6 +313,7 @@ func (rulesets *RulesetsV1) GetInstanceRulesetWithContext(ctx context.Context, g
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_instance_ruleset", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -399,6 +407,7 @@ func (rulesets *RulesetsV1) UpdateInstanceRulesetWithContext(ctx context.Context
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_instance_ruleset", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -467,6 +476,7 @@ func (rulesets *RulesetsV1) DeleteInstanceRulesetWithContext(ctx context.Context
 
 	response, err = rulesets.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_instance_ruleset", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -529,6 +539,7 @@ func (rulesets *RulesetsV1) GetInstanceRulesetVersionsWithContext(ctx context.Co
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_instance_ruleset_versions", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -600,6 +611,7 @@ func (rulesets *RulesetsV1) GetInstanceRulesetVersionWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_instance_ruleset_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -669,6 +681,7 @@ func (rulesets *RulesetsV1) DeleteInstanceRulesetVersionWithContext(ctx context.
 
 	response, err = rulesets.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_instance_ruleset_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -731,6 +744,7 @@ func (rulesets *RulesetsV1) GetInstanceEntrypointRulesetWithContext(ctx context.
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_instance_entrypoint_ruleset", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -824,6 +838,7 @@ func (rulesets *RulesetsV1) UpdateInstanceEntrypointRulesetWithContext(ctx conte
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_instance_entrypoint_ruleset", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -894,6 +909,7 @@ func (rulesets *RulesetsV1) GetInstanceEntryPointRulesetVersionsWithContext(ctx
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_instance_entry_point_ruleset_versions", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -965,6 +981,7 @@ func (rulesets *RulesetsV1) GetInstanceEntryPointRulesetVersionWithContext(ctx c
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_instance_entry_point_ruleset_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1073,6 +1090,7 @@ func (rulesets *RulesetsV1) CreateInstanceRulesetRuleWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_instance_ruleset_rule", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1182,6 +1200,7 @@ func (rulesets *RulesetsV1) UpdateInstanceRulesetRuleWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_instance_ruleset_rule", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1253,6 +1272,7 @@ func (rulesets *RulesetsV1) DeleteInstanceRulesetRuleWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_instance_ruleset_rule", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1325,6 +1345,7 @@ func (rulesets *RulesetsV1) GetInstanceRulesetVersionByTagWithContext(ctx contex
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_instance_ruleset_version_by_tag", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1390,6 +1411,7 @@ func (rulesets *RulesetsV1) GetZoneRulesetsWithContext(ctx context.Context, getZ
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_zone_rulesets", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1461,6 +1483,7 @@ func (rulesets *RulesetsV1) GetZoneRulesetWithContext(ctx context.Context, getZo
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_zone_ruleset", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1555,6 +1578,7 @@ func (rulesets *RulesetsV1) UpdateZoneRulesetWithContext(ctx context.Context, up
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_zone_ruleset", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1624,6 +1648,7 @@ func (rulesets *RulesetsV1) DeleteZoneRulesetWithContext(ctx context.Context, de
 
 	response, err = rulesets.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_zone_ruleset", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1687,6 +1712,7 @@ func (rulesets *RulesetsV1) GetZoneRulesetVersionsWithContext(ctx context.Contex
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_zone_ruleset_versions", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1759,6 +1785,7 @@ func (rulesets *RulesetsV1) GetZoneRulesetVersionWithContext(ctx context.Context
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_zone_ruleset_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1829,6 +1856,7 @@ func (rulesets *RulesetsV1) DeleteZoneRulesetVersionWithContext(ctx context.Cont
 
 	response, err = rulesets.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_zone_ruleset_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1892,6 +1920,7 @@ func (rulesets *RulesetsV1) GetZoneEntrypointRulesetWithContext(ctx context.Cont
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_zone_entrypoint_ruleset", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1986,6 +2015,7 @@ func (rulesets *RulesetsV1) UpdateZoneEntrypointRulesetWithContext(ctx context.C
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_zone_entrypoint_ruleset", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2057,6 +2087,7 @@ func (rulesets *RulesetsV1) GetZoneEntryPointRulesetVersionsWithContext(ctx cont
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_zone_entry_point_ruleset_versions", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2129,6 +2160,7 @@ func (rulesets *RulesetsV1) GetZoneEntryPointRulesetVersionWithContext(ctx conte
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_zone_entry_point_ruleset_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2238,6 +2270,7 @@ func (rulesets *RulesetsV1) CreateZoneRulesetRuleWithContext(ctx context.Context
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_zone_ruleset_rule", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2348,6 +2381,7 @@ func (rulesets *RulesetsV1) UpdateZoneRulesetRuleWithContext(ctx context.Context
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_zone_ruleset_rule", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2420,6 +2454,7 @@ func (rulesets *RulesetsV1) DeleteZoneRulesetRuleWithContext(ctx context.Context
 	var rawResponse map[string]json.RawMessage
 	response, err = rulesets.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_zone_ruleset_rule", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4278,6 +4313,18 @@ type ActionParameters struct {
 
 	// A map of managed ruleset ID(string) to lists of ruleset's rule IDs(array of strings).
 	Rules map[string][]string `json:"rules,omitempty"`
+
+	// The redirect of a redirect rule.
+	FromValue *ActionParametersFromValue `json:"from_value,omitempty"`
+
+	// Whether a cache settings rule makes the response eligible for caching.
+	Cache *bool `json:"cache,omitempty"`
+
+	// How long the edge caches the response of a cache settings rule.
+	EdgeTTL *ActionParametersEdgeTTL `json:"edge_ttl,omitempty"`
+
+	// How long browsers cache the response of a cache settings rule.
+	BrowserTTL *ActionParametersBrowserTTL `json:"browser_ttl,omitempty"`
 }
 
 // UnmarshalActionParameters unmarshals an instance of ActionParameters from the specified map of raw messages.
@@ -4328,6 +4375,189 @@ func UnmarshalActionParameters(m map[string]json.RawMessage, result interface{})
 		err = core.SDKErrorf(err, "", "rules-error", common.GetComponentInfo())
 		return
 	}
+	err = core.UnmarshalModel(m, "from_value", &obj.FromValue, UnmarshalActionParametersFromValue)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "from_value-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "cache", &obj.Cache)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "cache-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalModel(m, "edge_ttl", &obj.EdgeTTL, UnmarshalActionParametersEdgeTTL)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "edge_ttl-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalModel(m, "browser_ttl", &obj.BrowserTTL, UnmarshalActionParametersBrowserTTL)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "browser_ttl-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// ActionParametersBrowserTTL : How long browsers cache the response of a cache settings rule.
+type ActionParametersBrowserTTL struct {
+	// Whether browsers bypass the cache, keep the TTL sent by the origin, or use the default TTL instead.
+	Mode *string `json:"mode" validate:"required"`
+
+	// The time to live in seconds, with the override_origin mode.
+	Default *int64 `json:"default,omitempty"`
+}
+
+// Constants associated with the ActionParametersBrowserTTL.Mode property.
+const (
+	ActionParametersBrowserTTL_Mode_Bypass         = "bypass"
+	ActionParametersBrowserTTL_Mode_OverrideOrigin = "override_origin"
+	ActionParametersBrowserTTL_Mode_RespectOrigin  = "respect_origin"
+)
+
+// NewActionParametersBrowserTTL : Instantiate ActionParametersBrowserTTL (Generic Model Constructor)
+func (*RulesetsV1) NewActionParametersBrowserTTL(mode string) (_model *ActionParametersBrowserTTL, err error) {
+	_model = &ActionParametersBrowserTTL{
+		Mode: core.StringPtr(mode),
+	}
+	err = core.ValidateStruct(_model, "required parameters")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-missing-required", common.GetComponentInfo())
+	}
+	return
+}
+
+// UnmarshalActionParametersBrowserTTL unmarshals an instance of ActionParametersBrowserTTL from the specified map of raw messages.
+func UnmarshalActionParametersBrowserTTL(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(ActionParametersBrowserTTL)
+	err = core.UnmarshalPrimitive(m, "mode", &obj.Mode)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "mode-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "default", &obj.Default)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "default-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// ActionParametersEdgeTTL : How long the edge caches the response of a cache settings rule.
+type ActionParametersEdgeTTL struct {
+	// Whether the edge caches the response only when the origin allows it, keeps the TTL sent by the origin, or uses
+	// the default TTL instead.
+	Mode *string `json:"mode" validate:"required"`
+
+	// The time to live in seconds, with the override_origin mode.
+	Default *int64 `json:"default,omitempty"`
+}
+
+// Constants associated with the ActionParametersEdgeTTL.Mode property.
+const (
+	ActionParametersEdgeTTL_Mode_BypassByDefault = "bypass_by_default"
+	ActionParametersEdgeTTL_Mode_OverrideOrigin  = "override_origin"
+	ActionParametersEdgeTTL_Mode_RespectOrigin   = "respect_origin"
+)
+
+// NewActionParametersEdgeTTL : Instantiate ActionParametersEdgeTTL (Generic Model Constructor)
+func (*RulesetsV1) NewActionParametersEdgeTTL(mode string) (_model *ActionParametersEdgeTTL, err error) {
+	_model = &ActionParametersEdgeTTL{
+		Mode: core.StringPtr(mode),
+	}
+	err = core.ValidateStruct(_model, "required parameters")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-missing-required", common.GetComponentInfo())
+	}
+	return
+}
+
+// UnmarshalActionParametersEdgeTTL unmarshals an instance of ActionParametersEdgeTTL from the specified map of raw messages.
+func UnmarshalActionParametersEdgeTTL(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(ActionParametersEdgeTTL)
+	err = core.UnmarshalPrimitive(m, "mode", &obj.Mode)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "mode-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "default", &obj.Default)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "default-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// ActionParametersFromValue : The redirect of a redirect rule.
+type ActionParametersFromValue struct {
+	// The URL to redirect to.
+	TargetURL *ActionParametersFromValueTargetURL `json:"target_url" validate:"required"`
+
+	// The status code of the redirect: 301, 302, 303, 307 or 308.
+	StatusCode *int64 `json:"status_code,omitempty"`
+
+	// Whether the query string of the request is added to the target URL.
+	PreserveQueryString *bool `json:"preserve_query_string,omitempty"`
+}
+
+// NewActionParametersFromValue : Instantiate ActionParametersFromValue (Generic Model Constructor)
+func (*RulesetsV1) NewActionParametersFromValue(targetURL *ActionParametersFromValueTargetURL) (_model *ActionParametersFromValue, err error) {
+	_model = &ActionParametersFromValue{
+		TargetURL: targetURL,
+	}
+	err = core.ValidateStruct(_model, "required parameters")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-missing-required", common.GetComponentInfo())
+	}
+	return
+}
+
+// UnmarshalActionParametersFromValue unmarshals an instance of ActionParametersFromValue from the specified map of raw messages.
+func UnmarshalActionParametersFromValue(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(ActionParametersFromValue)
+	err = core.UnmarshalModel(m, "target_url", &obj.TargetURL, UnmarshalActionParametersFromValueTargetURL)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "target_url-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "status_code", &obj.StatusCode)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "status_code-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "preserve_query_string", &obj.PreserveQueryString)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "preserve_query_string-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// ActionParametersFromValueTargetURL : The target URL of a redirect: a static URL, or an expression computing it.
+type ActionParametersFromValueTargetURL struct {
+	// A static target URL.
+	Value *string `json:"value,omitempty"`
+
+	// An expression computing the target URL from the request.
+	Expression *string `json:"expression,omitempty"`
+}
+
+// UnmarshalActionParametersFromValueTargetURL unmarshals an instance of ActionParametersFromValueTargetURL from the specified map of raw messages.
+func UnmarshalActionParametersFromValueTargetURL(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(ActionParametersFromValueTargetURL)
+	err = core.UnmarshalPrimitive(m, "value", &obj.Value)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "value-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "expression", &obj.Expression)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "expression-error", common.GetComponentInfo())
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4817,6 +5047,9 @@ type RuleDetails struct {
 
 	ActionParameters *ActionParameters `json:"action_parameters,omitempty"`
 
+	// The rate limit of a rate limiting rule.
+	Ratelimit *Ratelimit `json:"ratelimit,omitempty"`
+
 	// List of categories for the rule.
 	Categories []string `json:"categories,omitempty"`
 
@@ -4861,6 +5094,11 @@ func UnmarshalRuleDetails(m map[string]json.RawMessage, result interface{}) (err
 		err = core.SDKErrorf(err, "", "action_parameters-error", common.GetComponentInfo())
 		return
 	}
+	err = core.UnmarshalModel(m, "ratelimit", &obj.Ratelimit, UnmarshalRatelimit)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "ratelimit-error", common.GetComponentInfo())
+		return
+	}
 	err = core.UnmarshalPrimitive(m, "categories", &obj.Categories)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "categories-error", common.GetComponentInfo())
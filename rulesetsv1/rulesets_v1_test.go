@@ -10692,6 +10692,86 @@ var _ = Describe(`RulesetsV1`, func() {
 			Expect(result).ToNot(BeNil())
 			Expect(result).To(Equal(model))
 		})
+		It(`Invoke UnmarshalActionParametersBrowserTTL successfully`, func() {
+			// Construct an instance of the model.
+			model := new(rulesetsv1.ActionParametersBrowserTTL)
+			model.Mode = core.StringPtr("override_origin")
+			model.Default = core.Int64Ptr(int64(3600))
+
+			b, err := json.Marshal(model)
+			Expect(err).To(BeNil())
+
+			var raw map[string]json.RawMessage
+			err = json.Unmarshal(b, &raw)
+			Expect(err).To(BeNil())
+
+			var result *rulesetsv1.ActionParametersBrowserTTL
+			err = rulesetsv1.UnmarshalActionParametersBrowserTTL(raw, &result)
+			Expect(err).To(BeNil())
+			Expect(result).ToNot(BeNil())
+			Expect(result).To(Equal(model))
+		})
+		It(`Invoke UnmarshalActionParametersEdgeTTL successfully`, func() {
+			// Construct an instance of the model.
+			model := new(rulesetsv1.ActionParametersEdgeTTL)
+			model.Mode = core.StringPtr("override_origin")
+			model.Default = core.Int64Ptr(int64(86400))
+
+			b, err := json.Marshal(model)
+			Expect(err).To(BeNil())
+
+			var raw map[string]json.RawMessage
+			err = json.Unmarshal(b, &raw)
+			Expect(err).To(BeNil())
+
+			var result *rulesetsv1.ActionParametersEdgeTTL
+			err = rulesetsv1.UnmarshalActionParametersEdgeTTL(raw, &result)
+			Expect(err).To(BeNil())
+			Expect(result).ToNot(BeNil())
+			Expect(result).To(Equal(model))
+		})
+		It(`Invoke UnmarshalActionParametersFromValueTargetURL successfully`, func() {
+			// Construct an instance of the model.
+			model := new(rulesetsv1.ActionParametersFromValueTargetURL)
+			model.Value = core.StringPtr("https://example.com/new")
+			model.Expression = core.StringPtr("concat(\"https://example.com\", http.request.uri.path)")
+
+			b, err := json.Marshal(model)
+			Expect(err).To(BeNil())
+
+			var raw map[string]json.RawMessage
+			err = json.Unmarshal(b, &raw)
+			Expect(err).To(BeNil())
+
+			var result *rulesetsv1.ActionParametersFromValueTargetURL
+			err = rulesetsv1.UnmarshalActionParametersFromValueTargetURL(raw, &result)
+			Expect(err).To(BeNil())
+			Expect(result).ToNot(BeNil())
+			Expect(result).To(Equal(model))
+		})
+		It(`Invoke UnmarshalActionParametersFromValue successfully`, func() {
+			// Construct an instance of the model.
+			actionParametersFromValueTargetURLModel := new(rulesetsv1.ActionParametersFromValueTargetURL)
+			actionParametersFromValueTargetURLModel.Value = core.StringPtr("https://example.com/new")
+
+			model := new(rulesetsv1.ActionParametersFromValue)
+			model.TargetURL = actionParametersFromValueTargetURLModel
+			model.StatusCode = core.Int64Ptr(int64(301))
+			model.PreserveQueryString = core.BoolPtr(true)
+
+			b, err := json.Marshal(model)
+			Expect(err).To(BeNil())
+
+			var raw map[string]json.RawMessage
+			err = json.Unmarshal(b, &raw)
+			Expect(err).To(BeNil())
+
+			var result *rulesetsv1.ActionParametersFromValue
+			err = rulesetsv1.UnmarshalActionParametersFromValue(raw, &result)
+			Expect(err).To(BeNil())
+			Expect(result).ToNot(BeNil())
+			Expect(result).To(Equal(model))
+		})
 		It(`Invoke UnmarshalActionParameters successfully`, func() {
 			// Construct an instance of the model.
 			model := new(rulesetsv1.ActionParameters)
@@ -10704,6 +10784,13 @@ var _ = Describe(`RulesetsV1`, func() {
 			model.Products = []string{"testString"}
 			model.Response = nil
 			model.Rules = map[string][]string{"key1": {"testString"}}
+			model.FromValue = &rulesetsv1.ActionParametersFromValue{
+				TargetURL:  &rulesetsv1.ActionParametersFromValueTargetURL{Value: core.StringPtr("https://example.com/new")},
+				StatusCode: core.Int64Ptr(int64(301)),
+			}
+			model.Cache = core.BoolPtr(true)
+			model.EdgeTTL = &rulesetsv1.ActionParametersEdgeTTL{Mode: core.StringPtr("override_origin"), Default: core.Int64Ptr(int64(86400))}
+			model.BrowserTTL = &rulesetsv1.ActionParametersBrowserTTL{Mode: core.StringPtr("respect_origin")}
 
 			b, err := json.Marshal(model)
 			Expect(err).To(BeNil())
@@ -10847,6 +10934,42 @@ var _ = Describe(`RulesetsV1`, func() {
 			Expect(result).ToNot(BeNil())
 			Expect(result).To(Equal(model))
 		})
+		It(`Invoke UnmarshalRuleDetails successfully`, func() {
+			// Construct an instance of the model.
+			ratelimitModel := new(rulesetsv1.Ratelimit)
+			ratelimitModel.Characteristics = []string{"ip.src"}
+			ratelimitModel.CountingExpression = core.StringPtr("true")
+			ratelimitModel.MitigationTimeout = core.Int64Ptr(int64(60))
+			ratelimitModel.Period = core.Int64Ptr(int64(60))
+			ratelimitModel.RequestsPerPeriod = core.Int64Ptr(int64(100))
+
+			model := new(rulesetsv1.RuleDetails)
+			model.ID = core.StringPtr("testString")
+			model.Version = core.StringPtr("testString")
+			model.Action = core.StringPtr("block")
+			model.ActionParameters = nil
+			model.Ratelimit = ratelimitModel
+			model.Categories = []string{"testString"}
+			model.Enabled = core.BoolPtr(true)
+			model.Description = core.StringPtr("testString")
+			model.Expression = core.StringPtr("ip.src ne 1.1.1.1")
+			model.Ref = core.StringPtr("my_ref")
+			model.Logging = nil
+			model.LastUpdated = core.StringPtr("2000-01-01T00:00:00.000000Z")
+
+			b, err := json.Marshal(model)
+			Expect(err).To(BeNil())
+
+			var raw map[string]json.RawMessage
+			err = json.Unmarshal(b, &raw)
+			Expect(err).To(BeNil())
+
+			var result *rulesetsv1.RuleDetails
+			err = rulesetsv1.UnmarshalRuleDetails(raw, &result)
+			Expect(err).To(BeNil())
+			Expect(result).ToNot(BeNil())
+			Expect(result).To(Equal(model))
+		})
 		It(`Invoke UnmarshalRulesOverride successfully`, func() {
 			// Construct an instance of the model.
 			model := new(rulesetsv1.RulesOverride)
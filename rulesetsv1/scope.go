@@ -0,0 +1,135 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rulesetsv1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+)
+
+// Constants associated with the ScopeOptions.Scope property.
+// The rulesets of the zone of the rulesets client, or of its instance.
+const (
+	ScopeOptions_Scope_Instance = "instance"
+	ScopeOptions_Scope_Zone     = "zone"
+)
+
+// ScopeOptions : The scope of the rulesets that the entrypoint helpers read and update.
+type ScopeOptions struct {
+	// The scope of the rulesets. By default the zone of the rulesets client.
+	Scope string
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewScopeOptions : Instantiate ScopeOptions
+func NewScopeOptions() *ScopeOptions {
+	return &ScopeOptions{Scope: ScopeOptions_Scope_Zone}
+}
+
+// SetScope : Allow user to set Scope
+func (_options *ScopeOptions) SetScope(scope string) *ScopeOptions {
+	_options.Scope = scope
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (_options *ScopeOptions) SetHeaders(param map[string]string) *ScopeOptions {
+	_options.Headers = param
+	return _options
+}
+
+// Validate returns an error unless the scope is one of the ScopeOptions_Scope_* constants.
+func (_options *ScopeOptions) Validate() error {
+	if _options.Scope != ScopeOptions_Scope_Zone && _options.Scope != ScopeOptions_Scope_Instance {
+		return core.SDKErrorf(nil, fmt.Sprintf("unknown scope %q", _options.Scope), "invalid-scope", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// GetScopedEntrypointRules : Get the rules of an entrypoint ruleset
+// Get the rules of the entrypoint ruleset of a phase, in the zone or the instance of the scope, as the rules to create
+// to keep them when the rules of the ruleset are replaced. A phase without an entrypoint ruleset has no rules.
+func (rulesets *RulesetsV1) GetScopedEntrypointRules(scopeOptions *ScopeOptions, phase string) (result []RuleCreate, err error) {
+	result, err = rulesets.GetScopedEntrypointRulesWithContext(context.Background(), scopeOptions, phase)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetScopedEntrypointRulesWithContext is an alternate form of the GetScopedEntrypointRules method which supports a Context parameter
+func (rulesets *RulesetsV1) GetScopedEntrypointRulesWithContext(ctx context.Context, scopeOptions *ScopeOptions, phase string) (result []RuleCreate, err error) {
+	var entrypoint *RulesetResp
+	if scopeOptions.Scope == ScopeOptions_Scope_Instance {
+		entrypoint, _, err = rulesets.GetInstanceEntrypointRulesetWithContext(ctx, &GetInstanceEntrypointRulesetOptions{
+			RulesetPhase: core.StringPtr(phase),
+			Headers:      scopeOptions.Headers,
+		})
+	} else {
+		entrypoint, _, err = rulesets.GetZoneEntrypointRulesetWithContext(ctx, &GetZoneEntrypointRulesetOptions{
+			RulesetPhase: core.StringPtr(phase),
+			Headers:      scopeOptions.Headers,
+		})
+	}
+	switch {
+	case common.IsNotFound(err):
+		// The phase has no entrypoint ruleset yet.
+		err = nil
+	case err != nil:
+		err = core.RepurposeSDKProblem(err, "get-entrypoint-ruleset-error")
+	case entrypoint.Result != nil:
+		for _, rule := range entrypoint.Result.Rules {
+			result = append(result, rule.AsRuleCreate())
+		}
+	}
+	return
+}
+
+// UpdateScopedEntrypointRules : Replace the rules of an entrypoint ruleset
+// Replace the rules of the entrypoint ruleset of a phase, in the zone or the instance of the scope. A nil
+// "description" is left out of the request.
+func (rulesets *RulesetsV1) UpdateScopedEntrypointRules(scopeOptions *ScopeOptions, phase string, description *string, rules []RuleCreate) (result *RulesetResp, err error) {
+	result, err = rulesets.UpdateScopedEntrypointRulesWithContext(context.Background(), scopeOptions, phase, description, rules)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpdateScopedEntrypointRulesWithContext is an alternate form of the UpdateScopedEntrypointRules method which supports a Context parameter
+func (rulesets *RulesetsV1) UpdateScopedEntrypointRulesWithContext(ctx context.Context, scopeOptions *ScopeOptions, phase string, description *string, rules []RuleCreate) (result *RulesetResp, err error) {
+	if scopeOptions.Scope == ScopeOptions_Scope_Instance {
+		result, _, err = rulesets.UpdateInstanceEntrypointRulesetWithContext(ctx, &UpdateInstanceEntrypointRulesetOptions{
+			RulesetPhase: core.StringPtr(phase),
+			Description:  description,
+			Rules:        rules,
+			Headers:      scopeOptions.Headers,
+		})
+	} else {
+		result, _, err = rulesets.UpdateZoneEntrypointRulesetWithContext(ctx, &UpdateZoneEntrypointRulesetOptions{
+			RulesetPhase: core.StringPtr(phase),
+			Description:  description,
+			Rules:        rules,
+			Headers:      scopeOptions.Headers,
+		})
+	}
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "update-entrypoint-ruleset-error")
+	}
+	return
+}
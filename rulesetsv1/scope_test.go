@@ -0,0 +1,97 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rulesetsv1_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/rulesetsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Scoped entrypoint rules`, func() {
+	var testServer *httptest.Server
+	var requests []string
+	var rulesetsService *rulesetsv1.RulesetsV1
+	BeforeEach(func() {
+		requests = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			body, _ := io.ReadAll(req.Body)
+			requests = append(requests, req.Method+" "+req.URL.Path+" "+req.Header.Get("X-Test")+" "+strings.TrimSpace(string(body)))
+			res.Header().Set("Content-type", "application/json")
+			if req.URL.Path == "/v1/crn1/rulesets/phases/http_request_firewall_custom/entrypoint" && req.Method == http.MethodGet {
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"success": false, "errors": [{"code": 10000, "message": "not found"}], "messages": [], "result": null}`)
+				return
+			}
+			fmt.Fprint(res, `{"success": true, "errors": [], "messages": [], "result": {
+				"id": "r1", "name": "zone", "description": "", "kind": "zone", "phase": "http_request_firewall_custom",
+				"version": "2", "last_updated": "x", "rules": [
+					{"id": "rule1", "version": "1", "action": "block", "expression": "true", "ref": "block-all", "last_updated": "x"}
+				]}}`)
+		}))
+		var err error
+		rulesetsService, err = rulesetsv1.NewRulesetsV1(&rulesetsv1.RulesetsV1Options{
+			URL:            testServer.URL,
+			Authenticator:  &core.NoAuthAuthenticator{},
+			Crn:            core.StringPtr("crn1"),
+			ZoneIdentifier: core.StringPtr("zone1"),
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Reads the rules of the entrypoint ruleset of the zone`, func() {
+		scopeOptions := rulesetsv1.NewScopeOptions().SetHeaders(map[string]string{"X-Test": "zone"})
+		rules, err := rulesetsService.GetScopedEntrypointRules(scopeOptions, "http_request_firewall_custom")
+		Expect(err).To(BeNil())
+		Expect(rules).To(HaveLen(1))
+		Expect(rules[0].ID).To(Equal(core.StringPtr("rule1")))
+		Expect(rules[0].Ref).To(Equal(core.StringPtr("block-all")))
+		Expect(requests).To(Equal([]string{"GET /v1/crn1/zones/zone1/rulesets/phases/http_request_firewall_custom/entrypoint zone "}))
+	})
+	It(`Reads no rules when the instance has no entrypoint ruleset`, func() {
+		scopeOptions := rulesetsv1.NewScopeOptions().SetScope(rulesetsv1.ScopeOptions_Scope_Instance)
+		rules, err := rulesetsService.GetScopedEntrypointRules(scopeOptions, "http_request_firewall_custom")
+		Expect(err).To(BeNil())
+		Expect(rules).To(BeEmpty())
+	})
+	It(`Replaces the rules of the entrypoint ruleset of the instance`, func() {
+		scopeOptions := rulesetsv1.NewScopeOptions().SetScope(rulesetsv1.ScopeOptions_Scope_Instance).SetHeaders(map[string]string{"X-Test": "instance"})
+		rules := []rulesetsv1.RuleCreate{{Action: core.StringPtr("log"), Expression: core.StringPtr("true")}}
+		result, err := rulesetsService.UpdateScopedEntrypointRules(scopeOptions, "http_request_firewall_managed", nil, rules)
+		Expect(err).To(BeNil())
+		Expect(result.Result.Version).To(Equal(core.StringPtr("2")))
+		Expect(requests).To(Equal([]string{
+			`PUT /v1/crn1/rulesets/phases/http_request_firewall_managed/entrypoint instance {"rules":[{"action":"log","expression":"true"}]}`,
+		}))
+	})
+	It(`Rejects an unknown scope`, func() {
+		Expect(rulesetsv1.NewScopeOptions().Validate()).To(Succeed())
+		err := rulesetsv1.NewScopeOptions().SetScope("account").Validate()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring(`unknown scope "account"`))
+	})
+})
@@ -179,6 +179,12 @@ func (securityEventsApi *SecurityEventsApiV1) DisableRetries() {
 	securityEventsApi.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (securityEventsApi *SecurityEventsApiV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(securityEventsApi.Service, policy)
+}
+
 // SecurityEvents : Logs of the mitigations performed by Firewall features
 // Provides a full log of the mitigations performed by the CIS Firewall features including; Firewall Rules, Rate
 // Limiting, Security Level, Access Rules (IP, IP Range, ASN, and Country), WAF (Web Application Firewall), User Agent
@@ -283,6 +289,7 @@ func (securityEventsApi *SecurityEventsApiV1) SecurityEventsWithContext(ctx cont
 	var rawResponse map[string]json.RawMessage
 	response, err = securityEventsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSecurityEvents)
@@ -179,6 +179,12 @@ func (sslCertificateApi *SslCertificateApiV1) DisableRetries() {
 	sslCertificateApi.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (sslCertificateApi *SslCertificateApiV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(sslCertificateApi.Service, policy)
+}
+
 // ListCertificates : List all certificates
 // CIS automatically add an active DNS zone to a universal SSL certificate, shared among multiple customers. Customer
 // may order dedicated certificates for the owning zones. This API list all certificates for a given zone, including
@@ -228,6 +234,7 @@ func (sslCertificateApi *SslCertificateApiV1) ListCertificatesWithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -305,6 +312,7 @@ func (sslCertificateApi *SslCertificateApiV1) OrderCertificateWithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -370,6 +378,7 @@ func (sslCertificateApi *SslCertificateApiV1) DeleteCertificateWithContext(ctx c
 	}
 
 	response, err = sslCertificateApi.Service.Request(request, nil)
+	err = common.NewAPIError(err, response)
 
 	return
 }
@@ -418,6 +427,7 @@ func (sslCertificateApi *SslCertificateApiV1) GetSslSettingWithContext(ctx conte
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -485,6 +495,7 @@ func (sslCertificateApi *SslCertificateApiV1) ChangeSslSettingWithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -542,6 +553,7 @@ func (sslCertificateApi *SslCertificateApiV1) ListCustomCertificatesWithContext(
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -618,6 +630,7 @@ func (sslCertificateApi *SslCertificateApiV1) UploadCustomCertificateWithContext
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -680,6 +693,7 @@ func (sslCertificateApi *SslCertificateApiV1) GetCustomCertificateWithContext(ct
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -761,6 +775,7 @@ func (sslCertificateApi *SslCertificateApiV1) UpdateCustomCertificateWithContext
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -820,6 +835,7 @@ func (sslCertificateApi *SslCertificateApiV1) DeleteCustomCertificateWithContext
 	}
 
 	response, err = sslCertificateApi.Service.Request(request, nil)
+	err = common.NewAPIError(err, response)
 
 	return
 }
@@ -875,6 +891,7 @@ func (sslCertificateApi *SslCertificateApiV1) ChangeCertificatePriorityWithConte
 	}
 
 	response, err = sslCertificateApi.Service.Request(request, nil)
+	err = common.NewAPIError(err, response)
 
 	return
 }
@@ -923,6 +940,7 @@ func (sslCertificateApi *SslCertificateApiV1) GetUniversalCertificateSettingWith
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -987,6 +1005,7 @@ func (sslCertificateApi *SslCertificateApiV1) ChangeUniversalCertificateSettingW
 	}
 
 	response, err = sslCertificateApi.Service.Request(request, nil)
+	err = common.NewAPIError(err, response)
 
 	return
 }
@@ -1035,6 +1054,7 @@ func (sslCertificateApi *SslCertificateApiV1) GetTls12SettingWithContext(ctx con
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1102,6 +1122,7 @@ func (sslCertificateApi *SslCertificateApiV1) ChangeTls12SettingWithContext(ctx
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1159,6 +1180,7 @@ func (sslCertificateApi *SslCertificateApiV1) GetTls13SettingWithContext(ctx con
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1226,6 +1248,7 @@ func (sslCertificateApi *SslCertificateApiV1) ChangeTls13SettingWithContext(ctx
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1312,6 +1335,7 @@ func (sslCertificateApi *SslCertificateApiV1) OrderAdvancedCertificateWithContex
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1378,6 +1402,7 @@ func (sslCertificateApi *SslCertificateApiV1) PatchCertificateWithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1440,6 +1465,7 @@ func (sslCertificateApi *SslCertificateApiV1) DeleteCertificateV2WithContext(ctx
 	}
 
 	response, err = sslCertificateApi.Service.Request(request, nil)
+	err = common.NewAPIError(err, response)
 
 	return
 }
@@ -1491,6 +1517,7 @@ func (sslCertificateApi *SslCertificateApiV1) GetSslVerificationWithContext(ctx
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1555,6 +1582,7 @@ func (sslCertificateApi *SslCertificateApiV1) ListOriginCertificatesWithContext(
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1638,6 +1666,7 @@ func (sslCertificateApi *SslCertificateApiV1) CreateOriginCertificateWithContext
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1703,6 +1732,7 @@ func (sslCertificateApi *SslCertificateApiV1) RevokeOriginCertificateWithContext
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1768,6 +1798,7 @@ func (sslCertificateApi *SslCertificateApiV1) GetOriginCertificateWithContext(ct
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -0,0 +1,258 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transitgatewayapisv1
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+)
+
+// PrefixFilterEvaluator decides, without calling the API, whether the prefix filters of a connection permit or deny
+// routes, so that changes to the filters can be tested before they are applied.
+//
+// The filters are applied in order and the first filter matching a route decides; routes no filter matches get the
+// default action of the connection. A filter is applied right before the filter its Before field names, and a filter
+// without Before is applied last. Filters sharing the same Before are applied in the order given, as if they had been
+// created in that order.
+type PrefixFilterEvaluator struct {
+	filters       []PrefixFilterCust
+	prefixes      []netip.Prefix
+	defaultAction string
+}
+
+// PrefixFilterDecision : The action the prefix filters of a connection take on a route.
+type PrefixFilterDecision struct {
+	// The prefix of the route.
+	Prefix string `json:"prefix"`
+
+	// The action taken, permit or deny.
+	Action string `json:"action"`
+
+	// The filter that decides, or nil if the default action does.
+	Filter *PrefixFilterCust `json:"filter,omitempty"`
+
+	// The position of the filter in the order the filters are applied, starting at 0, or -1 for the default action.
+	Position int `json:"position"`
+}
+
+// Permitted returns true if the route is permitted.
+func (decision PrefixFilterDecision) Permitted() bool {
+	return decision.Action == PrefixFilterCust_Action_Permit
+}
+
+func (decision PrefixFilterDecision) String() string {
+	if decision.Filter == nil {
+		return fmt.Sprintf("%s %s by the default action", decision.Action, decision.Prefix)
+	}
+	return fmt.Sprintf("%s %s by filter %d (%s)", decision.Action, decision.Prefix, decision.Position, describePrefixFilter(*decision.Filter))
+}
+
+// NewPrefixFilterEvaluator returns an evaluator for "filters", e.g. the filters returned by
+// ListTransitGatewayConnectionPrefixFilters or a proposed list, and the connection's default action, permit or deny.
+// Filters need an ID only when another filter's Before names them. It returns a common.ValidationErrors listing every
+// invalid filter, including filters whose Before names a filter that is not in the list.
+func NewPrefixFilterEvaluator(filters []PrefixFilterCust, defaultAction string) (evaluator *PrefixFilterEvaluator, err error) {
+	var validationErrs common.ValidationErrors
+	if defaultAction != PrefixFilterCust_Action_Permit && defaultAction != PrefixFilterCust_Action_Deny {
+		validationErrs.Add("prefix_filters_default", "must be permit or deny, got %q", defaultAction)
+	}
+	ids := map[string]bool{}
+	for _, filter := range filters {
+		if filter.ID != nil && *filter.ID != "" {
+			ids[*filter.ID] = true
+		}
+	}
+	for i, filter := range filters {
+		field := fmt.Sprintf("prefix_filters[%d]", i)
+		if filter.Action == nil || (*filter.Action != PrefixFilterCust_Action_Permit && *filter.Action != PrefixFilterCust_Action_Deny) {
+			validationErrs.Add(field+".action", "must be permit or deny")
+		}
+		if filter.Before != nil && *filter.Before != "" && !ids[*filter.Before] {
+			validationErrs.Add(field+".before", "names the prefix filter %q, which is not in the list", *filter.Before)
+		}
+		prefix, err := netip.ParsePrefix(stringValue(filter.Prefix))
+		if err != nil || !prefix.Addr().Is4() {
+			validationErrs.Add(field+".prefix", "%q is not an IPv4 prefix", stringValue(filter.Prefix))
+			continue
+		}
+		ge, le := common.PrefixFilterBound(filter.Ge), common.PrefixFilterBound(filter.Le)
+		if ge != 0 && (ge < int64(prefix.Bits()) || ge > 32) {
+			validationErrs.Add(field+".ge", "must be 0 or between %d and 32, got %d", prefix.Bits(), ge)
+		}
+		if le != 0 && (le < int64(prefix.Bits()) || le > 32 || (ge != 0 && le < ge)) {
+			validationErrs.Add(field+".le", "must be 0 or between %d and 32, got %d", max(ge, int64(prefix.Bits())), le)
+		}
+	}
+	if err = validationErrs.Err(); err != nil {
+		return
+	}
+	evaluator = newPrefixFilterEvaluator(filters, defaultAction)
+	if len(evaluator.filters) != len(filters) {
+		err = fmt.Errorf("the before fields of the prefix filters form a cycle")
+		evaluator = nil
+	}
+	return
+}
+
+// newPrefixFilterEvaluator returns an evaluator without checking the filters.
+// Filters with an invalid prefix never match, and filters whose Before names
+// a missing filter or forms a cycle are left out.
+func newPrefixFilterEvaluator(filters []PrefixFilterCust, defaultAction string) *PrefixFilterEvaluator {
+	if defaultAction == "" {
+		defaultAction = TransitGatewayConnectionCust_PrefixFiltersDefault_Permit
+	}
+	evaluator := &PrefixFilterEvaluator{filters: orderPrefixFilters(filters), defaultAction: defaultAction}
+	for _, filter := range evaluator.filters {
+		prefix, _ := netip.ParsePrefix(stringValue(filter.Prefix))
+		evaluator.prefixes = append(evaluator.prefixes, prefix.Masked())
+	}
+	return evaluator
+}
+
+// Filters returns the filters in the order they are applied.
+func (evaluator *PrefixFilterEvaluator) Filters() []PrefixFilterCust {
+	return append([]PrefixFilterCust{}, evaluator.filters...)
+}
+
+// Evaluate returns the action the filters take on a route to "prefix".
+func (evaluator *PrefixFilterEvaluator) Evaluate(prefix string) (decision PrefixFilterDecision, err error) {
+	route, err := netip.ParsePrefix(prefix)
+	if err != nil {
+		err = fmt.Errorf("%q is not an IP prefix", prefix)
+		return
+	}
+	route = route.Masked()
+	decision = PrefixFilterDecision{Prefix: route.String(), Action: evaluator.defaultAction, Position: -1}
+	for i, filter := range evaluator.filters {
+		if common.PrefixFilterMatches(route, evaluator.prefixes[i], filter.Ge, filter.Le) {
+			decision.Action = stringValue(filter.Action)
+			decision.Filter = &evaluator.filters[i]
+			decision.Position = i
+			break
+		}
+	}
+	return
+}
+
+// EvaluateAll returns the action the filters take on a route to each of "prefixes". It returns a
+// common.ValidationErrors listing every prefix that cannot be parsed.
+func (evaluator *PrefixFilterEvaluator) EvaluateAll(prefixes []string) (decisions []PrefixFilterDecision, err error) {
+	var validationErrs common.ValidationErrors
+	for i, prefix := range prefixes {
+		decision, evalErr := evaluator.Evaluate(prefix)
+		if evalErr != nil {
+			validationErrs.Add(fmt.Sprintf("prefixes[%d]", i), "%s", evalErr.Error())
+			continue
+		}
+		decisions = append(decisions, decision)
+	}
+	err = validationErrs.Err()
+	return
+}
+
+// NewTransitGatewayConnectionPrefixFilterEvaluator : Get an evaluator for the prefix filters of a connection
+// List the prefix filters of a connection and get its default action, and return an evaluator for them.
+func (transitGatewayApis *TransitGatewayApisV1) NewTransitGatewayConnectionPrefixFilterEvaluator(listTransitGatewayConnectionPrefixFiltersOptions *ListTransitGatewayConnectionPrefixFiltersOptions) (evaluator *PrefixFilterEvaluator, err error) {
+	evaluator, err = transitGatewayApis.NewTransitGatewayConnectionPrefixFilterEvaluatorWithContext(context.Background(), listTransitGatewayConnectionPrefixFiltersOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// NewTransitGatewayConnectionPrefixFilterEvaluatorWithContext is an alternate form of the NewTransitGatewayConnectionPrefixFilterEvaluator method which supports a Context parameter
+func (transitGatewayApis *TransitGatewayApisV1) NewTransitGatewayConnectionPrefixFilterEvaluatorWithContext(ctx context.Context, listTransitGatewayConnectionPrefixFiltersOptions *ListTransitGatewayConnectionPrefixFiltersOptions) (evaluator *PrefixFilterEvaluator, err error) {
+	err = core.ValidateNotNil(listTransitGatewayConnectionPrefixFiltersOptions, "listTransitGatewayConnectionPrefixFiltersOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(listTransitGatewayConnectionPrefixFiltersOptions, "listTransitGatewayConnectionPrefixFiltersOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	connection, _, err := transitGatewayApis.GetTransitGatewayConnectionWithContext(ctx, &GetTransitGatewayConnectionOptions{
+		TransitGatewayID: listTransitGatewayConnectionPrefixFiltersOptions.TransitGatewayID,
+		ID:               listTransitGatewayConnectionPrefixFiltersOptions.ID,
+		Headers:          listTransitGatewayConnectionPrefixFiltersOptions.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-connection-error")
+		return
+	}
+	collection, _, err := transitGatewayApis.ListTransitGatewayConnectionPrefixFiltersWithContext(ctx, listTransitGatewayConnectionPrefixFiltersOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-prefix-filters-error")
+		return
+	}
+
+	defaultAction := stringValue(connection.PrefixFiltersDefault)
+	if defaultAction == "" {
+		defaultAction = TransitGatewayConnectionCust_PrefixFiltersDefault_Permit
+	}
+	evaluator, err = NewPrefixFilterEvaluator(collection.PrefixFilters, defaultAction)
+	return
+}
+
+// prefixFiltersFromReferences returns the prefix filters a connection refers to.
+func prefixFiltersFromReferences(references []TransitGatewayConnectionPrefixFilterReference) (filters []PrefixFilterCust) {
+	for _, reference := range references {
+		filters = append(filters, PrefixFilterCust{
+			Action:    reference.Action,
+			Before:    reference.Before,
+			CreatedAt: reference.CreatedAt,
+			Ge:        reference.Ge,
+			ID:        reference.ID,
+			Le:        reference.Le,
+			Prefix:    reference.Prefix,
+			UpdatedAt: reference.UpdatedAt,
+		})
+	}
+	return
+}
+
+// orderPrefixFilters returns the filters in the order they are applied, as
+// common.OrderPrefixFilters orders them.
+func orderPrefixFilters(filters []PrefixFilterCust) (ordered []PrefixFilterCust) {
+	ids := make([]string, len(filters))
+	befores := make([]string, len(filters))
+	for i, filter := range filters {
+		ids[i], befores[i] = stringValue(filter.ID), stringValue(filter.Before)
+	}
+	for _, i := range common.OrderPrefixFilters(ids, befores) {
+		ordered = append(ordered, filters[i])
+	}
+	return
+}
+
+func describePrefixFilter(filter PrefixFilterCust) string {
+	description := stringValue(filter.Action) + " " + stringValue(filter.Prefix)
+	if ge := common.PrefixFilterBound(filter.Ge); ge != 0 {
+		description += fmt.Sprintf(" ge %d", ge)
+	}
+	if le := common.PrefixFilterBound(filter.Le); le != 0 {
+		description += fmt.Sprintf(" le %d", le)
+	}
+	if filter.ID != nil && *filter.ID != "" {
+		description = *filter.ID + ": " + description
+	}
+	return description
+}
@@ -0,0 +1,157 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transitgatewayapisv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`TransitGatewayApisV1 prefix filter evaluator`, func() {
+	prefixFilter := func(id string, action string, prefix string) transitgatewayapisv1.PrefixFilterCust {
+		return transitgatewayapisv1.PrefixFilterCust{ID: core.StringPtr(id), Action: core.StringPtr(action), Prefix: core.StringPtr(prefix)}
+	}
+
+	It(`Applies the first matching filter in the order given by before`, func() {
+		permitAll := prefixFilter("f1", "permit", "10.0.0.0/8")
+		permitAll.Le = core.Int64Ptr(32)
+		denyLab := prefixFilter("f2", "deny", "10.20.0.0/16")
+		denyLab.Ge = core.Int64Ptr(24)
+		denyLab.Before = core.StringPtr("f1")
+		permitHost := prefixFilter("f3", "permit", "10.20.30.0/24")
+		permitHost.Before = core.StringPtr("f1")
+		denySummary := prefixFilter("f4", "deny", "10.20.0.0/16")
+
+		evaluator, err := transitgatewayapisv1.NewPrefixFilterEvaluator([]transitgatewayapisv1.PrefixFilterCust{permitAll, denyLab, permitHost, denySummary}, "deny")
+		Expect(err).To(BeNil())
+		var ids []string
+		for _, filter := range evaluator.Filters() {
+			ids = append(ids, *filter.ID)
+		}
+		Expect(ids).To(Equal([]string{"f2", "f3", "f1", "f4"}))
+
+		decisions, err := evaluator.EvaluateAll([]string{"10.20.30.0/24", "10.20.0.0/16", "10.1.0.0/16", "192.168.0.0/24", "10.20.30.1/24"})
+		Expect(err).To(BeNil())
+		Expect(decisions).To(HaveLen(5))
+		Expect(decisions[0].Permitted()).To(BeFalse())
+		Expect(*decisions[0].Filter.ID).To(Equal("f2"))
+		Expect(decisions[0].Position).To(Equal(0))
+		Expect(decisions[1].Permitted()).To(BeTrue())
+		Expect(*decisions[1].Filter.ID).To(Equal("f1"))
+		Expect(decisions[2].Permitted()).To(BeTrue())
+		Expect(decisions[3].Filter).To(BeNil())
+		Expect(decisions[3].Position).To(Equal(-1))
+		Expect(decisions[3].String()).To(Equal("deny 192.168.0.0/24 by the default action"))
+		Expect(decisions[4].Prefix).To(Equal("10.20.30.0/24"))
+		Expect(decisions[4].String()).To(Equal("deny 10.20.30.0/24 by filter 0 (f2: deny 10.20.0.0/16 ge 24)"))
+	})
+
+	It(`Matches only the exact prefix without ge and le`, func() {
+		evaluator, err := transitgatewayapisv1.NewPrefixFilterEvaluator([]transitgatewayapisv1.PrefixFilterCust{prefixFilter("f1", "deny", "10.0.0.0/8")}, "permit")
+		Expect(err).To(BeNil())
+		decision, err := evaluator.Evaluate("10.0.0.0/8")
+		Expect(err).To(BeNil())
+		Expect(decision.Permitted()).To(BeFalse())
+		decision, err = evaluator.Evaluate("10.1.0.0/16")
+		Expect(err).To(BeNil())
+		Expect(decision.Permitted()).To(BeTrue())
+
+		_, err = evaluator.Evaluate("10.1.0.0")
+		Expect(err).ToNot(BeNil())
+		_, err = evaluator.EvaluateAll([]string{"10.0.0.0/8", "nope"})
+		validationErrs, ok := common.AsValidationErrors(err)
+		Expect(ok).To(BeTrue())
+		Expect(validationErrs[0].Field).To(Equal("prefixes[1]"))
+	})
+
+	It(`Lists every invalid filter`, func() {
+		badBounds := prefixFilter("f2", "permit", "10.0.0.0/16")
+		badBounds.Ge = core.Int64Ptr(8)
+		badBounds.Le = core.Int64Ptr(33)
+		missingBefore := prefixFilter("f3", "permit", "10.0.0.0/8")
+		missingBefore.Before = core.StringPtr("f9")
+
+		_, err := transitgatewayapisv1.NewPrefixFilterEvaluator([]transitgatewayapisv1.PrefixFilterCust{
+			prefixFilter("f1", "allow", "10.0.0.0/33"), badBounds, missingBefore,
+		}, "maybe")
+		validationErrs, ok := common.AsValidationErrors(err)
+		Expect(ok).To(BeTrue())
+		var fields []string
+		for _, validationErr := range validationErrs {
+			fields = append(fields, validationErr.Field)
+		}
+		Expect(fields).To(Equal([]string{
+			"prefix_filters_default",
+			"prefix_filters[0].action",
+			"prefix_filters[0].prefix",
+			"prefix_filters[1].ge",
+			"prefix_filters[1].le",
+			"prefix_filters[2].before",
+		}))
+	})
+
+	It(`Rejects filters whose before fields form a cycle`, func() {
+		first := prefixFilter("f1", "permit", "10.0.0.0/8")
+		first.Before = core.StringPtr("f2")
+		second := prefixFilter("f2", "deny", "10.0.0.0/16")
+		second.Before = core.StringPtr("f1")
+		_, err := transitgatewayapisv1.NewPrefixFilterEvaluator([]transitgatewayapisv1.PrefixFilterCust{first, second}, "permit")
+		Expect(err).ToNot(BeNil())
+	})
+
+	It(`Gets an evaluator for the filters of a connection`, func() {
+		var paths []string
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			paths = append(paths, req.URL.Path)
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			if req.URL.Path == "/transit_gateways/tgw1/connections/conn1" {
+				fmt.Fprint(res, `{"id": "conn1", "name": "vpc-conn", "network_type": "vpc", "created_at": "2019-01-01T12:00:00.000Z", "status": "attached", "prefix_filters_default": "deny"}`)
+				return
+			}
+			fmt.Fprint(res, `{"prefix_filters": [{"id": "f1", "action": "permit", "prefix": "10.0.0.0/8", "le": 24, "created_at": "2019-01-01T12:00:00.000Z"}]}`)
+		}))
+		defer testServer.Close()
+		transitGatewayApisService, serviceErr := transitgatewayapisv1.NewTransitGatewayApisV1(&transitgatewayapisv1.TransitGatewayApisV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			Version:       core.StringPtr("2024-01-01"),
+		})
+		Expect(serviceErr).To(BeNil())
+
+		evaluator, err := transitGatewayApisService.NewTransitGatewayConnectionPrefixFilterEvaluator(transitGatewayApisService.NewListTransitGatewayConnectionPrefixFiltersOptions("tgw1", "conn1"))
+		Expect(err).To(BeNil())
+		Expect(paths).To(Equal([]string{"/transit_gateways/tgw1/connections/conn1", "/transit_gateways/tgw1/connections/conn1/prefix_filters"}))
+		decisions, err := evaluator.EvaluateAll([]string{"10.1.0.0/16", "10.1.1.128/25"})
+		Expect(err).To(BeNil())
+		Expect(decisions[0].Permitted()).To(BeTrue())
+		Expect(decisions[1].Permitted()).To(BeFalse())
+
+		_, err = transitGatewayApisService.NewTransitGatewayConnectionPrefixFilterEvaluator(nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,373 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transitgatewayapisv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+)
+
+// AnalyzeTransitGatewayRouteReport : Analyze the routes of a transit gateway
+// Create a route report, wait for it to complete, and summarize it together with the prefix filters of the
+// connections: the overlapping prefixes of each pair of connections, the routes each connection's prefix filters keep
+// out of the transit gateway, and the prefixes no connection gets into it.
+func (transitGatewayApis *TransitGatewayApisV1) AnalyzeTransitGatewayRouteReport(analyzeTransitGatewayRouteReportOptions *AnalyzeTransitGatewayRouteReportOptions) (result *RouteReportAnalysis, err error) {
+	result, err = transitGatewayApis.AnalyzeTransitGatewayRouteReportWithContext(context.Background(), analyzeTransitGatewayRouteReportOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// AnalyzeTransitGatewayRouteReportWithContext is an alternate form of the AnalyzeTransitGatewayRouteReport method which supports a Context parameter
+func (transitGatewayApis *TransitGatewayApisV1) AnalyzeTransitGatewayRouteReportWithContext(ctx context.Context, analyzeTransitGatewayRouteReportOptions *AnalyzeTransitGatewayRouteReportOptions) (result *RouteReportAnalysis, err error) {
+	err = core.ValidateNotNil(analyzeTransitGatewayRouteReportOptions, "analyzeTransitGatewayRouteReportOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(analyzeTransitGatewayRouteReportOptions, "analyzeTransitGatewayRouteReportOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	transitGatewayID := *analyzeTransitGatewayRouteReportOptions.TransitGatewayID
+
+	report, _, err := transitGatewayApis.CreateTransitGatewayRouteReportWithContext(ctx, &CreateTransitGatewayRouteReportOptions{
+		TransitGatewayID: core.StringPtr(transitGatewayID),
+		Headers:          analyzeTransitGatewayRouteReportOptions.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "create-report-error")
+		return
+	}
+	if report.Status == nil || *report.Status != RouteReport_Status_Complete {
+		report, err = transitGatewayApis.WaitForTransitGatewayRouteReport(ctx, transitGatewayID, *report.ID)
+		if err != nil {
+			return
+		}
+	}
+
+	pager, err := transitGatewayApis.NewTransitGatewayConnectionsPager(&ListTransitGatewayConnectionsOptions{
+		TransitGatewayID: core.StringPtr(transitGatewayID),
+		Headers:          analyzeTransitGatewayRouteReportOptions.Headers,
+	})
+	if err != nil {
+		return
+	}
+	connections, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-connections-error")
+		return
+	}
+
+	result = AnalyzeRouteReport(report, connections)
+	return
+}
+
+// AnalyzeTransitGatewayRouteReportOptions : The AnalyzeTransitGatewayRouteReport options.
+type AnalyzeTransitGatewayRouteReportOptions struct {
+	// The Transit Gateway identifier.
+	TransitGatewayID *string `json:"transit_gateway_id" validate:"required,ne="`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewAnalyzeTransitGatewayRouteReportOptions : Instantiate AnalyzeTransitGatewayRouteReportOptions
+func (*TransitGatewayApisV1) NewAnalyzeTransitGatewayRouteReportOptions(transitGatewayID string) *AnalyzeTransitGatewayRouteReportOptions {
+	return &AnalyzeTransitGatewayRouteReportOptions{
+		TransitGatewayID: core.StringPtr(transitGatewayID),
+	}
+}
+
+// SetTransitGatewayID : Allow user to set TransitGatewayID
+func (_options *AnalyzeTransitGatewayRouteReportOptions) SetTransitGatewayID(transitGatewayID string) *AnalyzeTransitGatewayRouteReportOptions {
+	_options.TransitGatewayID = core.StringPtr(transitGatewayID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *AnalyzeTransitGatewayRouteReportOptions) SetHeaders(param map[string]string) *AnalyzeTransitGatewayRouteReportOptions {
+	options.Headers = param
+	return options
+}
+
+// RouteReportAnalysis : A summary of a route report.
+type RouteReportAnalysis struct {
+	// The ID of the route report.
+	ReportID string `json:"report_id"`
+
+	// The overlapping prefixes, by pair of connections.
+	Overlaps []RouteOverlap `json:"overlaps"`
+
+	// The routes kept out of the transit gateway by the prefix filters of the connection they come from.
+	FilteredRoutes []FilteredRoute `json:"filtered_routes"`
+
+	// The prefixes that connections advertise but that no connection gets into the transit gateway.
+	UnreachablePrefixes []UnreachablePrefix `json:"unreachable_prefixes"`
+}
+
+// RouteAnalysisConnection : A connection named in a route report analysis.
+type RouteAnalysisConnection struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+func (connection RouteAnalysisConnection) String() string {
+	if connection.Name == "" {
+		return connection.ID
+	}
+	return connection.Name
+}
+
+// RouteOverlap : The overlapping prefixes of two connections.
+type RouteOverlap struct {
+	Connections [2]RouteAnalysisConnection `json:"connections"`
+
+	// The overlapping prefixes of the first and the second connection, in pairs.
+	Prefixes [][2]string `json:"prefixes"`
+}
+
+// FilteredRoute : A route that a prefix filter keeps out of the transit gateway.
+type FilteredRoute struct {
+	Connection RouteAnalysisConnection `json:"connection"`
+	Prefix     string                  `json:"prefix"`
+
+	// The ID of the prefix filter that denies the route, or "" if the connection's default action does.
+	FilterID string `json:"filter_id,omitempty"`
+}
+
+// UnreachablePrefix : A prefix that no connection gets into the transit gateway.
+type UnreachablePrefix struct {
+	Prefix string `json:"prefix"`
+
+	// The connections advertising the prefix.
+	Connections []RouteAnalysisConnection `json:"connections"`
+
+	// Why the prefix is unreachable.
+	Reason string `json:"reason"`
+}
+
+// Reasons given for unreachable prefixes.
+const (
+	UnreachablePrefix_Reason_Filtered      = "filtered by every connection advertising it"
+	UnreachablePrefix_Reason_BgpPathUnused = "no BGP path to it is in use"
+)
+
+// AnalyzeRouteReport summarizes "report" without calling the API. The prefix filters are taken from "connections";
+// connections missing from it are treated as having no prefix filters.
+func AnalyzeRouteReport(report *RouteReport, connections []TransitGatewayConnectionCust) (analysis *RouteReportAnalysis) {
+	analysis = &RouteReportAnalysis{
+		Overlaps:            []RouteOverlap{},
+		FilteredRoutes:      []FilteredRoute{},
+		UnreachablePrefixes: []UnreachablePrefix{},
+	}
+	if report == nil {
+		return
+	}
+	if report.ID != nil {
+		analysis.ReportID = *report.ID
+	}
+
+	filters := map[string]*TransitGatewayConnectionCust{}
+	for i := range connections {
+		if connections[i].ID != nil {
+			filters[*connections[i].ID] = &connections[i]
+		}
+	}
+	described := map[string]RouteAnalysisConnection{}
+	for _, connection := range report.Connections {
+		reference := describeRouteReportConnection(connection)
+		described[reference.ID] = reference
+	}
+
+	// Overlaps, by pair of connections.
+	overlaps := map[[2]string]*RouteOverlap{}
+	var pairs [][2]string
+	for _, group := range report.OverlappingRoutes {
+		for i, first := range group.Routes {
+			for _, second := range group.Routes[i+1:] {
+				if first.ConnectionID == nil || second.ConnectionID == nil || *first.ConnectionID == *second.ConnectionID {
+					continue
+				}
+				firstPrefix, secondPrefix := stringValue(first.Prefix), stringValue(second.Prefix)
+				pair := [2]string{*first.ConnectionID, *second.ConnectionID}
+				if pair[0] > pair[1] {
+					pair[0], pair[1] = pair[1], pair[0]
+					firstPrefix, secondPrefix = secondPrefix, firstPrefix
+				}
+				overlap, ok := overlaps[pair]
+				if !ok {
+					overlap = &RouteOverlap{Connections: [2]RouteAnalysisConnection{
+						routeAnalysisConnection(described, pair[0]),
+						routeAnalysisConnection(described, pair[1]),
+					}}
+					overlaps[pair] = overlap
+					pairs = append(pairs, pair)
+				}
+				overlap.Prefixes = append(overlap.Prefixes, [2]string{firstPrefix, secondPrefix})
+			}
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i][0] < pairs[j][0] || (pairs[i][0] == pairs[j][0] && pairs[i][1] < pairs[j][1])
+	})
+	for _, pair := range pairs {
+		analysis.Overlaps = append(analysis.Overlaps, *overlaps[pair])
+	}
+
+	// Filtered routes and unreachable prefixes.
+	type advertisement struct {
+		connection RouteAnalysisConnection
+		permitted  bool
+		bgpOnly    bool
+		used       bool
+	}
+	advertisements := map[string][]*advertisement{}
+	var prefixes []string
+	for _, connection := range report.Connections {
+		reference := described[stringValue(connection.ID)]
+		byPrefix := map[string]*advertisement{}
+		var connectionPrefixes []string
+		advertise := func(prefix string) *advertisement {
+			if adv, ok := byPrefix[prefix]; ok {
+				return adv
+			}
+			adv := &advertisement{connection: reference, bgpOnly: true}
+			byPrefix[prefix] = adv
+			connectionPrefixes = append(connectionPrefixes, prefix)
+			return adv
+		}
+		for _, route := range connection.Routes {
+			if route.Prefix != nil {
+				advertise(*route.Prefix).bgpOnly = false
+			}
+		}
+		for _, bgp := range connection.Bgps {
+			if bgp.Prefix != nil {
+				adv := advertise(*bgp.Prefix)
+				adv.used = adv.used || (bgp.IsUsed != nil && *bgp.IsUsed)
+			}
+		}
+
+		evaluator := newPrefixFilterEvaluator(nil, "")
+		if filtered, ok := filters[reference.ID]; ok {
+			evaluator = newPrefixFilterEvaluator(prefixFiltersFromReferences(filtered.PrefixFilters), stringValue(filtered.PrefixFiltersDefault))
+		}
+		for _, prefix := range connectionPrefixes {
+			adv := byPrefix[prefix]
+			adv.permitted = true
+			if decision, err := evaluator.Evaluate(prefix); err == nil && !decision.Permitted() {
+				adv.permitted = false
+				filtered := FilteredRoute{Connection: reference, Prefix: prefix}
+				if decision.Filter != nil {
+					filtered.FilterID = stringValue(decision.Filter.ID)
+				}
+				analysis.FilteredRoutes = append(analysis.FilteredRoutes, filtered)
+			}
+			if _, ok := advertisements[prefix]; !ok {
+				prefixes = append(prefixes, prefix)
+			}
+			advertisements[prefix] = append(advertisements[prefix], adv)
+		}
+	}
+	sort.Strings(prefixes)
+	for _, prefix := range prefixes {
+		reachable, permitted := false, false
+		unreachable := UnreachablePrefix{Prefix: prefix}
+		for _, adv := range advertisements[prefix] {
+			unreachable.Connections = append(unreachable.Connections, adv.connection)
+			permitted = permitted || adv.permitted
+			reachable = reachable || (adv.permitted && (!adv.bgpOnly || adv.used))
+		}
+		if reachable {
+			continue
+		}
+		unreachable.Reason = UnreachablePrefix_Reason_BgpPathUnused
+		if !permitted {
+			unreachable.Reason = UnreachablePrefix_Reason_Filtered
+		}
+		analysis.UnreachablePrefixes = append(analysis.UnreachablePrefixes, unreachable)
+	}
+	return
+}
+
+// JSON renders the analysis as indented JSON.
+func (analysis *RouteReportAnalysis) JSON() ([]byte, error) {
+	return json.MarshalIndent(analysis, "", "  ")
+}
+
+// String renders the analysis as text for change reviews.
+func (analysis *RouteReportAnalysis) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Route report %s\n", analysis.ReportID)
+
+	fmt.Fprintf(&b, "\nOverlapping routes (%d connection pairs)\n", len(analysis.Overlaps))
+	for _, overlap := range analysis.Overlaps {
+		fmt.Fprintf(&b, "  %s <-> %s\n", overlap.Connections[0], overlap.Connections[1])
+		for _, prefixes := range overlap.Prefixes {
+			fmt.Fprintf(&b, "    %s overlaps %s\n", prefixes[0], prefixes[1])
+		}
+	}
+
+	fmt.Fprintf(&b, "\nFiltered routes (%d)\n", len(analysis.FilteredRoutes))
+	for _, route := range analysis.FilteredRoutes {
+		filter := "default action"
+		if route.FilterID != "" {
+			filter = "prefix filter " + route.FilterID
+		}
+		fmt.Fprintf(&b, "  %s from %s, denied by %s\n", route.Prefix, route.Connection, filter)
+	}
+
+	fmt.Fprintf(&b, "\nUnreachable prefixes (%d)\n", len(analysis.UnreachablePrefixes))
+	for _, prefix := range analysis.UnreachablePrefixes {
+		names := make([]string, len(prefix.Connections))
+		for i, connection := range prefix.Connections {
+			names[i] = connection.String()
+		}
+		fmt.Fprintf(&b, "  %s via %s: %s\n", prefix.Prefix, strings.Join(names, ", "), prefix.Reason)
+	}
+	return b.String()
+}
+
+func describeRouteReportConnection(connection RouteReportConnection) RouteAnalysisConnection {
+	return RouteAnalysisConnection{
+		ID:   stringValue(connection.ID),
+		Name: stringValue(connection.Name),
+		Type: stringValue(connection.Type),
+	}
+}
+
+func routeAnalysisConnection(described map[string]RouteAnalysisConnection, id string) RouteAnalysisConnection {
+	if connection, ok := described[id]; ok {
+		return connection
+	}
+	return RouteAnalysisConnection{ID: id}
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
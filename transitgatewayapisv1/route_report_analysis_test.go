@@ -0,0 +1,169 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transitgatewayapisv1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const routeReportBody = `{
+	"id": "report1", "created_at": "2019-01-01T12:00:00.000Z", "status": "%s",
+	"connections": [
+		{"id": "vpc1", "name": "vpc-east", "type": "vpc", "routes": [{"prefix": "10.1.0.0/16"}, {"prefix": "10.9.0.0/24"}]},
+		{"id": "vpc2", "name": "vpc-west", "type": "vpc", "routes": [{"prefix": "10.1.0.0/16"}, {"prefix": "192.168.0.0/24"}]},
+		{"id": "dl1", "name": "direct-link", "type": "directlink", "bgps": [
+			{"prefix": "172.16.0.0/12", "as_path": "(64999)", "is_used": true},
+			{"prefix": "172.16.8.0/24", "as_path": "(64999)", "is_used": true},
+			{"prefix": "10.1.4.0/24", "as_path": "(64999)", "is_used": false}
+		]}
+	],
+	"overlapping_routes": [
+		{"routes": [{"connection_id": "vpc2", "prefix": "10.1.0.0/16"}, {"connection_id": "vpc1", "prefix": "10.1.0.0/16"}, {"connection_id": "dl1", "prefix": "10.1.4.0/24"}]}
+	]
+}`
+
+const routeReportConnectionsBody = `{"connections": [
+	{"id": "vpc1", "name": "vpc-east", "network_type": "vpc", "created_at": "2019-01-01T12:00:00.000Z", "request_status": "approved", "status": "attached", "updated_at": "2019-01-01T12:00:00.000Z",
+	 "prefix_filters_default": "permit", "prefix_filters": [
+		{"id": "f1", "action": "deny", "prefix": "10.9.0.0/16", "ge": 24, "created_at": "2019-01-01T12:00:00.000Z"}
+	 ]},
+	{"id": "dl1", "name": "direct-link", "network_type": "directlink", "created_at": "2019-01-01T12:00:00.000Z", "request_status": "approved", "status": "attached", "updated_at": "2019-01-01T12:00:00.000Z",
+	 "prefix_filters_default": "deny", "prefix_filters": [
+		{"id": "f2", "action": "permit", "prefix": "172.16.0.0/12", "le": 24, "created_at": "2019-01-01T12:00:00.000Z"},
+		{"id": "f3", "action": "deny", "prefix": "172.16.8.0/24", "before": "f2", "created_at": "2019-01-01T12:01:00.000Z"}
+	 ]}
+], "first": {"href": "https://transit.cloud.ibm.com/v1/transit_gateways/tgw1/connections?limit=50"}, "limit": 50}`
+
+var _ = Describe(`TransitGatewayApisV1 route report analysis`, func() {
+	var testServer *httptest.Server
+	AfterEach(func() {
+		if testServer != nil {
+			testServer.Close()
+		}
+	})
+
+	It(`Analyzes a route report offline`, func() {
+		report := new(transitgatewayapisv1.RouteReport)
+		Expect(json.Unmarshal([]byte(fmt.Sprintf(routeReportBody, "complete")), report)).To(Succeed())
+		var collection transitgatewayapisv1.TransitGatewayConnectionCollection
+		Expect(json.Unmarshal([]byte(routeReportConnectionsBody), &collection)).To(Succeed())
+
+		analysis := transitgatewayapisv1.AnalyzeRouteReport(report, collection.Connections)
+		Expect(analysis.ReportID).To(Equal("report1"))
+		Expect(analysis.Overlaps).To(HaveLen(3))
+		Expect(analysis.Overlaps[0].Connections[0].ID).To(Equal("dl1"))
+		Expect(analysis.Overlaps[0].Connections[1].ID).To(Equal("vpc1"))
+		Expect(analysis.Overlaps[0].Prefixes).To(Equal([][2]string{{"10.1.4.0/24", "10.1.0.0/16"}}))
+		Expect(analysis.Overlaps[2].Connections[0].Name).To(Equal("vpc-east"))
+		Expect(analysis.Overlaps[2].Connections[1].Name).To(Equal("vpc-west"))
+
+		Expect(analysis.String()).To(Equal(`Route report report1
+
+Overlapping routes (3 connection pairs)
+  direct-link <-> vpc-east
+    10.1.4.0/24 overlaps 10.1.0.0/16
+  direct-link <-> vpc-west
+    10.1.4.0/24 overlaps 10.1.0.0/16
+  vpc-east <-> vpc-west
+    10.1.0.0/16 overlaps 10.1.0.0/16
+
+Filtered routes (3)
+  10.9.0.0/24 from vpc-east, denied by prefix filter f1
+  172.16.8.0/24 from direct-link, denied by prefix filter f3
+  10.1.4.0/24 from direct-link, denied by default action
+
+Unreachable prefixes (3)
+  10.1.4.0/24 via direct-link: filtered by every connection advertising it
+  10.9.0.0/24 via vpc-east: filtered by every connection advertising it
+  172.16.8.0/24 via direct-link: filtered by every connection advertising it
+`))
+
+		b, err := analysis.JSON()
+		Expect(err).To(BeNil())
+		var decoded map[string]interface{}
+		Expect(json.Unmarshal(b, &decoded)).To(Succeed())
+		Expect(decoded["unreachable_prefixes"]).To(HaveLen(3))
+		Expect(decoded["filtered_routes"].([]interface{})[2]).To(Equal(map[string]interface{}{
+			"connection": map[string]interface{}{"id": "dl1", "name": "direct-link", "type": "directlink"},
+			"prefix":     "10.1.4.0/24",
+		}))
+	})
+
+	It(`Reports BGP prefixes without a path in use`, func() {
+		report := &transitgatewayapisv1.RouteReport{
+			ID: core.StringPtr("report2"),
+			Connections: []transitgatewayapisv1.RouteReportConnection{{
+				ID:   core.StringPtr("dl1"),
+				Bgps: []transitgatewayapisv1.RouteReportConnectionBgp{{Prefix: core.StringPtr("10.0.0.0/8"), IsUsed: core.BoolPtr(false)}},
+			}},
+		}
+		analysis := transitgatewayapisv1.AnalyzeRouteReport(report, nil)
+		Expect(analysis.FilteredRoutes).To(BeEmpty())
+		Expect(analysis.UnreachablePrefixes).To(HaveLen(1))
+		Expect(analysis.UnreachablePrefixes[0].Reason).To(Equal(transitgatewayapisv1.UnreachablePrefix_Reason_BgpPathUnused))
+	})
+
+	It(`Creates a report, waits for it and analyzes it`, func() {
+		var requests []string
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			requests = append(requests, req.Method+" "+req.URL.Path)
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == "POST":
+				res.WriteHeader(201)
+				fmt.Fprintf(res, routeReportBody, "pending")
+			case req.URL.Path == "/transit_gateways/tgw1/route_reports/report1":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, routeReportBody, "complete")
+			default:
+				res.WriteHeader(200)
+				fmt.Fprint(res, routeReportConnectionsBody)
+			}
+		}))
+		transitGatewayApisService, serviceErr := transitgatewayapisv1.NewTransitGatewayApisV1(&transitgatewayapisv1.TransitGatewayApisV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			Version:       core.StringPtr("2024-01-01"),
+		})
+		Expect(serviceErr).To(BeNil())
+		transitGatewayApisService.SetWaitPolicy(common.NewWaitPolicy().SetInterval(time.Millisecond, time.Millisecond))
+
+		analysis, err := transitGatewayApisService.AnalyzeTransitGatewayRouteReport(transitGatewayApisService.NewAnalyzeTransitGatewayRouteReportOptions("tgw1"))
+		Expect(err).To(BeNil())
+		Expect(analysis.FilteredRoutes).To(HaveLen(3))
+		Expect(requests).To(Equal([]string{
+			"POST /transit_gateways/tgw1/route_reports",
+			"GET /transit_gateways/tgw1/route_reports/report1",
+			"GET /transit_gateways/tgw1/connections",
+		}))
+
+		_, err = transitGatewayApisService.AnalyzeTransitGatewayRouteReport(nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
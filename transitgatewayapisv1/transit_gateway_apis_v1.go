@@ -44,6 +44,9 @@ type TransitGatewayApisV1 struct {
 	// Requests the version of the API as of a date in the format `YYYY-MM-DD`. Any date up to the current date may be
 	// provided. Specify the current date to request the latest version.
 	Version *string
+
+	// How the waiters poll, or nil for the defaults of common.NewWaitPolicy.
+	WaitPolicy *common.WaitPolicy
 }
 
 // DefaultServiceURL is the default URL to make service requests to.
@@ -186,6 +189,12 @@ func (transitGatewayApis *TransitGatewayApisV1) DisableRetries() {
 	transitGatewayApis.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (transitGatewayApis *TransitGatewayApisV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(transitGatewayApis.Service, policy)
+}
+
 // ListTransitGateways : Retrieves all Transit Gateways
 // List all Transit Gateways in account the caller is authorized to view.
 func (transitGatewayApis *TransitGatewayApisV1) ListTransitGateways(listTransitGatewaysOptions *ListTransitGatewaysOptions) (result *TransitGatewayCollection, response *core.DetailedResponse, err error) {
@@ -238,6 +247,7 @@ func (transitGatewayApis *TransitGatewayApisV1) ListTransitGatewaysWithContext(c
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_transit_gateways", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -328,6 +338,7 @@ func (transitGatewayApis *TransitGatewayApisV1) CreateTransitGatewayWithContext(
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_transit_gateway", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -398,6 +409,7 @@ func (transitGatewayApis *TransitGatewayApisV1) DeleteTransitGatewayWithContext(
 
 	response, err = transitGatewayApis.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_transit_gateway", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -461,6 +473,7 @@ func (transitGatewayApis *TransitGatewayApisV1) GetTransitGatewayWithContext(ctx
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_transit_gateway", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -549,6 +562,7 @@ func (transitGatewayApis *TransitGatewayApisV1) UpdateTransitGatewayWithContext(
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_transit_gateway", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -623,6 +637,7 @@ func (transitGatewayApis *TransitGatewayApisV1) ListConnectionsWithContext(ctx c
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_connections", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -703,6 +718,7 @@ func (transitGatewayApis *TransitGatewayApisV1) ListTransitGatewayConnectionsWit
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_transit_gateway_connections", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -830,6 +846,7 @@ func (transitGatewayApis *TransitGatewayApisV1) CreateTransitGatewayConnectionWi
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_transit_gateway_connection", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -901,6 +918,7 @@ func (transitGatewayApis *TransitGatewayApisV1) DeleteTransitGatewayConnectionWi
 
 	response, err = transitGatewayApis.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_transit_gateway_connection", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -965,6 +983,7 @@ func (transitGatewayApis *TransitGatewayApisV1) GetTransitGatewayConnectionWithC
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_transit_gateway_connection", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1051,6 +1070,7 @@ func (transitGatewayApis *TransitGatewayApisV1) UpdateTransitGatewayConnectionWi
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_transit_gateway_connection", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1132,6 +1152,7 @@ func (transitGatewayApis *TransitGatewayApisV1) CreateTransitGatewayConnectionAc
 
 	response, err = transitGatewayApis.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_transit_gateway_connection_actions", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1196,6 +1217,7 @@ func (transitGatewayApis *TransitGatewayApisV1) ListTransitGatewayGreTunnelWithC
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_transit_gateway_gre_tunnel", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1297,6 +1319,7 @@ func (transitGatewayApis *TransitGatewayApisV1) CreateTransitGatewayGreTunnelWit
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_transit_gateway_gre_tunnel", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1368,6 +1391,7 @@ func (transitGatewayApis *TransitGatewayApisV1) DeleteTransitGatewayConnectionTu
 
 	response, err = transitGatewayApis.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_transit_gateway_connection_tunnels", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1433,6 +1457,7 @@ func (transitGatewayApis *TransitGatewayApisV1) GetTransitGatewayConnectionTunne
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_transit_gateway_connection_tunnels", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1513,6 +1538,7 @@ func (transitGatewayApis *TransitGatewayApisV1) UpdateTransitGatewayConnectionTu
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_transit_gateway_connection_tunnels", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1575,6 +1601,7 @@ func (transitGatewayApis *TransitGatewayApisV1) ListGatewayLocationsWithContext(
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_gateway_locations", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1646,6 +1673,7 @@ func (transitGatewayApis *TransitGatewayApisV1) GetGatewayLocationWithContext(ct
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_gateway_location", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1718,6 +1746,7 @@ func (transitGatewayApis *TransitGatewayApisV1) ListTransitGatewayConnectionPref
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_transit_gateway_connection_prefix_filters", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1815,6 +1844,7 @@ func (transitGatewayApis *TransitGatewayApisV1) CreateTransitGatewayConnectionPr
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_transit_gateway_connection_prefix_filter", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1886,6 +1916,7 @@ func (transitGatewayApis *TransitGatewayApisV1) DeleteTransitGatewayConnectionPr
 
 	response, err = transitGatewayApis.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_transit_gateway_connection_prefix_filter", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1951,6 +1982,7 @@ func (transitGatewayApis *TransitGatewayApisV1) GetTransitGatewayConnectionPrefi
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_transit_gateway_connection_prefix_filter", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2047,6 +2079,7 @@ func (transitGatewayApis *TransitGatewayApisV1) UpdateTransitGatewayConnectionPr
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_transit_gateway_connection_prefix_filter", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2119,6 +2152,7 @@ func (transitGatewayApis *TransitGatewayApisV1) ListTransitGatewayRouteReportsWi
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "list_transit_gateway_route_reports", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2191,6 +2225,7 @@ func (transitGatewayApis *TransitGatewayApisV1) CreateTransitGatewayRouteReportW
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "create_transit_gateway_route_report", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2261,6 +2296,7 @@ func (transitGatewayApis *TransitGatewayApisV1) DeleteTransitGatewayRouteReportW
 
 	response, err = transitGatewayApis.Service.Request(request, nil)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "delete_transit_gateway_route_report", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2325,6 +2361,7 @@ func (transitGatewayApis *TransitGatewayApisV1) GetTransitGatewayRouteReportWith
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_transit_gateway_route_report", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -29,6 +29,7 @@ import (
 	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/common"
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
 	"github.com/go-openapi/strfmt"
 	. "github.com/onsi/ginkgo"
@@ -1084,6 +1085,53 @@ var _ = Describe(`TransitGatewayApisV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint with an error response`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(getTransitGatewayPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(404)
+					fmt.Fprintf(res, "%s", `{"errors": [{"code": "not_found", "message": "Transit gateway not found.", "more_info": "https://cloud.ibm.com/docs/transit-gateway", "target": {"name": "id", "type": "parameter"}}], "trace": "a1b2c3d4"}`)
+				}))
+			})
+			It(`Invoke GetTransitGateway with error: typed API error`, func() {
+				transitGatewayApisService, serviceErr := transitgatewayapisv1.NewTransitGatewayApisV1(&transitgatewayapisv1.TransitGatewayApisV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+					Version:       core.StringPtr(version),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(transitGatewayApisService).ToNot(BeNil())
+
+				// Construct an instance of the GetTransitGatewayOptions model
+				getTransitGatewayOptionsModel := new(transitgatewayapisv1.GetTransitGatewayOptions)
+				getTransitGatewayOptionsModel.ID = core.StringPtr("testString")
+
+				result, response, operationErr := transitGatewayApisService.GetTransitGateway(getTransitGatewayOptionsModel)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).To(BeNil())
+				Expect(common.IsNotFound(operationErr)).To(BeTrue())
+
+				apiErr, ok := common.AsAPIError(operationErr)
+				Expect(ok).To(BeTrue())
+				Expect(apiErr.StatusCode).To(Equal(404))
+				Expect(apiErr.Code).To(Equal("not_found"))
+				Expect(apiErr.Message).To(Equal("Transit gateway not found."))
+				Expect(apiErr.Target).To(Equal("id"))
+				Expect(apiErr.Trace).To(Equal("a1b2c3d4"))
+				Expect(apiErr.OperationID).To(Equal("get_transit_gateway"))
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
 	})
 	Describe(`UpdateTransitGateway(updateTransitGatewayOptions *UpdateTransitGatewayOptions) - Operation response error`, func() {
 		version := "testString"
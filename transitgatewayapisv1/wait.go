@@ -0,0 +1,181 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transitgatewayapisv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+)
+
+// SetWaitPolicy sets how the waiters of this service instance poll, including their timeout. Passing nil restores
+// the defaults of common.NewWaitPolicy.
+func (transitGatewayApis *TransitGatewayApisV1) SetWaitPolicy(policy *common.WaitPolicy) {
+	transitGatewayApis.WaitPolicy = policy
+}
+
+// WaitForTransitGatewayStatus : Wait for a transit gateway status
+// Poll the transit gateway until its status is one of the target statuses and return it. If the transit gateway
+// fails while "failed" is not a target, or the wait ends first, a *common.WaitError carrying the last status is
+// returned together with the last transit gateway polled.
+func (transitGatewayApis *TransitGatewayApisV1) WaitForTransitGatewayStatus(ctx context.Context, id string, targetStatuses ...string) (result *TransitGateway, err error) {
+	getTransitGatewayOptions := transitGatewayApis.NewGetTransitGatewayOptions(id)
+	err = transitGatewayApis.waitFor(ctx, "transit gateway "+id, targetStatuses, func(ctx context.Context) (status *string, err error) {
+		result, _, err = transitGatewayApis.GetTransitGatewayWithContext(ctx, getTransitGatewayOptions)
+		if result != nil {
+			status = result.Status
+		}
+		return
+	})
+	return
+}
+
+// WaitForTransitGatewayDeleted : Wait for a transit gateway to be deleted
+// Poll the transit gateway until it no longer exists.
+func (transitGatewayApis *TransitGatewayApisV1) WaitForTransitGatewayDeleted(ctx context.Context, id string) (err error) {
+	getTransitGatewayOptions := transitGatewayApis.NewGetTransitGatewayOptions(id)
+	err = transitGatewayApis.waitForDeletion(ctx, "transit gateway "+id, func(ctx context.Context) (status *string, err error) {
+		result, _, err := transitGatewayApis.GetTransitGatewayWithContext(ctx, getTransitGatewayOptions)
+		if result != nil {
+			status = result.Status
+		}
+		return
+	})
+	return
+}
+
+// WaitForTransitGatewayConnectionStatus : Wait for a connection status
+// Poll the transit gateway connection until its status is one of the target statuses, e.g. attached, and return it.
+// If the connection fails while "failed" is not a target, or the wait ends first, a *common.WaitError carrying the
+// last status is returned together with the last connection polled.
+func (transitGatewayApis *TransitGatewayApisV1) WaitForTransitGatewayConnectionStatus(ctx context.Context, transitGatewayID string, id string, targetStatuses ...string) (result *TransitGatewayConnectionCust, err error) {
+	getTransitGatewayConnectionOptions := transitGatewayApis.NewGetTransitGatewayConnectionOptions(transitGatewayID, id)
+	err = transitGatewayApis.waitFor(ctx, "connection "+id+" of transit gateway "+transitGatewayID, targetStatuses, func(ctx context.Context) (status *string, err error) {
+		result, _, err = transitGatewayApis.GetTransitGatewayConnectionWithContext(ctx, getTransitGatewayConnectionOptions)
+		if result != nil {
+			status = result.Status
+		}
+		return
+	})
+	return
+}
+
+// WaitForTransitGatewayConnectionDeleted : Wait for a connection to be deleted
+// Poll the transit gateway connection until it no longer exists, e.g. before creating a connection with the same name.
+func (transitGatewayApis *TransitGatewayApisV1) WaitForTransitGatewayConnectionDeleted(ctx context.Context, transitGatewayID string, id string) (err error) {
+	getTransitGatewayConnectionOptions := transitGatewayApis.NewGetTransitGatewayConnectionOptions(transitGatewayID, id)
+	err = transitGatewayApis.waitForDeletion(ctx, "connection "+id+" of transit gateway "+transitGatewayID, func(ctx context.Context) (status *string, err error) {
+		result, _, err := transitGatewayApis.GetTransitGatewayConnectionWithContext(ctx, getTransitGatewayConnectionOptions)
+		if result != nil {
+			status = result.Status
+		}
+		return
+	})
+	return
+}
+
+// WaitForTransitGatewayGreTunnelStatus : Wait for a GRE tunnel status
+// Poll the GRE tunnel of a connection until its status is one of the target statuses, e.g. attached, and return it.
+// If the tunnel fails while "failed" is not a target, or the wait ends first, a *common.WaitError carrying the last
+// status is returned together with the last tunnel polled.
+func (transitGatewayApis *TransitGatewayApisV1) WaitForTransitGatewayGreTunnelStatus(ctx context.Context, transitGatewayID string, id string, greTunnelID string, targetStatuses ...string) (result *TransitGatewayTunnel, err error) {
+	getTransitGatewayConnectionTunnelsOptions := transitGatewayApis.NewGetTransitGatewayConnectionTunnelsOptions(transitGatewayID, id, greTunnelID)
+	err = transitGatewayApis.waitFor(ctx, "GRE tunnel "+greTunnelID+" of connection "+id, targetStatuses, func(ctx context.Context) (status *string, err error) {
+		result, _, err = transitGatewayApis.GetTransitGatewayConnectionTunnelsWithContext(ctx, getTransitGatewayConnectionTunnelsOptions)
+		if result != nil {
+			status = result.Status
+		}
+		return
+	})
+	return
+}
+
+// WaitForTransitGatewayGreTunnelDeleted : Wait for a GRE tunnel to be deleted
+// Poll the GRE tunnel of a connection until it no longer exists.
+func (transitGatewayApis *TransitGatewayApisV1) WaitForTransitGatewayGreTunnelDeleted(ctx context.Context, transitGatewayID string, id string, greTunnelID string) (err error) {
+	getTransitGatewayConnectionTunnelsOptions := transitGatewayApis.NewGetTransitGatewayConnectionTunnelsOptions(transitGatewayID, id, greTunnelID)
+	err = transitGatewayApis.waitForDeletion(ctx, "GRE tunnel "+greTunnelID+" of connection "+id, func(ctx context.Context) (status *string, err error) {
+		result, _, err := transitGatewayApis.GetTransitGatewayConnectionTunnelsWithContext(ctx, getTransitGatewayConnectionTunnelsOptions)
+		if result != nil {
+			status = result.Status
+		}
+		return
+	})
+	return
+}
+
+// WaitForTransitGatewayRouteReport : Wait for a route report
+// Poll the route report until it is complete and return it. If the report fails, or the wait ends first, a
+// *common.WaitError carrying the last status is returned together with the last report polled.
+func (transitGatewayApis *TransitGatewayApisV1) WaitForTransitGatewayRouteReport(ctx context.Context, transitGatewayID string, id string) (result *RouteReport, err error) {
+	getTransitGatewayRouteReportOptions := transitGatewayApis.NewGetTransitGatewayRouteReportOptions(transitGatewayID, id)
+	err = transitGatewayApis.waitFor(ctx, "route report "+id+" of transit gateway "+transitGatewayID, []string{RouteReport_Status_Complete}, func(ctx context.Context) (status *string, err error) {
+		result, _, err = transitGatewayApis.GetTransitGatewayRouteReportWithContext(ctx, getTransitGatewayRouteReportOptions)
+		if result != nil {
+			status = result.Status
+		}
+		return
+	})
+	return
+}
+
+// waitFor polls "get" until the resource reaches one of "targetStatuses".
+// The "failed" status ends the wait unless it is a target.
+func (transitGatewayApis *TransitGatewayApisV1) waitFor(ctx context.Context, resource string, targetStatuses []string, get func(ctx context.Context) (*string, error)) (err error) {
+	if len(targetStatuses) == 0 {
+		err = core.SDKErrorf(nil, "at least one target status must be given", "missing-target-status", common.GetComponentInfo())
+		return
+	}
+	err = common.Wait(ctx, transitGatewayApis.WaitPolicy, resource, func(ctx context.Context) (status common.WaitStatus, err error) {
+		current, err := get(ctx)
+		if err != nil {
+			return
+		}
+		if current != nil {
+			status.Status = *current
+		}
+		for _, targetStatus := range targetStatuses {
+			status.Done = status.Done || status.Status == targetStatus
+		}
+		status.Failed = !status.Done && status.Status == TransitGateway_Status_Failed
+		return
+	})
+	err = core.RepurposeSDKProblem(err, "wait-error")
+	return
+}
+
+// waitForDeletion polls "get" until it reports that the resource is not
+// found. The "failed" status ends the wait.
+func (transitGatewayApis *TransitGatewayApisV1) waitForDeletion(ctx context.Context, resource string, get func(ctx context.Context) (*string, error)) (err error) {
+	err = common.Wait(ctx, transitGatewayApis.WaitPolicy, resource, func(ctx context.Context) (status common.WaitStatus, err error) {
+		current, err := get(ctx)
+		if err != nil {
+			if common.IsNotFound(err) {
+				return common.WaitStatus{Done: true}, nil
+			}
+			return
+		}
+		if current != nil {
+			status.Status = *current
+		}
+		status.Failed = status.Status == TransitGateway_Status_Failed
+		return
+	})
+	err = core.RepurposeSDKProblem(err, "wait-error")
+	return
+}
@@ -0,0 +1,157 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transitgatewayapisv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`TransitGatewayApisV1 waiters`, func() {
+	var testServer *httptest.Server
+	var statuses []string
+	var paths []string
+	var transitGatewayApisService *transitgatewayapisv1.TransitGatewayApisV1
+
+	// serve answers each poll with the next of "statuses", repeating the last
+	// one; the status "gone" answers 404.
+	serve := func(body string) {
+		paths = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			status := statuses[len(statuses)-1]
+			if len(paths) < len(statuses) {
+				status = statuses[len(paths)]
+			}
+			paths = append(paths, req.URL.Path)
+			res.Header().Set("Content-type", "application/json")
+			if status == "gone" {
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "Not found"}], "trace": "abc"}`)
+				return
+			}
+			res.WriteHeader(200)
+			fmt.Fprintf(res, body, status)
+		}))
+		var serviceErr error
+		transitGatewayApisService, serviceErr = transitgatewayapisv1.NewTransitGatewayApisV1(&transitgatewayapisv1.TransitGatewayApisV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			Version:       core.StringPtr("2024-01-01"),
+		})
+		Expect(serviceErr).To(BeNil())
+		transitGatewayApisService.SetWaitPolicy(common.NewWaitPolicy().SetInterval(time.Millisecond, 4*time.Millisecond))
+	}
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	Describe(`WaitForTransitGatewayConnectionStatus(ctx context.Context, transitGatewayID string, id string, targetStatuses ...string)`, func() {
+		const connectionBody = `{"id": "conn1", "name": "vpc-conn", "network_type": "vpc", "created_at": "2019-01-01T12:00:00.000Z", "status": "%s"}`
+
+		It(`Returns the connection once it is attached`, func() {
+			statuses = []string{"pending", "pending", "attached"}
+			serve(connectionBody)
+			result, err := transitGatewayApisService.WaitForTransitGatewayConnectionStatus(context.Background(), "tgw1", "conn1", transitgatewayapisv1.TransitGatewayConnectionCust_Status_Attached)
+			Expect(err).To(BeNil())
+			Expect(*result.Status).To(Equal("attached"))
+			Expect(paths).To(HaveLen(3))
+			Expect(paths[0]).To(Equal("/transit_gateways/tgw1/connections/conn1"))
+		})
+		It(`Reports a failed connection`, func() {
+			statuses = []string{"pending", "failed"}
+			serve(connectionBody)
+			_, err := transitGatewayApisService.WaitForTransitGatewayConnectionStatus(context.Background(), "tgw1", "conn1", transitgatewayapisv1.TransitGatewayConnectionCust_Status_Attached)
+			var waitErr *common.WaitError
+			Expect(errors.As(err, &waitErr)).To(BeTrue())
+			Expect(waitErr.Failed).To(BeTrue())
+			Expect(waitErr.Status).To(Equal("failed"))
+		})
+		It(`Carries the last status when the timeout passes`, func() {
+			statuses = []string{"pending"}
+			serve(connectionBody)
+			transitGatewayApisService.SetWaitPolicy(common.NewWaitPolicy().SetInterval(time.Millisecond, time.Millisecond).SetTimeout(20 * time.Millisecond))
+			_, err := transitGatewayApisService.WaitForTransitGatewayConnectionStatus(context.Background(), "tgw1", "conn1", transitgatewayapisv1.TransitGatewayConnectionCust_Status_Attached)
+			var waitErr *common.WaitError
+			Expect(errors.As(err, &waitErr)).To(BeTrue())
+			Expect(waitErr.Status).To(Equal("pending"))
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		})
+		It(`Waits for the connection to be deleted`, func() {
+			statuses = []string{"deleting", "deleting", "gone"}
+			serve(connectionBody)
+			err := transitGatewayApisService.WaitForTransitGatewayConnectionDeleted(context.Background(), "tgw1", "conn1")
+			Expect(err).To(BeNil())
+			Expect(paths).To(HaveLen(3))
+		})
+	})
+
+	Describe(`WaitForTransitGatewayGreTunnelStatus(ctx context.Context, transitGatewayID string, id string, greTunnelID string, targetStatuses ...string)`, func() {
+		const tunnelBody = `{"id": "tun1", "name": "gre1", "base_network_type": "classic", "created_at": "2019-01-01T12:00:00.000Z", "local_bgp_asn": 64490, "local_gateway_ip": "192.168.100.20", "local_tunnel_ip": "192.168.129.2", "mtu": 9000, "remote_bgp_asn": 65010, "remote_gateway_ip": "10.242.63.12", "remote_tunnel_ip": "192.168.129.1", "status": "%s", "zone": {"name": "us-south-1"}}`
+
+		It(`Returns the tunnel once it is attached`, func() {
+			statuses = []string{"pending", "attached"}
+			serve(tunnelBody)
+			result, err := transitGatewayApisService.WaitForTransitGatewayGreTunnelStatus(context.Background(), "tgw1", "conn1", "tun1", transitgatewayapisv1.TransitGatewayTunnel_Status_Attached)
+			Expect(err).To(BeNil())
+			Expect(*result.Status).To(Equal("attached"))
+			Expect(paths[0]).To(Equal("/transit_gateways/tgw1/connections/conn1/tunnels/tun1"))
+		})
+		It(`Waits for the tunnel to be deleted`, func() {
+			statuses = []string{"deleting", "gone"}
+			serve(tunnelBody)
+			err := transitGatewayApisService.WaitForTransitGatewayGreTunnelDeleted(context.Background(), "tgw1", "conn1", "tun1")
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Describe(`WaitForTransitGatewayStatus(ctx context.Context, id string, targetStatuses ...string)`, func() {
+		const gatewayBody = `{"id": "tgw1", "crn": "crn", "name": "my-tgw", "location": "us-south", "created_at": "2019-01-01T12:00:00.000Z", "global": true, "status": "%s"}`
+
+		It(`Returns the transit gateway once it is available`, func() {
+			statuses = []string{"pending", "available"}
+			serve(gatewayBody)
+			result, err := transitGatewayApisService.WaitForTransitGatewayStatus(context.Background(), "tgw1", transitgatewayapisv1.TransitGateway_Status_Available)
+			Expect(err).To(BeNil())
+			Expect(*result.Status).To(Equal("available"))
+		})
+		It(`Requires a target status`, func() {
+			_, err := transitGatewayApisService.WaitForTransitGatewayStatus(context.Background(), "tgw1")
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(Equal("at least one target status must be given"))
+		})
+		It(`Stops waiting for deletion when the transit gateway fails`, func() {
+			statuses = []string{"deleting", "failed"}
+			serve(gatewayBody)
+			err := transitGatewayApisService.WaitForTransitGatewayDeleted(context.Background(), "tgw1")
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(Equal(`transit gateway tgw1 reached failure status "failed"`))
+		})
+	})
+})
@@ -0,0 +1,120 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transitgatewaytopology
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+)
+
+// nodeShapes are the Graphviz shapes of the node kinds; other kinds are boxes.
+var nodeShapes = map[string]string{
+	Node_Kind_TransitGateway:     "doubleoctagon",
+	Node_Kind_Vpc:                "box",
+	Node_Kind_Classic:            "box3d",
+	Node_Kind_Directlink:         "cds",
+	Node_Kind_PowerVirtualServer: "component",
+	Node_Kind_VpnGateway:         "house",
+	Node_Kind_GreTunnel:          "hexagon",
+	Node_Kind_UnboundGreTunnel:   "hexagon",
+	Node_Kind_RedundantGre:       "hexagon",
+	Node_Kind_Tunnel:             "ellipse",
+}
+
+// DOT renders the topology as a Graphviz digraph. Nodes are labelled with their name, kind and location or zone;
+// edges with the connection or tunnel name and any status other than attached. Tunnel edges are dotted, base edges
+// dashed, and edges of failed connections red.
+func (topology *Topology) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph topology {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [fontname=\"Helvetica\", fontsize=10];\n")
+	b.WriteString("  edge [fontname=\"Helvetica\", fontsize=9];\n")
+
+	for _, node := range topology.Nodes {
+		shape, ok := nodeShapes[node.Kind]
+		if !ok {
+			shape = "box"
+		}
+		lines := []string{node.Name, node.Kind}
+		if node.Name == "" || node.Name == node.Kind {
+			lines = []string{node.Kind}
+		}
+		if node.Location != "" {
+			lines = append(lines, node.Location)
+		}
+		if node.Zone != "" {
+			lines = append(lines, node.Zone)
+		}
+		if node.Status != "" && !healthyStatus(node.Status) {
+			lines = append(lines, node.Status)
+		}
+		fmt.Fprintf(&b, "  %s [label=%s, shape=%s];\n", dotID(node.ID), dotLabel(lines), shape)
+	}
+
+	for _, edge := range topology.Edges {
+		var lines []string
+		if edge.Name != "" {
+			lines = append(lines, edge.Name)
+		}
+		if edge.Status != "" && !healthyStatus(edge.Status) {
+			lines = append(lines, edge.Status)
+		}
+		fmt.Fprintf(&b, "  %s -> %s [label=%s", dotID(edge.From), dotID(edge.To), dotLabel(lines))
+		switch edge.Kind {
+		case Edge_Kind_Tunnel:
+			b.WriteString(", style=dotted")
+		case Edge_Kind_Base:
+			b.WriteString(", style=dashed, arrowhead=none")
+		}
+		if edge.Status == transitgatewayapisv1.TransitGatewayConnectionCust_Status_Failed {
+			b.WriteString(", color=red")
+		}
+		b.WriteString("];\n")
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// healthyStatus tells whether a status is not worth showing on the picture.
+func healthyStatus(status string) bool {
+	switch status {
+	case transitgatewayapisv1.TransitGateway_Status_Available,
+		transitgatewayapisv1.TransitGatewayConnectionCust_Status_Attached,
+		directlinkv1.GatewayCollectionGatewaysItem_OperationalStatus_Provisioned:
+		return true
+	}
+	return false
+}
+
+// dotID quotes a string as a Graphviz ID.
+func dotID(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + replacer.Replace(value) + `"`
+}
+
+// dotLabel quotes lines as a Graphviz label.
+func dotLabel(lines []string) string {
+	quoted := make([]string, len(lines))
+	for i, line := range lines {
+		quoted[i] = strings.TrimSuffix(strings.TrimPrefix(dotID(line), `"`), `"`)
+	}
+	return `"` + strings.Join(quoted, `\n`) + `"`
+}
@@ -0,0 +1,531 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package transitgatewaytopology draws the networks connected to transit
+// gateways.
+//
+// A Builder lists the transit gateways with the TransitGatewaysPager and the
+// connections of each one with the TransitGatewayConnectionsPager, and turns
+// them into a Topology: a graph whose nodes are the transit gateways, the
+// networks they connect (VPCs, classic infrastructure, Direct Link gateways,
+// PowerVS workspaces, VPN gateways and GRE tunnels) and the tunnels of
+// redundant GRE and VPN gateway connections, and whose edges are the
+// connections. Networks connected to several transit gateways are a single
+// node. When a Direct Link client is configured, the Direct Link gateways of
+// the account are merged in, including the ones no transit gateway connects.
+// A Topology renders as Graphviz DOT or JSON.
+package transitgatewaytopology
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+)
+
+// Constants associated with the Node.Kind property.
+// Networks connected to a transit gateway have the kind of their network_type.
+const (
+	Node_Kind_Classic            = transitgatewayapisv1.TransitGatewayConnectionCust_NetworkType_Classic
+	Node_Kind_Directlink         = transitgatewayapisv1.TransitGatewayConnectionCust_NetworkType_Directlink
+	Node_Kind_GreTunnel          = transitgatewayapisv1.TransitGatewayConnectionCust_NetworkType_GreTunnel
+	Node_Kind_PowerVirtualServer = transitgatewayapisv1.TransitGatewayConnectionCust_NetworkType_PowerVirtualServer
+	Node_Kind_RedundantGre       = transitgatewayapisv1.TransitGatewayConnectionCust_NetworkType_RedundantGre
+	Node_Kind_TransitGateway     = "transit_gateway"
+	Node_Kind_Tunnel             = "tunnel"
+	Node_Kind_UnboundGreTunnel   = transitgatewayapisv1.TransitGatewayConnectionCust_NetworkType_UnboundGreTunnel
+	Node_Kind_Vpc                = transitgatewayapisv1.TransitGatewayConnectionCust_NetworkType_Vpc
+	Node_Kind_VpnGateway         = transitgatewayapisv1.TransitGatewayConnectionCust_NetworkType_VpnGateway
+)
+
+// Constants associated with the Edge.Kind property.
+// A connection joins a transit gateway to a network, a tunnel joins a redundant GRE or VPN gateway connection to one
+// of its tunnels, and a base edge joins a GRE tunnel to the network it is configured over.
+const (
+	Edge_Kind_Base       = "base"
+	Edge_Kind_Connection = "connection"
+	Edge_Kind_Tunnel     = "tunnel"
+)
+
+// Builder builds the topology of the transit gateways of an account.
+type Builder struct {
+	Service *transitgatewayapisv1.TransitGatewayApisV1
+	Options *BuilderOptions
+}
+
+// BuilderOptions : The options of a Builder.
+type BuilderOptions struct {
+	// The Direct Link client used to merge the Direct Link gateways of the account into the topology. By default the
+	// Direct Link gateways are only known from the transit gateway connections.
+	DirectLink *directlinkv1.DirectLinkV1
+
+	// The IDs of the transit gateways to draw. By default all the transit gateways of the account.
+	TransitGatewayIDs []string
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewBuilderOptions : Instantiate BuilderOptions
+func NewBuilderOptions() *BuilderOptions {
+	return &BuilderOptions{}
+}
+
+// SetDirectLink : Allow user to set DirectLink
+func (_options *BuilderOptions) SetDirectLink(directLink *directlinkv1.DirectLinkV1) *BuilderOptions {
+	_options.DirectLink = directLink
+	return _options
+}
+
+// SetTransitGatewayIDs : Allow user to set TransitGatewayIDs
+func (_options *BuilderOptions) SetTransitGatewayIDs(transitGatewayIDs []string) *BuilderOptions {
+	_options.TransitGatewayIDs = transitGatewayIDs
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (_options *BuilderOptions) SetHeaders(param map[string]string) *BuilderOptions {
+	_options.Headers = param
+	return _options
+}
+
+// NewBuilder returns a Builder drawing the transit gateways visible to "service". A nil "options" uses the defaults.
+func NewBuilder(service *transitgatewayapisv1.TransitGatewayApisV1, options *BuilderOptions) (builder *Builder, err error) {
+	if service == nil {
+		err = core.SDKErrorf(nil, "the Transit Gateway client is required", "missing-client", common.GetComponentInfo())
+		return
+	}
+	if options == nil {
+		options = NewBuilderOptions()
+	}
+	builder = &Builder{Service: service, Options: options}
+	return
+}
+
+// Topology : The transit gateways of an account and the networks they connect.
+type Topology struct {
+	// The transit gateways first, then the networks and tunnels in the order their connections were listed.
+	Nodes []*Node `json:"nodes"`
+
+	// The connections, tunnels and GRE tunnel bases.
+	Edges []*Edge `json:"edges"`
+}
+
+// Node : A transit gateway, a connected network or a tunnel.
+type Node struct {
+	// The ID of a transit gateway, connection or tunnel, or the CRN of a VPC, PowerVS workspace, VPN gateway or Direct
+	// Link gateway. The classic infrastructure of an account is "classic", or "classic/<account ID>" for another
+	// account.
+	ID string `json:"id"`
+
+	// The kind of node, one of the Node_Kind_* constants.
+	Kind string `json:"kind"`
+
+	Name     string `json:"name,omitempty"`
+	Status   string `json:"status,omitempty"`
+	Location string `json:"location,omitempty"`
+	Zone     string `json:"zone,omitempty"`
+
+	// Further details, such as the tunnel addresses and BGP ASNs.
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// Edge : A connection between two nodes.
+type Edge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+
+	// The kind of edge, one of the Edge_Kind_* constants.
+	Kind string `json:"kind"`
+
+	// The transit gateway connection the edge belongs to.
+	ConnectionID string `json:"connection_id,omitempty"`
+
+	Name   string `json:"name,omitempty"`
+	Status string `json:"status,omitempty"`
+	Zone   string `json:"zone,omitempty"`
+
+	// Further details, such as the account of a cross-account connection.
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// Build : Build the topology
+// List the transit gateways and their connections and, with a Direct Link client configured, the Direct Link
+// gateways, and return their topology.
+func (builder *Builder) Build() (result *Topology, err error) {
+	result, err = builder.BuildWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// BuildWithContext is an alternate form of the Build method which supports a Context parameter
+func (builder *Builder) BuildWithContext(ctx context.Context) (result *Topology, err error) {
+	headers := builder.Options.Headers
+	gatewaysPager, err := builder.Service.NewTransitGatewaysPager(&transitgatewayapisv1.ListTransitGatewaysOptions{Headers: headers})
+	if err != nil {
+		return
+	}
+	gateways, err := gatewaysPager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-transit-gateways-error")
+		return
+	}
+
+	result = &Topology{Nodes: []*Node{}, Edges: []*Edge{}}
+	for _, gateway := range gateways {
+		if len(builder.Options.TransitGatewayIDs) > 0 && !containsString(builder.Options.TransitGatewayIDs, stringValue(gateway.ID)) {
+			continue
+		}
+		var connectionsPager *transitgatewayapisv1.TransitGatewayConnectionsPager
+		connectionsPager, err = builder.Service.NewTransitGatewayConnectionsPager(&transitgatewayapisv1.ListTransitGatewayConnectionsOptions{
+			TransitGatewayID: gateway.ID,
+			Headers:          headers,
+		})
+		if err != nil {
+			return
+		}
+		var connections []transitgatewayapisv1.TransitGatewayConnectionCust
+		connections, err = connectionsPager.GetAllWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "list-connections-error")
+			return
+		}
+		result.AddTransitGateway(gateway, connections)
+	}
+
+	if builder.Options.DirectLink != nil {
+		var collection *directlinkv1.GatewayCollection
+		collection, _, err = builder.Options.DirectLink.ListGatewaysWithContext(ctx, &directlinkv1.ListGatewaysOptions{Headers: headers})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "list-direct-link-gateways-error")
+			return
+		}
+		result.MergeDirectLinkGateways(collection.Gateways)
+	}
+	return
+}
+
+// AddTransitGateway adds a transit gateway, the networks its connections join and the connections to the topology.
+// A network already in the topology, such as a VPC connected to another transit gateway, is not added again.
+func (topology *Topology) AddTransitGateway(gateway transitgatewayapisv1.TransitGateway, connections []transitgatewayapisv1.TransitGatewayConnectionCust) {
+	gatewayID := stringValue(gateway.ID)
+	topology.addNode(&Node{
+		ID:         gatewayID,
+		Kind:       Node_Kind_TransitGateway,
+		Name:       stringValue(gateway.Name),
+		Status:     stringValue(gateway.Status),
+		Location:   stringValue(gateway.Location),
+		Attributes: attributes("crn", stringValue(gateway.Crn), "global", boolString(gateway.Global)),
+	})
+
+	// GRE tunnels name the classic connection they are configured over, which may be listed after them.
+	networkIDs := map[string]string{}
+	for _, connection := range connections {
+		networkIDs[stringValue(connection.ID)] = connectionNetworkID(connection)
+	}
+
+	for _, connection := range connections {
+		connectionID := stringValue(connection.ID)
+		networkType := stringValue(connection.NetworkType)
+		network := &Node{
+			ID:   networkIDs[connectionID],
+			Kind: networkType,
+			Name: stringValue(connection.Name),
+		}
+		switch networkType {
+		case Node_Kind_Classic:
+			network.Name = Node_Kind_Classic
+			if connection.NetworkAccountID != nil {
+				network.Attributes = attributes("account_id", *connection.NetworkAccountID)
+			}
+		case Node_Kind_GreTunnel, Node_Kind_UnboundGreTunnel, Node_Kind_RedundantGre:
+			network.Status = stringValue(connection.Status)
+			network.Zone = zoneName(connection.Zone)
+			network.Attributes = attributes(
+				"base_network_type", stringValue(connection.BaseNetworkType),
+				"local_gateway_ip", stringValue(connection.LocalGatewayIp),
+				"remote_gateway_ip", stringValue(connection.RemoteGatewayIp),
+				"local_tunnel_ip", stringValue(connection.LocalTunnelIp),
+				"remote_tunnel_ip", stringValue(connection.RemoteTunnelIp),
+				"local_bgp_asn", int64String(connection.LocalBgpAsn),
+				"remote_bgp_asn", int64String(connection.RemoteBgpAsn),
+				"mtu", int64String(connection.Mtu),
+			)
+		case Node_Kind_VpnGateway:
+			network.Zone = zoneName(connection.Zone)
+			network.Attributes = attributes("cidr", stringValue(connection.Cidr))
+		}
+		topology.addNode(network)
+
+		topology.Edges = append(topology.Edges, &Edge{
+			From:         gatewayID,
+			To:           network.ID,
+			Kind:         Edge_Kind_Connection,
+			ConnectionID: connectionID,
+			Name:         stringValue(connection.Name),
+			Status:       stringValue(connection.Status),
+			Zone:         zoneName(connection.Zone),
+			Attributes: attributes(
+				"network_account_id", stringValue(connection.NetworkAccountID),
+				"request_status", stringValue(connection.RequestStatus),
+				"prefix_filters_default", stringValue(connection.PrefixFiltersDefault),
+			),
+		})
+
+		switch networkType {
+		case Node_Kind_GreTunnel:
+			if baseID, ok := networkIDs[stringValue(connection.BaseConnectionID)]; ok {
+				topology.addBaseEdge(network.ID, baseID, connectionID)
+			}
+		case Node_Kind_UnboundGreTunnel:
+			topology.addBaseNetwork(network.ID, connectionID, stringValue(connection.BaseNetworkType), "", stringValue(connection.NetworkAccountID))
+		}
+
+		for _, tunnel := range connection.Tunnels {
+			topology.addTunnel(network.ID, connectionID, tunnel)
+		}
+	}
+}
+
+// MergeDirectLinkGateways merges Direct Link gateways into the topology. A gateway a transit gateway connects takes
+// the name, status and location of the gateway; the other gateways are added without edges.
+func (topology *Topology) MergeDirectLinkGateways(gateways []directlinkv1.GatewayCollectionGatewaysItemIntf) {
+	for _, item := range gateways {
+		var crn, id, name, gatewayType, location, status, bgpStatus, connectionMode *string
+		var speed *int64
+		switch item := item.(type) {
+		case *directlinkv1.GatewayCollectionGatewaysItem:
+			crn, id, name, gatewayType, location, status = item.Crn, item.ID, item.Name, item.Type, item.LocationName, item.OperationalStatus
+			bgpStatus, connectionMode, speed = item.BgpStatus, item.ConnectionMode, item.SpeedMbps
+		case *directlinkv1.GatewayCollectionGatewaysItemGateway:
+			crn, id, name, gatewayType, location, status = item.Crn, item.ID, item.Name, item.Type, item.LocationName, item.OperationalStatus
+			bgpStatus, connectionMode, speed = item.BgpStatus, item.ConnectionMode, item.SpeedMbps
+		case *directlinkv1.GatewayCollectionGatewaysItemCrossAccountGateway:
+			crn, id, name, gatewayType, location, status = item.Crn, item.ID, item.Name, item.Type, item.LocationName, item.OperationalStatus
+			bgpStatus, connectionMode, speed = item.BgpStatus, item.ConnectionMode, item.SpeedMbps
+		default:
+			continue
+		}
+		gateway := &Node{
+			ID:       stringValue(crn),
+			Kind:     Node_Kind_Directlink,
+			Name:     stringValue(name),
+			Status:   stringValue(status),
+			Location: stringValue(location),
+			Attributes: attributes(
+				"id", stringValue(id),
+				"type", stringValue(gatewayType),
+				"speed_mbps", int64String(speed),
+				"bgp_status", stringValue(bgpStatus),
+				"connection_mode", stringValue(connectionMode),
+			),
+		}
+		if existing := topology.Node(gateway.ID); existing != nil {
+			existing.Name, existing.Status, existing.Location = gateway.Name, gateway.Status, gateway.Location
+			existing.mergeAttributes(gateway.Attributes)
+			continue
+		}
+		topology.Nodes = append(topology.Nodes, gateway)
+	}
+}
+
+// Node returns the node with the given ID, or nil.
+func (topology *Topology) Node(id string) *Node {
+	for _, node := range topology.Nodes {
+		if node.ID == id {
+			return node
+		}
+	}
+	return nil
+}
+
+// JSON renders the topology as indented JSON.
+func (topology *Topology) JSON() ([]byte, error) {
+	return json.MarshalIndent(topology, "", "  ")
+}
+
+// addNode adds a node to the topology or, when a node with the same ID is there, completes it.
+func (topology *Topology) addNode(node *Node) {
+	existing := topology.Node(node.ID)
+	if existing == nil && node.Kind == Node_Kind_TransitGateway {
+		// The transit gateways come before the networks and tunnels.
+		i := 0
+		for i < len(topology.Nodes) && topology.Nodes[i].Kind == Node_Kind_TransitGateway {
+			i++
+		}
+		topology.Nodes = append(topology.Nodes[:i], append([]*Node{node}, topology.Nodes[i:]...)...)
+		return
+	}
+	if existing == nil {
+		topology.Nodes = append(topology.Nodes, node)
+		return
+	}
+	if existing.Name == "" {
+		existing.Name = node.Name
+	}
+	if existing.Status == "" {
+		existing.Status = node.Status
+	}
+	if existing.Location == "" {
+		existing.Location = node.Location
+	}
+	if existing.Zone == "" {
+		existing.Zone = node.Zone
+	}
+	existing.mergeAttributes(node.Attributes)
+}
+
+// addTunnel adds a redundant GRE or VPN gateway tunnel and the network it is configured over.
+func (topology *Topology) addTunnel(connectionNodeID string, connectionID string, tunnel transitgatewayapisv1.TransitGatewayTunnel) {
+	tunnelID := stringValue(tunnel.ID)
+	topology.addNode(&Node{
+		ID:     tunnelID,
+		Kind:   Node_Kind_Tunnel,
+		Name:   stringValue(tunnel.Name),
+		Status: stringValue(tunnel.Status),
+		Zone:   zoneName(tunnel.Zone),
+		Attributes: attributes(
+			"base_network_type", stringValue(tunnel.BaseNetworkType),
+			"local_gateway_ip", stringValue(tunnel.LocalGatewayIp),
+			"remote_gateway_ip", stringValue(tunnel.RemoteGatewayIp),
+			"local_tunnel_ip", stringValue(tunnel.LocalTunnelIp),
+			"remote_tunnel_ip", stringValue(tunnel.RemoteTunnelIp),
+			"local_bgp_asn", int64String(tunnel.LocalBgpAsn),
+			"remote_bgp_asn", int64String(tunnel.RemoteBgpAsn),
+			"mtu", int64String(tunnel.Mtu),
+		),
+	})
+	topology.Edges = append(topology.Edges, &Edge{
+		From:         connectionNodeID,
+		To:           tunnelID,
+		Kind:         Edge_Kind_Tunnel,
+		ConnectionID: connectionID,
+		Name:         stringValue(tunnel.Name),
+		Status:       stringValue(tunnel.Status),
+		Zone:         zoneName(tunnel.Zone),
+	})
+	topology.addBaseNetwork(tunnelID, connectionID, stringValue(tunnel.BaseNetworkType), stringValue(tunnel.NetworkID), stringValue(tunnel.NetworkAccountID))
+}
+
+// addBaseNetwork joins a GRE tunnel to the classic infrastructure or VPC it is configured over.
+func (topology *Topology) addBaseNetwork(from string, connectionID string, baseNetworkType string, networkID string, accountID string) {
+	var base *Node
+	switch {
+	case baseNetworkType == Node_Kind_Classic:
+		base = &Node{ID: classicNodeID(accountID), Kind: Node_Kind_Classic, Name: Node_Kind_Classic}
+		if accountID != "" {
+			base.Attributes = attributes("account_id", accountID)
+		}
+	case networkID != "":
+		base = &Node{ID: networkID, Kind: Node_Kind_Vpc}
+	default:
+		return
+	}
+	topology.addNode(base)
+	topology.addBaseEdge(from, base.ID, connectionID)
+}
+
+func (topology *Topology) addBaseEdge(from string, to string, connectionID string) {
+	topology.Edges = append(topology.Edges, &Edge{From: from, To: to, Kind: Edge_Kind_Base, ConnectionID: connectionID})
+}
+
+func (node *Node) mergeAttributes(attributes map[string]string) {
+	if len(attributes) == 0 {
+		return
+	}
+	if node.Attributes == nil {
+		node.Attributes = map[string]string{}
+	}
+	for key, value := range attributes {
+		node.Attributes[key] = value
+	}
+}
+
+// connectionNetworkID returns the ID of the node of the network a connection joins.
+func connectionNetworkID(connection transitgatewayapisv1.TransitGatewayConnectionCust) string {
+	switch stringValue(connection.NetworkType) {
+	case Node_Kind_Classic:
+		return classicNodeID(stringValue(connection.NetworkAccountID))
+	case Node_Kind_GreTunnel, Node_Kind_UnboundGreTunnel, Node_Kind_RedundantGre:
+		return stringValue(connection.ID)
+	}
+	if connection.NetworkID != nil && *connection.NetworkID != "" {
+		return *connection.NetworkID
+	}
+	return stringValue(connection.ID)
+}
+
+func classicNodeID(accountID string) string {
+	if accountID == "" {
+		return Node_Kind_Classic
+	}
+	return Node_Kind_Classic + "/" + accountID
+}
+
+// attributes returns the non-empty values of the given key and value pairs, or nil.
+func attributes(keyValues ...string) (result map[string]string) {
+	for i := 0; i+1 < len(keyValues); i += 2 {
+		if keyValues[i+1] == "" {
+			continue
+		}
+		if result == nil {
+			result = map[string]string{}
+		}
+		result[keyValues[i]] = keyValues[i+1]
+	}
+	return
+}
+
+func zoneName(zone *transitgatewayapisv1.ZoneReference) string {
+	if zone == nil {
+		return ""
+	}
+	return stringValue(zone.Name)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func int64String(value *int64) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(*value)
+}
+
+func boolString(value *bool) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(*value)
+}
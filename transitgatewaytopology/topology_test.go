@@ -0,0 +1,186 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transitgatewaytopology
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/IBM/networking-go-sdk/internal/testserver"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/stretchr/testify/assert"
+)
+
+const tg1ConnectionsBody = `{"connections": [
+	{"id": "c-vpc", "name": "vpc-one", "network_type": "vpc", "network_id": "crn:vpc1", "status": "attached"},
+	{"id": "c-gre", "name": "gre-one", "network_type": "gre_tunnel", "base_connection_id": "c-classic", "status": "attached",
+	 "zone": {"name": "us-south-1"}, "local_gateway_ip": "192.168.100.1", "remote_gateway_ip": "10.242.63.12", "remote_bgp_asn": 65010},
+	{"id": "c-classic", "name": "classic", "network_type": "classic", "status": "attached"},
+	{"id": "c-rgre", "name": "rgre \"one\"", "network_type": "redundant_gre", "base_network_type": "vpc", "status": "failed",
+	 "tunnels": [
+		{"id": "t1", "name": "tunnel-1", "base_network_type": "vpc", "network_id": "crn:vpc2", "status": "attached", "zone": {"name": "us-south-1"}},
+		{"id": "t2", "name": "tunnel-2", "base_network_type": "classic", "network_account_id": "acct2", "status": "detached", "zone": {"name": "us-south-2"}}
+	 ]},
+	{"id": "c-dl", "name": "dl-connection", "network_type": "directlink", "network_id": "crn:dl1", "status": "attached"}
+]}`
+
+const tg2ConnectionsBody = `{"connections": [
+	{"id": "c-vpc-2", "name": "vpc-one", "network_type": "vpc", "network_id": "crn:vpc1", "status": "attached", "network_account_id": "acct3"},
+	{"id": "c-vpn", "name": "vpn", "network_type": "vpn_gateway", "network_id": "crn:vpn1", "status": "attached", "cidr": "10.0.0.0/24",
+	 "zone": {"name": "us-south-3"}, "tunnels": [
+		{"id": "t3", "name": "tunnel-3", "base_network_type": "vpn", "status": "attached", "zone": {"name": "us-south-3"}}
+	 ]}
+]}`
+
+const directLinkGatewaysBody = `{"gateways": [
+	{"id": "dl1", "crn": "crn:dl1", "name": "dl-one", "type": "dedicated", "location_name": "dal03", "operational_status": "provisioned",
+	 "speed_mbps": 1000, "bgp_status": "established", "connection_mode": "transit"},
+	{"id": "dl2", "crn": "crn:dl2", "name": "dl-two", "type": "connect", "location_name": "dal10", "operational_status": "provisioned",
+	 "speed_mbps": 50, "connection_mode": "direct"}
+]}`
+
+// topologyRoutes serve two transit gateways, one per page, with their connections, and the Direct Link gateways.
+var topologyRoutes = []testserver.Route{
+	{Path: "/transit_gateways", Handler: func(res http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("start") == "" {
+			fmt.Fprint(res, `{"first": {"href": "x"}, "limit": 1, "next": {"href": "x", "start": "tg2"}, "transit_gateways": [
+				{"id": "tg1", "name": "tg-one", "crn": "crn:tg1", "location": "us-south", "status": "available", "global": false}
+			]}`)
+		} else {
+			fmt.Fprint(res, `{"first": {"href": "x"}, "limit": 1, "transit_gateways": [
+				{"id": "tg2", "name": "tg-two", "crn": "crn:tg2", "location": "us-east", "status": "available", "global": true}
+			]}`)
+		}
+	}},
+	{Path: "/transit_gateways/tg1/connections", Body: tg1ConnectionsBody},
+	{Path: "/transit_gateways/tg2/connections", Body: tg2ConnectionsBody},
+	{Path: "/gateways", Body: directLinkGatewaysBody},
+}
+
+func newTestBuilder(t *testing.T, url string, options *BuilderOptions) *Builder {
+	service, err := transitgatewayapisv1.NewTransitGatewayApisV1(&transitgatewayapisv1.TransitGatewayApisV1Options{
+		URL:           url,
+		Authenticator: &core.NoAuthAuthenticator{},
+		Version:       core.StringPtr("2024-01-01"),
+	})
+	assert.Nil(t, err)
+	builder, err := NewBuilder(service, options)
+	assert.Nil(t, err)
+	return builder
+}
+
+func nodeIDs(topology *Topology) (ids []string) {
+	for _, node := range topology.Nodes {
+		ids = append(ids, node.ID)
+	}
+	return
+}
+
+func edgeStrings(topology *Topology) (edges []string) {
+	for _, edge := range topology.Edges {
+		edges = append(edges, edge.Kind+" "+edge.From+" -> "+edge.To)
+	}
+	return
+}
+
+func TestBuild(t *testing.T) {
+	server := testserver.New(t, "", topologyRoutes...)
+
+	topology, err := newTestBuilder(t, server.URL, nil).Build()
+	assert.Nil(t, err)
+	// Both transit gateways come before the networks and tunnels, even though tg2 is only reached after the
+	// connections of tg1.
+	assert.Equal(t, []string{"tg1", "tg2", "crn:vpc1", "c-gre", "classic", "c-rgre", "t1", "crn:vpc2", "t2", "classic/acct2",
+		"crn:dl1", "crn:vpn1", "t3"}, nodeIDs(topology))
+	assert.Equal(t, []string{
+		"connection tg1 -> crn:vpc1",
+		"connection tg1 -> c-gre",
+		"base c-gre -> classic",
+		"connection tg1 -> classic",
+		"connection tg1 -> c-rgre",
+		"tunnel c-rgre -> t1",
+		"base t1 -> crn:vpc2",
+		"tunnel c-rgre -> t2",
+		"base t2 -> classic/acct2",
+		"connection tg1 -> crn:dl1",
+		"connection tg2 -> crn:vpc1",
+		"connection tg2 -> crn:vpn1",
+		"tunnel crn:vpn1 -> t3",
+	}, edgeStrings(topology))
+
+	gre := topology.Node("c-gre")
+	assert.Equal(t, Node_Kind_GreTunnel, gre.Kind)
+	assert.Equal(t, "us-south-1", gre.Zone)
+	assert.Equal(t, "65010", gre.Attributes["remote_bgp_asn"])
+	assert.Equal(t, "us-south-2", topology.Node("t2").Zone)
+	assert.Equal(t, "acct2", topology.Node("classic/acct2").Attributes["account_id"])
+	assert.Equal(t, "10.0.0.0/24", topology.Node("crn:vpn1").Attributes["cidr"])
+	assert.Equal(t, "acct3", topology.Edges[10].Attributes["network_account_id"])
+	assert.Equal(t, "dl-connection", topology.Node("crn:dl1").Name)
+	assert.Nil(t, topology.Node("crn:dl2"))
+}
+
+func TestBuildSelectedGatewaysWithDirectLink(t *testing.T) {
+	server := testserver.New(t, "", topologyRoutes...)
+
+	directLink, err := directlinkv1.NewDirectLinkV1(&directlinkv1.DirectLinkV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+		Version:       core.StringPtr("2024-01-01"),
+	})
+	assert.Nil(t, err)
+	options := NewBuilderOptions().SetDirectLink(directLink).SetTransitGatewayIDs([]string{"tg2"})
+	topology, err := newTestBuilder(t, server.URL, options).Build()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"tg2", "crn:vpc1", "crn:vpn1", "t3", "crn:dl1", "crn:dl2"}, nodeIDs(topology))
+
+	options.SetTransitGatewayIDs(nil)
+	topology, err = newTestBuilder(t, server.URL, options).Build()
+	assert.Nil(t, err)
+	dl1 := topology.Node("crn:dl1")
+	assert.Equal(t, "dl-one", dl1.Name)
+	assert.Equal(t, "dal03", dl1.Location)
+	assert.Equal(t, map[string]string{"id": "dl1", "type": "dedicated", "speed_mbps": "1000", "bgp_status": "established",
+		"connection_mode": "transit"}, dl1.Attributes)
+	assert.Equal(t, "direct", topology.Node("crn:dl2").Attributes["connection_mode"])
+}
+
+func TestRender(t *testing.T) {
+	server := testserver.New(t, "", topologyRoutes...)
+
+	topology, err := newTestBuilder(t, server.URL, NewBuilderOptions().SetTransitGatewayIDs([]string{"tg1"})).Build()
+	assert.Nil(t, err)
+
+	dot := topology.DOT()
+	assert.Contains(t, dot, "digraph topology {\n")
+	assert.Contains(t, dot, `  "tg1" [label="tg-one\ntransit_gateway\nus-south", shape=doubleoctagon];`)
+	assert.Contains(t, dot, `  "classic" [label="classic", shape=box3d];`)
+	assert.Contains(t, dot, `  "c-rgre" [label="rgre \"one\"\nredundant_gre\nfailed", shape=hexagon];`)
+	assert.Contains(t, dot, `  "tg1" -> "c-rgre" [label="rgre \"one\"\nfailed", color=red];`)
+	assert.Contains(t, dot, `  "c-rgre" -> "t2" [label="tunnel-2\ndetached", style=dotted];`)
+	assert.Contains(t, dot, `  "c-gre" -> "classic" [label="", style=dashed, arrowhead=none];`)
+
+	encoded, err := topology.JSON()
+	assert.Nil(t, err)
+	var decoded Topology
+	assert.Nil(t, json.Unmarshal(encoded, &decoded))
+	assert.Equal(t, topology, &decoded)
+}
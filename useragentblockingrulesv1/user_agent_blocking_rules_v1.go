@@ -178,6 +178,12 @@ func (userAgentBlockingRules *UserAgentBlockingRulesV1) DisableRetries() {
 	userAgentBlockingRules.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (userAgentBlockingRules *UserAgentBlockingRulesV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(userAgentBlockingRules.Service, policy)
+}
+
 // ListAllZoneUserAgentRules : List all user-agent blocking rules
 // List all user agent blocking rules.
 func (userAgentBlockingRules *UserAgentBlockingRulesV1) ListAllZoneUserAgentRules(listAllZoneUserAgentRulesOptions *ListAllZoneUserAgentRulesOptions) (result *ListUseragentRulesResp, response *core.DetailedResponse, err error) {
@@ -229,6 +235,7 @@ func (userAgentBlockingRules *UserAgentBlockingRulesV1) ListAllZoneUserAgentRule
 	var rawResponse map[string]json.RawMessage
 	response, err = userAgentBlockingRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListUseragentRulesResp)
@@ -303,6 +310,7 @@ func (userAgentBlockingRules *UserAgentBlockingRulesV1) CreateZoneUserAgentRuleW
 	var rawResponse map[string]json.RawMessage
 	response, err = userAgentBlockingRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalUseragentRuleResp)
@@ -363,6 +371,7 @@ func (userAgentBlockingRules *UserAgentBlockingRulesV1) DeleteZoneUserAgentRuleW
 	var rawResponse map[string]json.RawMessage
 	response, err = userAgentBlockingRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDeleteUseragentRuleResp)
@@ -423,6 +432,7 @@ func (userAgentBlockingRules *UserAgentBlockingRulesV1) GetUserAgentRuleWithCont
 	var rawResponse map[string]json.RawMessage
 	response, err = userAgentBlockingRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalUseragentRuleResp)
@@ -502,6 +512,7 @@ func (userAgentBlockingRules *UserAgentBlockingRulesV1) UpdateUserAgentRuleWithC
 	var rawResponse map[string]json.RawMessage
 	response, err = userAgentBlockingRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalUseragentRuleResp)
@@ -945,6 +956,20 @@ func UnmarshalListUseragentRulesResp(m map[string]json.RawMessage, result interf
 	return
 }
 
+// Retrieve the value to be passed to a request to access the next page of results
+func (resp *ListUseragentRulesResp) GetNextPage() (*int64, error) {
+	if core.IsNil(resp.ResultInfo) || resp.ResultInfo.Page == nil || resp.ResultInfo.PerPage == nil || resp.ResultInfo.TotalCount == nil {
+		return nil, nil
+	}
+	if resp.ResultInfo.Count != nil && *resp.ResultInfo.Count == 0 {
+		return nil, nil
+	}
+	if *resp.ResultInfo.Page**resp.ResultInfo.PerPage >= *resp.ResultInfo.TotalCount {
+		return nil, nil
+	}
+	return core.Int64Ptr(*resp.ResultInfo.Page + 1), nil
+}
+
 // UseragentRuleObject : user agent rule object.
 type UseragentRuleObject struct {
 	// Identifier of the user-agent blocking rule.
@@ -1035,3 +1060,82 @@ func UnmarshalUseragentRuleResp(m map[string]json.RawMessage, result interface{}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// ZoneUserAgentRulesPager can be used to simplify the use of the "ListAllZoneUserAgentRules" method.
+type ZoneUserAgentRulesPager struct {
+	hasNext     bool
+	options     *ListAllZoneUserAgentRulesOptions
+	client      *UserAgentBlockingRulesV1
+	pageContext struct {
+		next *int64
+	}
+}
+
+// NewZoneUserAgentRulesPager returns a new ZoneUserAgentRulesPager instance.
+func (userAgentBlockingRules *UserAgentBlockingRulesV1) NewZoneUserAgentRulesPager(options *ListAllZoneUserAgentRulesOptions) (pager *ZoneUserAgentRulesPager, err error) {
+	if options.Page != nil && *options.Page > 1 {
+		err = fmt.Errorf("the 'options.Page' field should not be set")
+		return
+	}
+
+	var optionsCopy ListAllZoneUserAgentRulesOptions = *options
+	pager = &ZoneUserAgentRulesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  userAgentBlockingRules,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ZoneUserAgentRulesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ZoneUserAgentRulesPager) GetNextWithContext(ctx context.Context) (page []UseragentRuleObject, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Page = pager.pageContext.next
+
+	result, _, err := pager.client.ListAllZoneUserAgentRulesWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+
+	next, err := result.GetNextPage()
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Result
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ZoneUserAgentRulesPager) GetAllWithContext(ctx context.Context) (allItems []UseragentRuleObject, err error) {
+	for pager.HasNext() {
+		var nextPage []UseragentRuleObject
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ZoneUserAgentRulesPager) GetNext() (page []UseragentRuleObject, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ZoneUserAgentRulesPager) GetAll() (allItems []UseragentRuleObject, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
@@ -353,6 +353,101 @@ var _ = Describe(`UserAgentBlockingRulesV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listAllZoneUserAgentRulesPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						Expect(req.URL.Query()["page"]).To(BeNil())
+						fmt.Fprintf(res, "%s", `{"success": true, "errors": [["Errors"]], "messages": [["Messages"]], "result": [{"id": "92f17202ed8bd63d69a66b86a49a8f6b", "paused": true, "description": "Prevent access from abusive clients identified by this UserAgent to mitigate DDoS attack", "mode": "block", "configuration": {"target": "ua", "value": "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_12_5) AppleWebKit/603.2.4 (KHTML, like Gecko) Version/10.1.1 Safari/603.2.4"}}], "result_info": {"page": 1, "per_page": 1, "count": 1, "total_count": 2}}`)
+					} else if requestNumber == 2 {
+						Expect(req.URL.Query()["page"]).To(Equal([]string{fmt.Sprint(int64(2))}))
+						fmt.Fprintf(res, "%s", `{"success": true, "errors": [["Errors"]], "messages": [["Messages"]], "result": [{"id": "92f17202ed8bd63d69a66b86a49a8f6b", "paused": true, "description": "Prevent access from abusive clients identified by this UserAgent to mitigate DDoS attack", "mode": "block", "configuration": {"target": "ua", "value": "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_12_5) AppleWebKit/603.2.4 (KHTML, like Gecko) Version/10.1.1 Safari/603.2.4"}}], "result_info": {"page": 2, "per_page": 1, "count": 1, "total_count": 2}}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use ZoneUserAgentRulesPager.GetNext successfully`, func() {
+				userAgentBlockingRulesService, serviceErr := useragentblockingrulesv1.NewUserAgentBlockingRulesV1(&useragentblockingrulesv1.UserAgentBlockingRulesV1Options{
+					URL:            testServer.URL,
+					Authenticator:  &core.NoAuthAuthenticator{},
+					Crn:            core.StringPtr(crn),
+					ZoneIdentifier: core.StringPtr(zoneIdentifier),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(userAgentBlockingRulesService).ToNot(BeNil())
+
+				// Construct an instance of the ListAllZoneUserAgentRulesOptions model
+				listAllZoneUserAgentRulesOptionsModel := new(useragentblockingrulesv1.ListAllZoneUserAgentRulesOptions)
+
+				pager, err := userAgentBlockingRulesService.NewZoneUserAgentRulesPager(listAllZoneUserAgentRulesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []useragentblockingrulesv1.UseragentRuleObject
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use ZoneUserAgentRulesPager.GetAll successfully`, func() {
+				userAgentBlockingRulesService, serviceErr := useragentblockingrulesv1.NewUserAgentBlockingRulesV1(&useragentblockingrulesv1.UserAgentBlockingRulesV1Options{
+					URL:            testServer.URL,
+					Authenticator:  &core.NoAuthAuthenticator{},
+					Crn:            core.StringPtr(crn),
+					ZoneIdentifier: core.StringPtr(zoneIdentifier),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(userAgentBlockingRulesService).ToNot(BeNil())
+
+				// Construct an instance of the ListAllZoneUserAgentRulesOptions model
+				listAllZoneUserAgentRulesOptionsModel := new(useragentblockingrulesv1.ListAllZoneUserAgentRulesOptions)
+
+				pager, err := userAgentBlockingRulesService.NewZoneUserAgentRulesPager(listAllZoneUserAgentRulesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Invoke NewZoneUserAgentRulesPager with a page already set`, func() {
+				userAgentBlockingRulesService, serviceErr := useragentblockingrulesv1.NewUserAgentBlockingRulesV1(&useragentblockingrulesv1.UserAgentBlockingRulesV1Options{
+					URL:            testServer.URL,
+					Authenticator:  &core.NoAuthAuthenticator{},
+					Crn:            core.StringPtr(crn),
+					ZoneIdentifier: core.StringPtr(zoneIdentifier),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(userAgentBlockingRulesService).ToNot(BeNil())
+
+				// Construct an instance of the ListAllZoneUserAgentRulesOptions model
+				listAllZoneUserAgentRulesOptionsModel := new(useragentblockingrulesv1.ListAllZoneUserAgentRulesOptions)
+				listAllZoneUserAgentRulesOptionsModel.Page = core.Int64Ptr(int64(3))
+
+				pager, err := userAgentBlockingRulesService.NewZoneUserAgentRulesPager(listAllZoneUserAgentRulesOptionsModel)
+				Expect(err).ToNot(BeNil())
+				Expect(pager).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
 	})
 	Describe(`CreateZoneUserAgentRule(createZoneUserAgentRuleOptions *CreateZoneUserAgentRuleOptions) - Operation response error`, func() {
 		crn := "testString"
@@ -178,6 +178,12 @@ func (wafApi *WafApiV1) DisableRetries() {
 	wafApi.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (wafApi *WafApiV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(wafApi.Service, policy)
+}
+
 // GetWafSettings : Get WAF setting
 // Get WAF of a specific zone.
 func (wafApi *WafApiV1) GetWafSettings(getWafSettingsOptions *GetWafSettingsOptions) (result *WafResponse, response *core.DetailedResponse, err error) {
@@ -222,6 +228,7 @@ func (wafApi *WafApiV1) GetWafSettingsWithContext(ctx context.Context, getWafSet
 	var rawResponse map[string]json.RawMessage
 	response, err = wafApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWafResponse)
@@ -287,6 +294,7 @@ func (wafApi *WafApiV1) UpdateWafSettingsWithContext(ctx context.Context, update
 	var rawResponse map[string]json.RawMessage
 	response, err = wafApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWafResponse)
@@ -178,6 +178,12 @@ func (wafRuleGroupsApi *WafRuleGroupsApiV1) DisableRetries() {
 	wafRuleGroupsApi.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (wafRuleGroupsApi *WafRuleGroupsApiV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(wafRuleGroupsApi.Service, policy)
+}
+
 // ListWafRuleGroups : List all WAF rule groups
 // List all WAF rule groups contained within a package.
 func (wafRuleGroupsApi *WafRuleGroupsApiV1) ListWafRuleGroups(listWafRuleGroupsOptions *ListWafRuleGroupsOptions) (result *WafGroupsResponse, response *core.DetailedResponse, err error) {
@@ -252,6 +258,7 @@ func (wafRuleGroupsApi *WafRuleGroupsApiV1) ListWafRuleGroupsWithContext(ctx con
 	var rawResponse map[string]json.RawMessage
 	response, err = wafRuleGroupsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWafGroupsResponse)
@@ -313,6 +320,7 @@ func (wafRuleGroupsApi *WafRuleGroupsApiV1) GetWafRuleGroupWithContext(ctx conte
 	var rawResponse map[string]json.RawMessage
 	response, err = wafRuleGroupsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWafGroupResponse)
@@ -384,6 +392,7 @@ func (wafRuleGroupsApi *WafRuleGroupsApiV1) UpdateWafRuleGroupWithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = wafRuleGroupsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWafGroupResponse)
@@ -774,6 +783,20 @@ func UnmarshalWafGroupsResponse(m map[string]json.RawMessage, result interface{}
 	return
 }
 
+// Retrieve the value to be passed to a request to access the next page of results
+func (resp *WafGroupsResponse) GetNextPage() (*int64, error) {
+	if core.IsNil(resp.ResultInfo) || resp.ResultInfo.Page == nil || resp.ResultInfo.PerPage == nil || resp.ResultInfo.TotalCount == nil {
+		return nil, nil
+	}
+	if resp.ResultInfo.Count != nil && *resp.ResultInfo.Count == 0 {
+		return nil, nil
+	}
+	if *resp.ResultInfo.Page**resp.ResultInfo.PerPage >= *resp.ResultInfo.TotalCount {
+		return nil, nil
+	}
+	return core.Int64Ptr(*resp.ResultInfo.Page + 1), nil
+}
+
 // WafRuleProperties : waf rule properties.
 type WafRuleProperties struct {
 	// ID.
@@ -839,3 +862,82 @@ func UnmarshalWafRuleProperties(m map[string]json.RawMessage, result interface{}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// WafRuleGroupsPager can be used to simplify the use of the "ListWafRuleGroups" method.
+type WafRuleGroupsPager struct {
+	hasNext     bool
+	options     *ListWafRuleGroupsOptions
+	client      *WafRuleGroupsApiV1
+	pageContext struct {
+		next *int64
+	}
+}
+
+// NewWafRuleGroupsPager returns a new WafRuleGroupsPager instance.
+func (wafRuleGroupsApi *WafRuleGroupsApiV1) NewWafRuleGroupsPager(options *ListWafRuleGroupsOptions) (pager *WafRuleGroupsPager, err error) {
+	if options.Page != nil && *options.Page > 1 {
+		err = fmt.Errorf("the 'options.Page' field should not be set")
+		return
+	}
+
+	var optionsCopy ListWafRuleGroupsOptions = *options
+	pager = &WafRuleGroupsPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  wafRuleGroupsApi,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *WafRuleGroupsPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *WafRuleGroupsPager) GetNextWithContext(ctx context.Context) (page []WafRuleProperties, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Page = pager.pageContext.next
+
+	result, _, err := pager.client.ListWafRuleGroupsWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+
+	next, err := result.GetNextPage()
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Result
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *WafRuleGroupsPager) GetAllWithContext(ctx context.Context) (allItems []WafRuleProperties, err error) {
+	for pager.HasNext() {
+		var nextPage []WafRuleProperties
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *WafRuleGroupsPager) GetNext() (page []WafRuleProperties, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *WafRuleGroupsPager) GetAll() (allItems []WafRuleProperties, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
@@ -405,6 +405,122 @@ var _ = Describe(`WafRuleGroupsApiV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listWafRuleGroupsPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						Expect(req.URL.Query()["page"]).To(BeNil())
+						fmt.Fprintf(res, "%s", `{"success": true, "errors": [["Errors"]], "messages": [["Messages"]], "result": [{"id": "a25a9a7e9c00afc1fb2e0245519d725b", "name": "Project Honey Pot", "description": "Group designed to protect against IP addresses that are a threat and typically used to launch DDoS attacks", "rules_count": 10, "modified_rules_count": 10, "package_id": "a25a9a7e9c00afc1fb2e0245519d725b", "mode": "on", "allowed_modes": ["AllowedModes"]}], "result_info": {"page": 1, "per_page": 1, "count": 1, "total_count": 2}}`)
+					} else if requestNumber == 2 {
+						Expect(req.URL.Query()["page"]).To(Equal([]string{fmt.Sprint(int64(2))}))
+						fmt.Fprintf(res, "%s", `{"success": true, "errors": [["Errors"]], "messages": [["Messages"]], "result": [{"id": "a25a9a7e9c00afc1fb2e0245519d725b", "name": "Project Honey Pot", "description": "Group designed to protect against IP addresses that are a threat and typically used to launch DDoS attacks", "rules_count": 10, "modified_rules_count": 10, "package_id": "a25a9a7e9c00afc1fb2e0245519d725b", "mode": "on", "allowed_modes": ["AllowedModes"]}], "result_info": {"page": 2, "per_page": 1, "count": 1, "total_count": 2}}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use WafRuleGroupsPager.GetNext successfully`, func() {
+				wafRuleGroupsApiService, serviceErr := wafrulegroupsapiv1.NewWafRuleGroupsApiV1(&wafrulegroupsapiv1.WafRuleGroupsApiV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+					Crn:           core.StringPtr(crn),
+					ZoneID:        core.StringPtr(zoneID),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(wafRuleGroupsApiService).ToNot(BeNil())
+
+				// Construct an instance of the ListWafRuleGroupsOptions model
+				listWafRuleGroupsOptionsModel := new(wafrulegroupsapiv1.ListWafRuleGroupsOptions)
+				listWafRuleGroupsOptionsModel.PkgID = core.StringPtr("testString")
+				listWafRuleGroupsOptionsModel.Name = core.StringPtr("Wordpress-rules")
+				listWafRuleGroupsOptionsModel.Mode = core.StringPtr("true")
+				listWafRuleGroupsOptionsModel.RulesCount = core.StringPtr("10")
+				listWafRuleGroupsOptionsModel.Order = core.StringPtr("status")
+				listWafRuleGroupsOptionsModel.Direction = core.StringPtr("desc")
+				listWafRuleGroupsOptionsModel.Match = core.StringPtr("all")
+
+				pager, err := wafRuleGroupsApiService.NewWafRuleGroupsPager(listWafRuleGroupsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []wafrulegroupsapiv1.WafRuleProperties
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use WafRuleGroupsPager.GetAll successfully`, func() {
+				wafRuleGroupsApiService, serviceErr := wafrulegroupsapiv1.NewWafRuleGroupsApiV1(&wafrulegroupsapiv1.WafRuleGroupsApiV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+					Crn:           core.StringPtr(crn),
+					ZoneID:        core.StringPtr(zoneID),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(wafRuleGroupsApiService).ToNot(BeNil())
+
+				// Construct an instance of the ListWafRuleGroupsOptions model
+				listWafRuleGroupsOptionsModel := new(wafrulegroupsapiv1.ListWafRuleGroupsOptions)
+				listWafRuleGroupsOptionsModel.PkgID = core.StringPtr("testString")
+				listWafRuleGroupsOptionsModel.Name = core.StringPtr("Wordpress-rules")
+				listWafRuleGroupsOptionsModel.Mode = core.StringPtr("true")
+				listWafRuleGroupsOptionsModel.RulesCount = core.StringPtr("10")
+				listWafRuleGroupsOptionsModel.Order = core.StringPtr("status")
+				listWafRuleGroupsOptionsModel.Direction = core.StringPtr("desc")
+				listWafRuleGroupsOptionsModel.Match = core.StringPtr("all")
+
+				pager, err := wafRuleGroupsApiService.NewWafRuleGroupsPager(listWafRuleGroupsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Invoke NewWafRuleGroupsPager with a page already set`, func() {
+				wafRuleGroupsApiService, serviceErr := wafrulegroupsapiv1.NewWafRuleGroupsApiV1(&wafrulegroupsapiv1.WafRuleGroupsApiV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+					Crn:           core.StringPtr(crn),
+					ZoneID:        core.StringPtr(zoneID),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(wafRuleGroupsApiService).ToNot(BeNil())
+
+				// Construct an instance of the ListWafRuleGroupsOptions model
+				listWafRuleGroupsOptionsModel := new(wafrulegroupsapiv1.ListWafRuleGroupsOptions)
+				listWafRuleGroupsOptionsModel.PkgID = core.StringPtr("testString")
+				listWafRuleGroupsOptionsModel.Name = core.StringPtr("Wordpress-rules")
+				listWafRuleGroupsOptionsModel.Mode = core.StringPtr("true")
+				listWafRuleGroupsOptionsModel.RulesCount = core.StringPtr("10")
+				listWafRuleGroupsOptionsModel.Order = core.StringPtr("status")
+				listWafRuleGroupsOptionsModel.Direction = core.StringPtr("desc")
+				listWafRuleGroupsOptionsModel.Match = core.StringPtr("all")
+				listWafRuleGroupsOptionsModel.Page = core.Int64Ptr(int64(3))
+
+				pager, err := wafRuleGroupsApiService.NewWafRuleGroupsPager(listWafRuleGroupsOptionsModel)
+				Expect(err).ToNot(BeNil())
+				Expect(pager).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
 	})
 	Describe(`GetWafRuleGroup(getWafRuleGroupOptions *GetWafRuleGroupOptions) - Operation response error`, func() {
 		crn := "testString"
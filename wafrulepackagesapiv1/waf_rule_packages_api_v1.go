@@ -178,6 +178,12 @@ func (wafRulePackagesApi *WafRulePackagesApiV1) DisableRetries() {
 	wafRulePackagesApi.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (wafRulePackagesApi *WafRulePackagesApiV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(wafRulePackagesApi.Service, policy)
+}
+
 // ListWafPackages : List all WAF rule packages
 // Get firewall packages for a zone.
 func (wafRulePackagesApi *WafRulePackagesApiV1) ListWafPackages(listWafPackagesOptions *ListWafPackagesOptions) (result *WafPackagesResponse, response *core.DetailedResponse, err error) {
@@ -241,6 +247,7 @@ func (wafRulePackagesApi *WafRulePackagesApiV1) ListWafPackagesWithContext(ctx c
 	var rawResponse map[string]json.RawMessage
 	response, err = wafRulePackagesApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWafPackagesResponse)
@@ -301,6 +308,7 @@ func (wafRulePackagesApi *WafRulePackagesApiV1) GetWafPackageWithContext(ctx con
 	var rawResponse map[string]json.RawMessage
 	response, err = wafRulePackagesApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWafPackageResponse)
@@ -374,6 +382,7 @@ func (wafRulePackagesApi *WafRulePackagesApiV1) UpdateWafPackageWithContext(ctx
 	var rawResponse map[string]json.RawMessage
 	response, err = wafRulePackagesApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWafPackageResponse)
@@ -799,3 +808,96 @@ func UnmarshalWafPackagesResponse(m map[string]json.RawMessage, result interface
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// Retrieve the value to be passed to a request to access the next page of results
+func (resp *WafPackagesResponse) GetNextPage() (*int64, error) {
+	if core.IsNil(resp.ResultInfo) || resp.ResultInfo.Page == nil || resp.ResultInfo.PerPage == nil || resp.ResultInfo.TotalCount == nil {
+		return nil, nil
+	}
+	if resp.ResultInfo.Count != nil && *resp.ResultInfo.Count == 0 {
+		return nil, nil
+	}
+	if *resp.ResultInfo.Page**resp.ResultInfo.PerPage >= *resp.ResultInfo.TotalCount {
+		return nil, nil
+	}
+	return core.Int64Ptr(*resp.ResultInfo.Page + 1), nil
+}
+
+// WafPackagesPager can be used to simplify the use of the "ListWafPackages" method.
+type WafPackagesPager struct {
+	hasNext     bool
+	options     *ListWafPackagesOptions
+	client      *WafRulePackagesApiV1
+	pageContext struct {
+		next *int64
+	}
+}
+
+// NewWafPackagesPager returns a new WafPackagesPager instance.
+func (wafRulePackagesApi *WafRulePackagesApiV1) NewWafPackagesPager(options *ListWafPackagesOptions) (pager *WafPackagesPager, err error) {
+	if options.Page != nil && *options.Page > 1 {
+		err = fmt.Errorf("the 'options.Page' field should not be set")
+		return
+	}
+
+	var optionsCopy ListWafPackagesOptions = *options
+	pager = &WafPackagesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  wafRulePackagesApi,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *WafPackagesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *WafPackagesPager) GetNextWithContext(ctx context.Context) (page []WafPackagesResponseResultItem, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Page = pager.pageContext.next
+
+	result, _, err := pager.client.ListWafPackagesWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+
+	next, err := result.GetNextPage()
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Result
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *WafPackagesPager) GetAllWithContext(ctx context.Context) (allItems []WafPackagesResponseResultItem, err error) {
+	for pager.HasNext() {
+		var nextPage []WafPackagesResponseResultItem
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *WafPackagesPager) GetNext() (page []WafPackagesResponseResultItem, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *WafPackagesPager) GetAll() (allItems []WafPackagesResponseResultItem, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
@@ -381,6 +381,113 @@ var _ = Describe(`WafRulePackagesApiV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listWafPackagesPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						Expect(req.URL.Query()["page"]).To(BeNil())
+						fmt.Fprintf(res, "%s", `{"success": true, "errors": [["Errors"]], "messages": [["Messages"]], "result": [{"id": "a25a9a7e9c00afc1fb2e0245519d725b", "name": "WordPress rules", "description": "Common WordPress exploit protections", "detection_mode": "traditional", "zone_id": "023e105f4ecef8ad9ca31a8372d0c353", "status": "active"}], "result_info": {"page": 1, "per_page": 1, "count": 1, "total_count": 2}}`)
+					} else if requestNumber == 2 {
+						Expect(req.URL.Query()["page"]).To(Equal([]string{fmt.Sprint(int64(2))}))
+						fmt.Fprintf(res, "%s", `{"success": true, "errors": [["Errors"]], "messages": [["Messages"]], "result": [{"id": "a25a9a7e9c00afc1fb2e0245519d725b", "name": "WordPress rules", "description": "Common WordPress exploit protections", "detection_mode": "traditional", "zone_id": "023e105f4ecef8ad9ca31a8372d0c353", "status": "active"}], "result_info": {"page": 2, "per_page": 1, "count": 1, "total_count": 2}}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use WafPackagesPager.GetNext successfully`, func() {
+				wafRulePackagesApiService, serviceErr := wafrulepackagesapiv1.NewWafRulePackagesApiV1(&wafrulepackagesapiv1.WafRulePackagesApiV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+					Crn:           core.StringPtr(crn),
+					ZoneID:        core.StringPtr(zoneID),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(wafRulePackagesApiService).ToNot(BeNil())
+
+				// Construct an instance of the ListWafPackagesOptions model
+				listWafPackagesOptionsModel := new(wafrulepackagesapiv1.ListWafPackagesOptions)
+				listWafPackagesOptionsModel.Name = core.StringPtr("Wordpress-rules")
+				listWafPackagesOptionsModel.Order = core.StringPtr("status")
+				listWafPackagesOptionsModel.Direction = core.StringPtr("desc")
+				listWafPackagesOptionsModel.Match = core.StringPtr("all")
+
+				pager, err := wafRulePackagesApiService.NewWafPackagesPager(listWafPackagesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []wafrulepackagesapiv1.WafPackagesResponseResultItem
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use WafPackagesPager.GetAll successfully`, func() {
+				wafRulePackagesApiService, serviceErr := wafrulepackagesapiv1.NewWafRulePackagesApiV1(&wafrulepackagesapiv1.WafRulePackagesApiV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+					Crn:           core.StringPtr(crn),
+					ZoneID:        core.StringPtr(zoneID),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(wafRulePackagesApiService).ToNot(BeNil())
+
+				// Construct an instance of the ListWafPackagesOptions model
+				listWafPackagesOptionsModel := new(wafrulepackagesapiv1.ListWafPackagesOptions)
+				listWafPackagesOptionsModel.Name = core.StringPtr("Wordpress-rules")
+				listWafPackagesOptionsModel.Order = core.StringPtr("status")
+				listWafPackagesOptionsModel.Direction = core.StringPtr("desc")
+				listWafPackagesOptionsModel.Match = core.StringPtr("all")
+
+				pager, err := wafRulePackagesApiService.NewWafPackagesPager(listWafPackagesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Invoke NewWafPackagesPager with a page already set`, func() {
+				wafRulePackagesApiService, serviceErr := wafrulepackagesapiv1.NewWafRulePackagesApiV1(&wafrulepackagesapiv1.WafRulePackagesApiV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+					Crn:           core.StringPtr(crn),
+					ZoneID:        core.StringPtr(zoneID),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(wafRulePackagesApiService).ToNot(BeNil())
+
+				// Construct an instance of the ListWafPackagesOptions model
+				listWafPackagesOptionsModel := new(wafrulepackagesapiv1.ListWafPackagesOptions)
+				listWafPackagesOptionsModel.Name = core.StringPtr("Wordpress-rules")
+				listWafPackagesOptionsModel.Order = core.StringPtr("status")
+				listWafPackagesOptionsModel.Direction = core.StringPtr("desc")
+				listWafPackagesOptionsModel.Match = core.StringPtr("all")
+				listWafPackagesOptionsModel.Page = core.Int64Ptr(int64(3))
+
+				pager, err := wafRulePackagesApiService.NewWafPackagesPager(listWafPackagesOptionsModel)
+				Expect(err).ToNot(BeNil())
+				Expect(pager).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
 	})
 	Describe(`GetWafPackage(getWafPackageOptions *GetWafPackageOptions) - Operation response error`, func() {
 		crn := "testString"
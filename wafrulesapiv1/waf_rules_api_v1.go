@@ -178,6 +178,12 @@ func (wafRulesApi *WafRulesApiV1) DisableRetries() {
 	wafRulesApi.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (wafRulesApi *WafRulesApiV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(wafRulesApi.Service, policy)
+}
+
 // ListWafRules : List all WAF rules
 // List all Web Application Firewall (WAF) rules.
 func (wafRulesApi *WafRulesApiV1) ListWafRules(listWafRulesOptions *ListWafRulesOptions) (result *WafRulesResponse, response *core.DetailedResponse, err error) {
@@ -255,6 +261,7 @@ func (wafRulesApi *WafRulesApiV1) ListWafRulesWithContext(ctx context.Context, l
 	var rawResponse map[string]json.RawMessage
 	response, err = wafRulesApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWafRulesResponse)
@@ -316,6 +323,7 @@ func (wafRulesApi *WafRulesApiV1) GetWafRuleWithContext(ctx context.Context, get
 	var rawResponse map[string]json.RawMessage
 	response, err = wafRulesApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWafRuleResponse)
@@ -390,6 +398,7 @@ func (wafRulesApi *WafRulesApiV1) UpdateWafRuleWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = wafRulesApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWafRuleResponse)
@@ -980,3 +989,96 @@ func UnmarshalWafRulesResponse(m map[string]json.RawMessage, result interface{})
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// Retrieve the value to be passed to a request to access the next page of results
+func (resp *WafRulesResponse) GetNextPage() (*int64, error) {
+	if core.IsNil(resp.ResultInfo) || resp.ResultInfo.Page == nil || resp.ResultInfo.PerPage == nil || resp.ResultInfo.TotalCount == nil {
+		return nil, nil
+	}
+	if resp.ResultInfo.Count != nil && *resp.ResultInfo.Count == 0 {
+		return nil, nil
+	}
+	if *resp.ResultInfo.Page**resp.ResultInfo.PerPage >= *resp.ResultInfo.TotalCount {
+		return nil, nil
+	}
+	return core.Int64Ptr(*resp.ResultInfo.Page + 1), nil
+}
+
+// WafRulesPager can be used to simplify the use of the "ListWafRules" method.
+type WafRulesPager struct {
+	hasNext     bool
+	options     *ListWafRulesOptions
+	client      *WafRulesApiV1
+	pageContext struct {
+		next *int64
+	}
+}
+
+// NewWafRulesPager returns a new WafRulesPager instance.
+func (wafRulesApi *WafRulesApiV1) NewWafRulesPager(options *ListWafRulesOptions) (pager *WafRulesPager, err error) {
+	if options.Page != nil && *options.Page > 1 {
+		err = fmt.Errorf("the 'options.Page' field should not be set")
+		return
+	}
+
+	var optionsCopy ListWafRulesOptions = *options
+	pager = &WafRulesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  wafRulesApi,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *WafRulesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *WafRulesPager) GetNextWithContext(ctx context.Context) (page []WafRulesResponseResultItem, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Page = pager.pageContext.next
+
+	result, _, err := pager.client.ListWafRulesWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+
+	next, err := result.GetNextPage()
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Result
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *WafRulesPager) GetAllWithContext(ctx context.Context) (allItems []WafRulesResponseResultItem, err error) {
+	for pager.HasNext() {
+		var nextPage []WafRulesResponseResultItem
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *WafRulesPager) GetNext() (page []WafRulesResponseResultItem, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *WafRulesPager) GetAll() (allItems []WafRulesResponseResultItem, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
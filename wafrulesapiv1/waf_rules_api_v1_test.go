@@ -412,6 +412,125 @@ var _ = Describe(`WafRulesApiV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listWafRulesPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						Expect(req.URL.Query()["page"]).To(BeNil())
+						fmt.Fprintf(res, "%s", `{"success": true, "errors": [["Errors"]], "messages": [["Messages"]], "result": [{"id": "f939de3be84e66e757adcdcb87908023", "description": "SQL-injection-prevention-for-SELECT-statements", "priority": "5", "group": {"id": "de677e5818985db1285d0e80225f06e5", "name": "Project abc"}, "package_id": "a25a9a7e9c00afc1fb2e0245519d725b", "allowed_modes": ["AllowedModes"], "mode": "on"}], "result_info": {"page": 1, "per_page": 1, "count": 1, "total_count": 2}}`)
+					} else if requestNumber == 2 {
+						Expect(req.URL.Query()["page"]).To(Equal([]string{fmt.Sprint(int64(2))}))
+						fmt.Fprintf(res, "%s", `{"success": true, "errors": [["Errors"]], "messages": [["Messages"]], "result": [{"id": "f939de3be84e66e757adcdcb87908023", "description": "SQL-injection-prevention-for-SELECT-statements", "priority": "5", "group": {"id": "de677e5818985db1285d0e80225f06e5", "name": "Project abc"}, "package_id": "a25a9a7e9c00afc1fb2e0245519d725b", "allowed_modes": ["AllowedModes"], "mode": "on"}], "result_info": {"page": 2, "per_page": 1, "count": 1, "total_count": 2}}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use WafRulesPager.GetNext successfully`, func() {
+				wafRulesApiService, serviceErr := wafrulesapiv1.NewWafRulesApiV1(&wafrulesapiv1.WafRulesApiV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+					Crn:           core.StringPtr(crn),
+					ZoneID:        core.StringPtr(zoneID),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(wafRulesApiService).ToNot(BeNil())
+
+				// Construct an instance of the ListWafRulesOptions model
+				listWafRulesOptionsModel := new(wafrulesapiv1.ListWafRulesOptions)
+				listWafRulesOptionsModel.PackageID = core.StringPtr("testString")
+				listWafRulesOptionsModel.Mode = core.StringPtr("on")
+				listWafRulesOptionsModel.Priority = core.StringPtr("5")
+				listWafRulesOptionsModel.Match = core.StringPtr("all")
+				listWafRulesOptionsModel.Order = core.StringPtr("status")
+				listWafRulesOptionsModel.GroupID = core.StringPtr("de677e5818985db1285d0e80225f06e5")
+				listWafRulesOptionsModel.Description = core.StringPtr("SQL-injection-prevention-for-SELECT-statements")
+				listWafRulesOptionsModel.Direction = core.StringPtr("desc")
+
+				pager, err := wafRulesApiService.NewWafRulesPager(listWafRulesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []wafrulesapiv1.WafRulesResponseResultItem
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use WafRulesPager.GetAll successfully`, func() {
+				wafRulesApiService, serviceErr := wafrulesapiv1.NewWafRulesApiV1(&wafrulesapiv1.WafRulesApiV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+					Crn:           core.StringPtr(crn),
+					ZoneID:        core.StringPtr(zoneID),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(wafRulesApiService).ToNot(BeNil())
+
+				// Construct an instance of the ListWafRulesOptions model
+				listWafRulesOptionsModel := new(wafrulesapiv1.ListWafRulesOptions)
+				listWafRulesOptionsModel.PackageID = core.StringPtr("testString")
+				listWafRulesOptionsModel.Mode = core.StringPtr("on")
+				listWafRulesOptionsModel.Priority = core.StringPtr("5")
+				listWafRulesOptionsModel.Match = core.StringPtr("all")
+				listWafRulesOptionsModel.Order = core.StringPtr("status")
+				listWafRulesOptionsModel.GroupID = core.StringPtr("de677e5818985db1285d0e80225f06e5")
+				listWafRulesOptionsModel.Description = core.StringPtr("SQL-injection-prevention-for-SELECT-statements")
+				listWafRulesOptionsModel.Direction = core.StringPtr("desc")
+
+				pager, err := wafRulesApiService.NewWafRulesPager(listWafRulesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Invoke NewWafRulesPager with a page already set`, func() {
+				wafRulesApiService, serviceErr := wafrulesapiv1.NewWafRulesApiV1(&wafrulesapiv1.WafRulesApiV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+					Crn:           core.StringPtr(crn),
+					ZoneID:        core.StringPtr(zoneID),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(wafRulesApiService).ToNot(BeNil())
+
+				// Construct an instance of the ListWafRulesOptions model
+				listWafRulesOptionsModel := new(wafrulesapiv1.ListWafRulesOptions)
+				listWafRulesOptionsModel.PackageID = core.StringPtr("testString")
+				listWafRulesOptionsModel.Mode = core.StringPtr("on")
+				listWafRulesOptionsModel.Priority = core.StringPtr("5")
+				listWafRulesOptionsModel.Match = core.StringPtr("all")
+				listWafRulesOptionsModel.Order = core.StringPtr("status")
+				listWafRulesOptionsModel.GroupID = core.StringPtr("de677e5818985db1285d0e80225f06e5")
+				listWafRulesOptionsModel.Description = core.StringPtr("SQL-injection-prevention-for-SELECT-statements")
+				listWafRulesOptionsModel.Direction = core.StringPtr("desc")
+				listWafRulesOptionsModel.Page = core.Int64Ptr(int64(3))
+
+				pager, err := wafRulesApiService.NewWafRulesPager(listWafRulesOptionsModel)
+				Expect(err).ToNot(BeNil())
+				Expect(pager).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
 	})
 	Describe(`GetWafRule(getWafRuleOptions *GetWafRuleOptions) - Operation response error`, func() {
 		crn := "testString"
@@ -171,6 +171,12 @@ func (webhooks *WebhooksV1) DisableRetries() {
 	webhooks.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (webhooks *WebhooksV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(webhooks.Service, policy)
+}
+
 // ListWebhooks : List alert webhooks
 // List configured alert webhooks for the CIS instance.
 func (webhooks *WebhooksV1) ListWebhooks(listWebhooksOptions *ListWebhooksOptions) (result *ListAlertWebhooksResp, response *core.DetailedResponse, err error) {
@@ -214,6 +220,7 @@ func (webhooks *WebhooksV1) ListWebhooksWithContext(ctx context.Context, listWeb
 	var rawResponse map[string]json.RawMessage
 	response, err = webhooks.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -286,6 +293,7 @@ func (webhooks *WebhooksV1) CreateAlertWebhookWithContext(ctx context.Context, c
 	var rawResponse map[string]json.RawMessage
 	response, err = webhooks.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -347,6 +355,7 @@ func (webhooks *WebhooksV1) GetWebhookWithContext(ctx context.Context, getWebhoo
 	var rawResponse map[string]json.RawMessage
 	response, err = webhooks.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -424,6 +433,7 @@ func (webhooks *WebhooksV1) UpdateAlertWebhookWithContext(ctx context.Context, u
 	var rawResponse map[string]json.RawMessage
 	response, err = webhooks.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -485,6 +495,7 @@ func (webhooks *WebhooksV1) DeleteWebhookWithContext(ctx context.Context, delete
 	var rawResponse map[string]json.RawMessage
 	response, err = webhooks.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
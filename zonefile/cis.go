@@ -0,0 +1,397 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zonefile
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+)
+
+// DnsrecordInput returns the CIS DNS record for "record". SRV, CAA, DS and LOC
+// records are described by "data"; the other types by "content" and, for MX,
+// "priority". SOA records are managed by CIS and can't be converted.
+func DnsrecordInput(record Record) (*dnsrecordsv1.DnsrecordInput, error) {
+	if err := record.Validate(); err != nil {
+		return nil, err
+	}
+
+	input := &dnsrecordsv1.DnsrecordInput{
+		Name: core.StringPtr(record.Name),
+		Type: core.StringPtr(record.Type),
+	}
+	if record.TTL > 0 {
+		input.TTL = core.Int64Ptr(record.TTL)
+	}
+
+	rdata := record.Rdata
+	switch record.Type {
+	case TypeA, TypeAaaa, TypeCname, TypeNs, TypePtr:
+		input.Content = core.StringPtr(rdata[0])
+	case TypeMx:
+		input.Priority = core.Int64Ptr(mustParseInt(rdata[0]))
+		input.Content = core.StringPtr(rdata[1])
+	case TypeTxt:
+		input.Content = core.StringPtr(strings.Join(rdata, ""))
+	case TypeSrv:
+		service, protocol, host, _ := splitServiceName(record.Name)
+		input.Data = map[string]interface{}{
+			"service":  service,
+			"proto":    protocol,
+			"name":     host,
+			"priority": mustParseInt(rdata[0]),
+			"weight":   mustParseInt(rdata[1]),
+			"port":     mustParseInt(rdata[2]),
+			"target":   rdata[3],
+		}
+	case TypeCaa:
+		input.Data = map[string]interface{}{
+			"flags": mustParseInt(rdata[0]),
+			"tag":   rdata[1],
+			"value": rdata[2],
+		}
+	case TypeDs:
+		input.Data = map[string]interface{}{
+			"key_tag":     mustParseInt(rdata[0]),
+			"algorithm":   mustParseInt(rdata[1]),
+			"digest_type": mustParseInt(rdata[2]),
+			"digest":      strings.ToUpper(rdata[3]),
+		}
+	case TypeLoc:
+		loc, _ := parseLocation(rdata)
+		input.Data = loc.data()
+	default:
+		return nil, fmt.Errorf("%s records can't be managed through the CIS DNS records API", record.Type)
+	}
+	return input, nil
+}
+
+// DnsrecordInputs returns the CIS DNS records for the zone's records, leaving
+// out the SOA record, which CIS manages itself.
+func (zone *Zone) DnsrecordInputs() ([]dnsrecordsv1.DnsrecordInput, error) {
+	inputs := make([]dnsrecordsv1.DnsrecordInput, 0, len(zone.Records))
+	for _, record := range zone.Records {
+		if record.Type == TypeSoa {
+			continue
+		}
+		input, err := DnsrecordInput(record)
+		if err != nil {
+			if record.Line > 0 {
+				err = &ParseError{Line: record.Line, Err: err}
+			}
+			return nil, err
+		}
+		inputs = append(inputs, *input)
+	}
+	return inputs, nil
+}
+
+// FromDnsrecordInput returns the record described by a CIS DNS record input.
+// Names that are relative, "@" or empty are qualified with "origin".
+func FromDnsrecordInput(input dnsrecordsv1.DnsrecordInput, origin string) (Record, error) {
+	if input.Type == nil {
+		return Record{}, fmt.Errorf("DNS record has no type")
+	}
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+	return fromCIS(*input.Type, name, origin, input.TTL, input.Content, input.Priority, input.Data)
+}
+
+// FromDnsrecordDetails returns the record described by a CIS DNS record, as
+// returned by ListAllDnsRecords or GetDnsRecord.
+func FromDnsrecordDetails(details dnsrecordsv1.DnsrecordDetails) (Record, error) {
+	if details.Type == nil || details.Name == nil {
+		return Record{}, fmt.Errorf("DNS record has no type or name")
+	}
+	zoneName := ""
+	if details.ZoneName != nil {
+		zoneName = *details.ZoneName
+	}
+	return fromCIS(*details.Type, *details.Name, zoneName, details.TTL, details.Content, details.Priority, details.Data)
+}
+
+// NewZoneFromDnsrecords returns a zone holding the given CIS DNS records.
+func NewZoneFromDnsrecords(origin string, records []dnsrecordsv1.DnsrecordDetails) (*Zone, error) {
+	zone := &Zone{Origin: strings.TrimSuffix(strings.ToLower(strings.TrimSpace(origin)), ".")}
+	for _, details := range records {
+		record, err := FromDnsrecordDetails(details)
+		if err != nil {
+			return nil, err
+		}
+		zone.Records = append(zone.Records, record)
+	}
+	return zone, nil
+}
+
+func fromCIS(recordType string, name string, origin string, ttl *int64, content *string, priority *int64, data map[string]interface{}) (record Record, err error) {
+	origin = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(origin)), ".")
+	record.Type = strings.ToUpper(recordType)
+	if ttl != nil {
+		record.TTL = *ttl
+	}
+
+	contentOf := func() (string, error) {
+		if content == nil {
+			return "", fmt.Errorf("%s record for %s has no content", record.Type, name)
+		}
+		return *content, nil
+	}
+
+	switch record.Type {
+	case TypeA, TypeAaaa:
+		var value string
+		if value, err = contentOf(); err == nil {
+			record.Rdata = []string{value}
+		}
+	case TypeCname, TypeNs, TypePtr:
+		var value string
+		if value, err = contentOf(); err == nil {
+			value, err = qualifyHostname(value)
+			record.Rdata = []string{value}
+		}
+	case TypeMx:
+		var value string
+		if value, err = contentOf(); err == nil {
+			if priority == nil {
+				return record, fmt.Errorf("MX record for %s has no priority", name)
+			}
+			value, err = qualifyHostname(value)
+			record.Rdata = []string{strconv.FormatInt(*priority, 10), value}
+		}
+	case TypeTxt:
+		var value string
+		if value, err = contentOf(); err == nil {
+			record.Rdata = splitText(value)
+		}
+	case TypeSrv:
+		if name == "" || name == "@" {
+			name = strings.Join([]string{dataString(data, "service"), dataString(data, "proto"), dataString(data, "name")}, ".")
+		}
+		var target string
+		target, err = qualifyHostname(dataString(data, "target"))
+		record.Rdata = []string{dataInt(data, "priority"), dataInt(data, "weight"), dataInt(data, "port"), target}
+	case TypeCaa:
+		record.Rdata = []string{dataInt(data, "flags"), dataString(data, "tag"), dataString(data, "value")}
+	case TypeDs:
+		record.Rdata = []string{dataInt(data, "key_tag"), dataInt(data, "algorithm"), dataInt(data, "digest_type"), dataString(data, "digest")}
+	case TypeLoc:
+		record.Rdata = locationFromData(data).rdata()
+	default:
+		err = fmt.Errorf("unsupported record type %q", record.Type)
+	}
+	if err != nil {
+		return
+	}
+
+	record.Name, err = qualifyCISName(name, origin)
+	if err != nil {
+		return
+	}
+	err = record.Validate()
+	return
+}
+
+// qualifyCISName qualifies a name the way the CIS API does: a name is taken as
+// relative to the zone unless it already ends with the zone name.
+func qualifyCISName(name string, origin string) (string, error) {
+	trimmed := strings.ToLower(strings.TrimSpace(name))
+	if trimmed == "" || trimmed == "@" {
+		trimmed = "@"
+	} else if !strings.HasSuffix(trimmed, ".") && (origin == "" || trimmed == origin || strings.HasSuffix(trimmed, "."+origin)) {
+		trimmed += "."
+	}
+	return qualifyName(trimmed, origin)
+}
+
+// qualifyHostname qualifies a host name found in record content, which CIS
+// always reports in full.
+func qualifyHostname(name string) (string, error) {
+	trimmed := strings.TrimSpace(name)
+	if trimmed == "" {
+		return "", fmt.Errorf("empty name")
+	}
+	if !strings.HasSuffix(trimmed, ".") {
+		trimmed += "."
+	}
+	return qualifyName(trimmed, "")
+}
+
+func mustParseInt(s string) int64 {
+	value, _ := strconv.ParseInt(s, 10, 64)
+	return value
+}
+
+func dataString(data map[string]interface{}, key string) string {
+	switch value := data[key].(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+func dataInt(data map[string]interface{}, key string) string {
+	switch value := data[key].(type) {
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case json.Number:
+		return value.String()
+	default:
+		return dataString(data, key)
+	}
+}
+
+func dataFloat(data map[string]interface{}, key string) float64 {
+	value, _ := strconv.ParseFloat(dataInt(data, key), 64)
+	return value
+}
+
+// location is the content of a LOC record (RFC 1876). Sizes and altitude are
+// in meters.
+type location struct {
+	latDegrees, latMinutes     int64
+	latSeconds                 float64
+	latDirection               string
+	longDegrees, longMinutes   int64
+	longSeconds                float64
+	longDirection              string
+	altitude                   float64
+	size, horizontal, vertical float64
+}
+
+// parseLocation parses LOC rdata of the form
+// "d1 [m1 [s1]] {N|S} d2 [m2 [s2]] {E|W} alt[m] [siz[m] [hp[m] [vp[m]]]]".
+func parseLocation(rdata []string) (loc location, err error) {
+	invalid := fmt.Errorf("invalid LOC rdata %q", strings.Join(rdata, " "))
+
+	fields := rdata
+	parseCoordinate := func(directions string, maxDegrees int64) (degrees int64, minutes int64, seconds float64, direction string, ok bool) {
+		var parts []string
+		for len(fields) > 0 && len(parts) < 4 {
+			field := strings.ToUpper(fields[0])
+			fields = fields[1:]
+			if strings.Contains(directions, field) && len(field) == 1 {
+				direction = field
+				break
+			}
+			parts = append(parts, field)
+		}
+		if direction == "" || len(parts) == 0 || len(parts) > 3 {
+			return
+		}
+		var parseErr error
+		if degrees, parseErr = strconv.ParseInt(parts[0], 10, 64); parseErr != nil || degrees < 0 || degrees > maxDegrees {
+			return
+		}
+		if len(parts) > 1 {
+			if minutes, parseErr = strconv.ParseInt(parts[1], 10, 64); parseErr != nil || minutes < 0 || minutes > 59 {
+				return
+			}
+		}
+		if len(parts) > 2 {
+			if seconds, parseErr = strconv.ParseFloat(parts[2], 64); parseErr != nil || seconds < 0 || seconds >= 60 {
+				return
+			}
+		}
+		ok = true
+		return
+	}
+
+	var ok bool
+	if loc.latDegrees, loc.latMinutes, loc.latSeconds, loc.latDirection, ok = parseCoordinate("NS", 90); !ok {
+		return loc, invalid
+	}
+	if loc.longDegrees, loc.longMinutes, loc.longSeconds, loc.longDirection, ok = parseCoordinate("EW", 180); !ok {
+		return loc, invalid
+	}
+	if len(fields) == 0 || len(fields) > 4 {
+		return loc, invalid
+	}
+
+	values := []float64{0, 1, 10000, 10}
+	for i, field := range fields {
+		value, parseErr := strconv.ParseFloat(strings.TrimSuffix(strings.ToLower(field), "m"), 64)
+		if parseErr != nil || (i > 0 && value < 0) {
+			return loc, invalid
+		}
+		values[i] = value
+	}
+	loc.altitude, loc.size, loc.horizontal, loc.vertical = values[0], values[1], values[2], values[3]
+	return loc, nil
+}
+
+func locationFromData(data map[string]interface{}) location {
+	return location{
+		latDegrees:    mustParseInt(dataInt(data, "lat_degrees")),
+		latMinutes:    mustParseInt(dataInt(data, "lat_minutes")),
+		latSeconds:    dataFloat(data, "lat_seconds"),
+		latDirection:  strings.ToUpper(dataString(data, "lat_direction")),
+		longDegrees:   mustParseInt(dataInt(data, "long_degrees")),
+		longMinutes:   mustParseInt(dataInt(data, "long_minutes")),
+		longSeconds:   dataFloat(data, "long_seconds"),
+		longDirection: strings.ToUpper(dataString(data, "long_direction")),
+		altitude:      dataFloat(data, "altitude"),
+		size:          dataFloat(data, "size"),
+		horizontal:    dataFloat(data, "precision_horz"),
+		vertical:      dataFloat(data, "precision_vert"),
+	}
+}
+
+func (loc location) data() map[string]interface{} {
+	return map[string]interface{}{
+		"lat_degrees":    loc.latDegrees,
+		"lat_minutes":    loc.latMinutes,
+		"lat_seconds":    loc.latSeconds,
+		"lat_direction":  loc.latDirection,
+		"long_degrees":   loc.longDegrees,
+		"long_minutes":   loc.longMinutes,
+		"long_seconds":   loc.longSeconds,
+		"long_direction": loc.longDirection,
+		"altitude":       loc.altitude,
+		"size":           loc.size,
+		"precision_horz": loc.horizontal,
+		"precision_vert": loc.vertical,
+	}
+}
+
+func (loc location) rdata() []string {
+	meters := func(value float64) string {
+		return strconv.FormatFloat(value, 'f', 2, 64) + "m"
+	}
+	return []string{
+		strconv.FormatInt(loc.latDegrees, 10),
+		strconv.FormatInt(loc.latMinutes, 10),
+		strconv.FormatFloat(loc.latSeconds, 'f', 3, 64),
+		loc.latDirection,
+		strconv.FormatInt(loc.longDegrees, 10),
+		strconv.FormatInt(loc.longMinutes, 10),
+		strconv.FormatFloat(loc.longSeconds, 'f', 3, 64),
+		loc.longDirection,
+		meters(loc.altitude),
+		meters(loc.size),
+		meters(loc.horizontal),
+		meters(loc.vertical),
+	}
+}
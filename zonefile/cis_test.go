@@ -0,0 +1,120 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zonefile
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZoneDnsrecordInputs(t *testing.T) {
+	zone, err := ParseString(exampleZone, "")
+	assert.Nil(t, err)
+
+	inputs, err := zone.DnsrecordInputs()
+	assert.Nil(t, err)
+	assert.Len(t, inputs, 11)
+
+	mx := inputs[1]
+	assert.Equal(t, "MX", *mx.Type)
+	assert.Equal(t, "example.com", *mx.Name)
+	assert.Equal(t, "mail.example.com", *mx.Content)
+	assert.Equal(t, int64(10), *mx.Priority)
+	assert.Equal(t, int64(3600), *mx.TTL)
+
+	assert.Equal(t, "v=spf1 include:_spf.example.net ~all"+`second "quoted" string`, *inputs[5].Content)
+
+	srv := inputs[6]
+	assert.Nil(t, srv.Content)
+	assert.Equal(t, map[string]interface{}{
+		"service": "_sip", "proto": "_udp", "name": "example.com",
+		"priority": int64(10), "weight": int64(60), "port": int64(5060), "target": "sip.example.com",
+	}, srv.Data)
+
+	assert.Equal(t, map[string]interface{}{"flags": int64(0), "tag": "issue", "value": "letsencrypt.org"}, inputs[7].Data)
+	assert.Equal(t, int64(12345), inputs[8].Data["key_tag"])
+
+	loc := inputs[9].Data
+	assert.Equal(t, int64(52), loc["lat_degrees"])
+	assert.Equal(t, "E", loc["long_direction"])
+	assert.Equal(t, float64(-2), loc["altitude"])
+	assert.Equal(t, float64(10000), loc["precision_horz"])
+}
+
+func TestDnsrecordInputRejectsSOA(t *testing.T) {
+	zone, err := ParseString(exampleZone, "")
+	assert.Nil(t, err)
+
+	_, err = DnsrecordInput(zone.Records[0])
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "SOA records can't be managed")
+}
+
+func TestFromDnsrecordDetails(t *testing.T) {
+	var data map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(`{"service": "_sip", "proto": "_tcp", "name": "example.com", "priority": 1, "weight": 2, "port": 5060, "target": "sip.example.net"}`), &data))
+
+	records := []dnsrecordsv1.DnsrecordDetails{
+		{Name: core.StringPtr("www.example.com"), Type: core.StringPtr("CNAME"), Content: core.StringPtr("example.com"), TTL: core.Int64Ptr(1), ZoneName: core.StringPtr("example.com")},
+		{Name: core.StringPtr("_sip._tcp.example.com"), Type: core.StringPtr("SRV"), Data: data, TTL: core.Int64Ptr(120), ZoneName: core.StringPtr("example.com")},
+		{Name: core.StringPtr("example.com"), Type: core.StringPtr("MX"), Content: core.StringPtr("mx.example.net"), Priority: core.Int64Ptr(5), TTL: core.Int64Ptr(300), ZoneName: core.StringPtr("example.com")},
+	}
+	zone, err := NewZoneFromDnsrecords("example.com.", records)
+	assert.Nil(t, err)
+	assert.Equal(t, "$ORIGIN example.com.\n"+
+		"www\t1\tIN\tCNAME example.com.\n"+
+		"_sip._tcp\t120\tIN\tSRV 1 2 5060 sip.example.net.\n"+
+		"@\t300\tIN\tMX 5 mx.example.net.\n", zone.String())
+
+	_, err = FromDnsrecordDetails(dnsrecordsv1.DnsrecordDetails{Name: core.StringPtr("www.example.com"), Type: core.StringPtr("A")})
+	assert.NotNil(t, err)
+}
+
+func TestFromDnsrecordInput(t *testing.T) {
+	record, err := FromDnsrecordInput(dnsrecordsv1.DnsrecordInput{
+		Name:    core.StringPtr("www"),
+		Type:    core.StringPtr("aaaa"),
+		Content: core.StringPtr("2001:db8::1"),
+	}, "example.com")
+	assert.Nil(t, err)
+	assert.Equal(t, Record{Name: "www.example.com", Type: TypeAaaa, Rdata: []string{"2001:db8::1"}}, record)
+
+	record, err = FromDnsrecordInput(dnsrecordsv1.DnsrecordInput{
+		Name: core.StringPtr("@"),
+		Type: core.StringPtr("CAA"),
+		Data: map[string]interface{}{"flags": 128, "tag": "iodef", "value": "mailto:security@example.com"},
+	}, "example.com")
+	assert.Nil(t, err)
+	assert.Equal(t, "example.com", record.Name)
+	assert.Equal(t, []string{"128", "iodef", "mailto:security@example.com"}, record.Rdata)
+
+	_, err = FromDnsrecordInput(dnsrecordsv1.DnsrecordInput{Name: core.StringPtr("www"), Type: core.StringPtr("A"), Content: core.StringPtr("192.0.2.256")}, "example.com")
+	assert.NotNil(t, err)
+
+	// LOC data round-trips through its rdata.
+	zone, err := ParseString("office LOC 52 22 23 N 4 53 32 E -2m\n", "example.com")
+	assert.Nil(t, err)
+	input, err := DnsrecordInput(zone.Records[0])
+	assert.Nil(t, err)
+	record, err = FromDnsrecordInput(*input, "example.com")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"52", "22", "23.000", "N", "4", "53", "32.000", "E", "-2.00m", "1.00m", "10000.00m", "10.00m"}, record.Rdata)
+}
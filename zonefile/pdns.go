@@ -0,0 +1,240 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zonefile
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+)
+
+// ResourceRecordRdata returns the DNS Services rdata for "record". DNS Services
+// supports A, AAAA, CNAME, MX, PTR, SRV and TXT records.
+func ResourceRecordRdata(record Record) (dnssvcsv1.ResourceRecordInputRdataIntf, error) {
+	if err := record.Validate(); err != nil {
+		return nil, err
+	}
+
+	rdata := record.Rdata
+	switch record.Type {
+	case TypeA:
+		return &dnssvcsv1.ResourceRecordInputRdataRdataARecord{Ip: core.StringPtr(rdata[0])}, nil
+	case TypeAaaa:
+		return &dnssvcsv1.ResourceRecordInputRdataRdataAaaaRecord{Ip: core.StringPtr(rdata[0])}, nil
+	case TypeCname:
+		return &dnssvcsv1.ResourceRecordInputRdataRdataCnameRecord{Cname: core.StringPtr(rdata[0])}, nil
+	case TypeMx:
+		return &dnssvcsv1.ResourceRecordInputRdataRdataMxRecord{
+			Preference: core.Int64Ptr(mustParseInt(rdata[0])),
+			Exchange:   core.StringPtr(rdata[1]),
+		}, nil
+	case TypePtr:
+		return &dnssvcsv1.ResourceRecordInputRdataRdataPtrRecord{Ptrdname: core.StringPtr(rdata[0])}, nil
+	case TypeSrv:
+		return &dnssvcsv1.ResourceRecordInputRdataRdataSrvRecord{
+			Priority: core.Int64Ptr(mustParseInt(rdata[0])),
+			Weight:   core.Int64Ptr(mustParseInt(rdata[1])),
+			Port:     core.Int64Ptr(mustParseInt(rdata[2])),
+			Target:   core.StringPtr(rdata[3]),
+		}, nil
+	case TypeTxt:
+		return &dnssvcsv1.ResourceRecordInputRdataRdataTxtRecord{Text: core.StringPtr(strings.Join(rdata, ""))}, nil
+	}
+	return nil, fmt.Errorf("%s records are not supported by DNS Services", record.Type)
+}
+
+// CreateResourceRecordOptions returns the options that create "record" in the
+// given DNS Services zone. An SRV record's owner name is split into the
+// service, protocol and name fields.
+func CreateResourceRecordOptions(record Record, instanceID string, dnszoneID string) (*dnssvcsv1.CreateResourceRecordOptions, error) {
+	rdata, err := ResourceRecordRdata(record)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &dnssvcsv1.CreateResourceRecordOptions{
+		InstanceID: core.StringPtr(instanceID),
+		DnszoneID:  core.StringPtr(dnszoneID),
+		Type:       core.StringPtr(record.Type),
+		Name:       core.StringPtr(record.Name),
+		Rdata:      rdata,
+	}
+	if record.TTL > 0 {
+		options.TTL = core.Int64Ptr(record.TTL)
+	}
+	if record.Type == TypeSrv {
+		service, protocol, host, _ := splitServiceName(record.Name)
+		options.Service = core.StringPtr(service)
+		options.Protocol = core.StringPtr(strings.TrimPrefix(protocol, "_"))
+		options.Name = core.StringPtr(host)
+	}
+	return options, nil
+}
+
+// CreateResourceRecordOptions returns the options that create the zone's
+// records in the given DNS Services zone, leaving out the SOA and NS records,
+// which DNS Services manages itself.
+func (zone *Zone) CreateResourceRecordOptions(instanceID string, dnszoneID string) ([]*dnssvcsv1.CreateResourceRecordOptions, error) {
+	optionsList := make([]*dnssvcsv1.CreateResourceRecordOptions, 0, len(zone.Records))
+	for _, record := range zone.Records {
+		if record.Type == TypeSoa || record.Type == TypeNs {
+			continue
+		}
+		options, err := CreateResourceRecordOptions(record, instanceID, dnszoneID)
+		if err != nil {
+			if record.Line > 0 {
+				err = &ParseError{Line: record.Line, Err: err}
+			}
+			return nil, err
+		}
+		optionsList = append(optionsList, options)
+	}
+	return optionsList, nil
+}
+
+// FromCreateResourceRecordOptions returns the record described by the options
+// that create it. Relative names are qualified with "origin".
+func FromCreateResourceRecordOptions(options *dnssvcsv1.CreateResourceRecordOptions, origin string) (Record, error) {
+	if options == nil || options.Type == nil {
+		return Record{}, fmt.Errorf("resource record has no type")
+	}
+
+	fields := map[string]interface{}{}
+	switch rdata := options.Rdata.(type) {
+	case *dnssvcsv1.ResourceRecordInputRdata:
+		fields = map[string]interface{}{
+			"ip": rdata.Ip, "cname": rdata.Cname, "exchange": rdata.Exchange, "preference": rdata.Preference,
+			"port": rdata.Port, "priority": rdata.Priority, "target": rdata.Target, "weight": rdata.Weight,
+			"text": rdata.Text, "ptrdname": rdata.Ptrdname,
+		}
+	case *dnssvcsv1.ResourceRecordInputRdataRdataARecord:
+		fields["ip"] = rdata.Ip
+	case *dnssvcsv1.ResourceRecordInputRdataRdataAaaaRecord:
+		fields["ip"] = rdata.Ip
+	case *dnssvcsv1.ResourceRecordInputRdataRdataCnameRecord:
+		fields["cname"] = rdata.Cname
+	case *dnssvcsv1.ResourceRecordInputRdataRdataMxRecord:
+		fields["exchange"], fields["preference"] = rdata.Exchange, rdata.Preference
+	case *dnssvcsv1.ResourceRecordInputRdataRdataPtrRecord:
+		fields["ptrdname"] = rdata.Ptrdname
+	case *dnssvcsv1.ResourceRecordInputRdataRdataSrvRecord:
+		fields["port"], fields["priority"], fields["target"], fields["weight"] = rdata.Port, rdata.Priority, rdata.Target, rdata.Weight
+	case *dnssvcsv1.ResourceRecordInputRdataRdataTxtRecord:
+		fields["text"] = rdata.Text
+	case nil:
+		return Record{}, fmt.Errorf("resource record has no rdata")
+	default:
+		return Record{}, fmt.Errorf("unsupported rdata type %T", rdata)
+	}
+
+	name := ""
+	if options.Name != nil {
+		name = *options.Name
+	}
+	return fromResourceRecord(*options.Type, name, origin, options.TTL, options.Service, options.Protocol, fields)
+}
+
+// FromResourceRecord returns the record described by a DNS Services resource
+// record, as returned by ListResourceRecords or GetResourceRecord.
+func FromResourceRecord(resourceRecord dnssvcsv1.ResourceRecord) (Record, error) {
+	if resourceRecord.Type == nil || resourceRecord.Name == nil {
+		return Record{}, fmt.Errorf("resource record has no type or name")
+	}
+	return fromResourceRecord(*resourceRecord.Type, *resourceRecord.Name, "", resourceRecord.TTL, resourceRecord.Service, resourceRecord.Protocol, resourceRecord.Rdata)
+}
+
+// NewZoneFromResourceRecords returns a zone holding the given DNS Services
+// resource records.
+func NewZoneFromResourceRecords(origin string, resourceRecords []dnssvcsv1.ResourceRecord) (*Zone, error) {
+	zone := &Zone{Origin: strings.TrimSuffix(strings.ToLower(strings.TrimSpace(origin)), ".")}
+	for _, resourceRecord := range resourceRecords {
+		record, err := FromResourceRecord(resourceRecord)
+		if err != nil {
+			return nil, err
+		}
+		zone.Records = append(zone.Records, record)
+	}
+	return zone, nil
+}
+
+func fromResourceRecord(recordType string, name string, origin string, ttl *int64, service *string, protocol *string, rdata map[string]interface{}) (record Record, err error) {
+	origin = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(origin)), ".")
+	record.Type = strings.ToUpper(recordType)
+	if ttl != nil {
+		record.TTL = *ttl
+	}
+
+	field := func(key string) string {
+		switch value := rdata[key].(type) {
+		case *string:
+			if value != nil {
+				return *value
+			}
+		case *int64:
+			if value != nil {
+				return strconv.FormatInt(*value, 10)
+			}
+		default:
+			return dataInt(rdata, key)
+		}
+		return ""
+	}
+	hostname := func(key string) string {
+		if err == nil {
+			var value string
+			value, err = qualifyHostname(field(key))
+			return value
+		}
+		return ""
+	}
+
+	switch record.Type {
+	case TypeA, TypeAaaa:
+		record.Rdata = []string{field("ip")}
+	case TypeCname:
+		record.Rdata = []string{hostname("cname")}
+	case TypePtr:
+		record.Rdata = []string{hostname("ptrdname")}
+	case TypeMx:
+		record.Rdata = []string{field("preference"), hostname("exchange")}
+	case TypeSrv:
+		record.Rdata = []string{field("priority"), field("weight"), field("port"), hostname("target")}
+		if service != nil && protocol != nil {
+			prefix := *service + "._" + strings.TrimPrefix(*protocol, "_")
+			if !strings.HasPrefix(strings.ToLower(name), strings.ToLower(prefix)+".") {
+				name = prefix + "." + name
+			}
+		}
+	case TypeTxt:
+		record.Rdata = splitText(field("text"))
+	default:
+		err = fmt.Errorf("%s records are not supported by DNS Services", record.Type)
+	}
+	if err != nil {
+		return
+	}
+
+	record.Name, err = qualifyCISName(name, origin)
+	if err != nil {
+		return
+	}
+	err = record.Validate()
+	return
+}
@@ -0,0 +1,125 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zonefile
+
+import (
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/stretchr/testify/assert"
+)
+
+const privateZone = `$ORIGIN internal.example.
+$TTL 300
+@	NS	ns1.internal.example.
+app	A	10.0.0.5
+	AAAA	fd00::5
+db	CNAME	app
+@	MX	10 mail
+_ldap._tcp	SRV	0 100 389 dc1
+note	TXT	"hello" " world"
+`
+
+func TestZoneCreateResourceRecordOptions(t *testing.T) {
+	zone, err := ParseString(privateZone, "")
+	assert.Nil(t, err)
+
+	optionsList, err := zone.CreateResourceRecordOptions("instance-id", "zone-id")
+	assert.Nil(t, err)
+	assert.Len(t, optionsList, 6)
+
+	a := optionsList[0]
+	assert.Equal(t, "instance-id", *a.InstanceID)
+	assert.Equal(t, "zone-id", *a.DnszoneID)
+	assert.Equal(t, "app.internal.example", *a.Name)
+	assert.Equal(t, int64(300), *a.TTL)
+	assert.Equal(t, &dnssvcsv1.ResourceRecordInputRdataRdataARecord{Ip: core.StringPtr("10.0.0.5")}, a.Rdata)
+
+	srv := optionsList[4]
+	assert.Equal(t, "_ldap", *srv.Service)
+	assert.Equal(t, "tcp", *srv.Protocol)
+	assert.Equal(t, "internal.example", *srv.Name)
+	assert.Equal(t, int64(389), *srv.Rdata.(*dnssvcsv1.ResourceRecordInputRdataRdataSrvRecord).Port)
+
+	assert.Equal(t, "hello world", *optionsList[5].Rdata.(*dnssvcsv1.ResourceRecordInputRdataRdataTxtRecord).Text)
+
+	// Every option converts back to the record it came from.
+	for i, options := range optionsList {
+		record, err := FromCreateResourceRecordOptions(options, zone.Origin)
+		assert.Nil(t, err)
+		expected := zone.Records[i+1]
+		expected.Line = 0
+		if expected.Type == TypeTxt {
+			expected.Rdata = []string{"hello world"}
+		}
+		assert.Equal(t, expected, record)
+	}
+}
+
+func TestResourceRecordRdataRejectsUnsupportedTypes(t *testing.T) {
+	zone, err := ParseString("@ CAA 0 issue \"ca.example.net\"\n", "internal.example")
+	assert.Nil(t, err)
+
+	_, err = zone.CreateResourceRecordOptions("instance-id", "zone-id")
+	assert.NotNil(t, err)
+	assert.Equal(t, "zonefile: line 1: CAA records are not supported by DNS Services", err.Error())
+}
+
+func TestFromResourceRecord(t *testing.T) {
+	resourceRecords := []dnssvcsv1.ResourceRecord{
+		{
+			Name:  core.StringPtr("app.internal.example"),
+			Type:  core.StringPtr("A"),
+			TTL:   core.Int64Ptr(900),
+			Rdata: map[string]interface{}{"ip": "10.0.0.5"},
+		},
+		{
+			Name:     core.StringPtr("_ldap._tcp.internal.example"),
+			Type:     core.StringPtr("SRV"),
+			TTL:      core.Int64Ptr(300),
+			Service:  core.StringPtr("_ldap"),
+			Protocol: core.StringPtr("tcp"),
+			Rdata:    map[string]interface{}{"priority": float64(0), "weight": float64(100), "port": float64(389), "target": "dc1.internal.example"},
+		},
+		{
+			Name:     core.StringPtr("internal.example"),
+			Type:     core.StringPtr("SRV"),
+			Service:  core.StringPtr("_kerberos"),
+			Protocol: core.StringPtr("udp"),
+			Rdata:    map[string]interface{}{"priority": float64(0), "weight": float64(100), "port": float64(88), "target": "dc1.internal.example"},
+		},
+		{
+			Name:  core.StringPtr("mail.internal.example"),
+			Type:  core.StringPtr("MX"),
+			TTL:   core.Int64Ptr(300),
+			Rdata: map[string]interface{}{"exchange": "mx.internal.example", "preference": float64(5)},
+		},
+	}
+	zone, err := NewZoneFromResourceRecords("internal.example", resourceRecords)
+	assert.Nil(t, err)
+	zone.TTL = 300
+	assert.Equal(t, "$ORIGIN internal.example.\n"+
+		"$TTL 300\n"+
+		"app\t900\tIN\tA 10.0.0.5\n"+
+		"_ldap._tcp\tIN\tSRV 0 100 389 dc1.internal.example.\n"+
+		"_kerberos._udp\tIN\tSRV 0 100 88 dc1.internal.example.\n"+
+		"mail\tIN\tMX 5 mx.internal.example.\n", zone.String())
+
+	_, err = FromResourceRecord(dnssvcsv1.ResourceRecord{Name: core.StringPtr("x.internal.example"), Type: core.StringPtr("NS")})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,724 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package zonefile reads and writes DNS zones in the RFC 1035 master file
+// ("BIND") format used by dnsrecordbulkv1 and by the import and export
+// operations of dnssvcsv1, and converts their records to and from the
+// dnsrecordsv1 and dnssvcsv1 models.
+package zonefile
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Record types understood by the parser.
+const (
+	TypeA     = "A"
+	TypeAaaa  = "AAAA"
+	TypeCaa   = "CAA"
+	TypeCname = "CNAME"
+	TypeDs    = "DS"
+	TypeLoc   = "LOC"
+	TypeMx    = "MX"
+	TypeNs    = "NS"
+	TypePtr   = "PTR"
+	TypeSoa   = "SOA"
+	TypeSrv   = "SRV"
+	TypeTxt   = "TXT"
+)
+
+// MaxTTL is the largest TTL allowed by RFC 2181.
+const MaxTTL int64 = 2147483647
+
+// Zone is the content of a zone file.
+type Zone struct {
+	// The zone's origin, fully qualified and without the trailing dot.
+	Origin string
+
+	// The default TTL set by the $TTL directive, or 0 if there is none.
+	TTL int64
+
+	// The zone's records, in file order.
+	Records []Record
+}
+
+// Record is a single resource record. Names are fully qualified, lower case
+// and carry no trailing dot; the root name is ".".
+type Record struct {
+	// The owner name.
+	Name string
+
+	// The record's TTL in seconds, or 0 if the file did not give one.
+	TTL int64
+
+	// The record type, in upper case.
+	Type string
+
+	// The rdata fields in presentation order. Domain names are fully
+	// qualified, TXT character strings and the CAA value are unquoted, and a
+	// DS digest is a single field.
+	Rdata []string
+
+	// The line the record started on, or 0 if it was not parsed.
+	Line int
+}
+
+// ParseError reports a syntax or validation error in a zone file.
+type ParseError struct {
+	Line int
+	Err  error
+}
+
+func (parseErr *ParseError) Error() string {
+	return fmt.Sprintf("zonefile: line %d: %s", parseErr.Line, parseErr.Err.Error())
+}
+
+// Unwrap returns the underlying error.
+func (parseErr *ParseError) Unwrap() error {
+	return parseErr.Err
+}
+
+type token struct {
+	text   string
+	quoted bool
+}
+
+// entry is a logical line: a physical line, or several joined by parentheses.
+type entry struct {
+	line     int
+	indented bool
+	tokens   []token
+}
+
+// Parse reads a zone file. Relative names are qualified with "origin", which
+// is always taken as fully qualified, until a $ORIGIN directive changes it. $INCLUDE and $GENERATE are not supported.
+//
+// Records without a TTL get the $TTL default, or else the TTL of the previous
+// record (RFC 1035), or else 0. Every record is validated as it is read.
+func Parse(r io.Reader, origin string) (*Zone, error) {
+	entries, err := scanEntries(r)
+	if err != nil {
+		return nil, err
+	}
+
+	zone := &Zone{}
+	if strings.TrimSpace(origin) != "" {
+		zone.Origin, err = qualifyName(strings.TrimSuffix(strings.TrimSpace(origin), ".")+".", "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	currentOrigin := zone.Origin
+	owner := ""
+	var defaultTTL, lastTTL int64
+	for _, e := range entries {
+		tokens := e.tokens
+		if !tokens[0].quoted && strings.HasPrefix(tokens[0].text, "$") {
+			directive := strings.ToUpper(tokens[0].text)
+			if len(tokens) < 2 {
+				return nil, &ParseError{Line: e.line, Err: fmt.Errorf("%s requires an argument", directive)}
+			}
+			switch directive {
+			case "$ORIGIN":
+				currentOrigin, err = qualifyName(tokens[1].text, currentOrigin)
+				if err != nil {
+					return nil, &ParseError{Line: e.line, Err: err}
+				}
+				if zone.Origin == "" {
+					zone.Origin = currentOrigin
+				}
+			case "$TTL":
+				defaultTTL, err = ParseTTL(tokens[1].text)
+				if err != nil {
+					return nil, &ParseError{Line: e.line, Err: err}
+				}
+				if zone.TTL == 0 {
+					zone.TTL = defaultTTL
+				}
+			default:
+				return nil, &ParseError{Line: e.line, Err: fmt.Errorf("unsupported directive %s", directive)}
+			}
+			continue
+		}
+
+		if !e.indented {
+			owner, err = qualifyName(tokens[0].text, currentOrigin)
+			if err != nil {
+				return nil, &ParseError{Line: e.line, Err: err}
+			}
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, &ParseError{Line: e.line, Err: fmt.Errorf("record has no owner name")}
+		}
+
+		// The TTL and class may appear in either order, and both are optional.
+		var ttl int64
+		hasTTL, hasClass := false, false
+		for len(tokens) > 0 && !tokens[0].quoted {
+			if !hasTTL {
+				if value, ttlErr := ParseTTL(tokens[0].text); ttlErr == nil {
+					ttl, hasTTL = value, true
+					tokens = tokens[1:]
+					continue
+				}
+			}
+			if !hasClass && isClass(tokens[0].text) {
+				if !strings.EqualFold(tokens[0].text, "IN") {
+					return nil, &ParseError{Line: e.line, Err: fmt.Errorf("unsupported class %s", strings.ToUpper(tokens[0].text))}
+				}
+				hasClass = true
+				tokens = tokens[1:]
+				continue
+			}
+			break
+		}
+		if len(tokens) == 0 {
+			return nil, &ParseError{Line: e.line, Err: fmt.Errorf("record has no type")}
+		}
+
+		record := Record{
+			Name: owner,
+			Type: strings.ToUpper(tokens[0].text),
+			Line: e.line,
+		}
+		switch {
+		case hasTTL:
+			record.TTL = ttl
+		case defaultTTL > 0:
+			record.TTL = defaultTTL
+		default:
+			record.TTL = lastTTL
+		}
+		lastTTL = record.TTL
+
+		record.Rdata, err = parseRdata(record.Type, tokens[1:], currentOrigin)
+		if err == nil {
+			err = record.Validate()
+		}
+		if err != nil {
+			return nil, &ParseError{Line: e.line, Err: err}
+		}
+		zone.Records = append(zone.Records, record)
+	}
+	return zone, nil
+}
+
+// ParseString reads a zone file from a string.
+func ParseString(s string, origin string) (*Zone, error) {
+	return Parse(strings.NewReader(s), origin)
+}
+
+// ParseTTL parses a TTL given in seconds or with BIND's unit suffixes, e.g.
+// "3600", "1h" or "1h30m".
+func ParseTTL(s string) (int64, error) {
+	if s == "" {
+		return 0, fmt.Errorf("empty TTL")
+	}
+	if value, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if value < 0 || value > MaxTTL {
+			return 0, fmt.Errorf("TTL %s is out of range", s)
+		}
+		return value, nil
+	}
+
+	var total, current int64
+	digits := false
+	for _, c := range strings.ToLower(s) {
+		if c >= '0' && c <= '9' {
+			current = current*10 + int64(c-'0')
+			digits = true
+			if current > MaxTTL {
+				return 0, fmt.Errorf("TTL %s is out of range", s)
+			}
+			continue
+		}
+		var unit int64
+		switch c {
+		case 's':
+			unit = 1
+		case 'm':
+			unit = 60
+		case 'h':
+			unit = 3600
+		case 'd':
+			unit = 86400
+		case 'w':
+			unit = 604800
+		default:
+			return 0, fmt.Errorf("invalid TTL %s", s)
+		}
+		if !digits {
+			return 0, fmt.Errorf("invalid TTL %s", s)
+		}
+		total += current * unit
+		current, digits = 0, false
+		if total > MaxTTL {
+			return 0, fmt.Errorf("TTL %s is out of range", s)
+		}
+	}
+	if digits {
+		return 0, fmt.Errorf("invalid TTL %s", s)
+	}
+	return total, nil
+}
+
+func isClass(s string) bool {
+	switch strings.ToUpper(s) {
+	case "IN", "CH", "HS", "CS":
+		return true
+	}
+	return false
+}
+
+// scanEntries splits the input into logical lines, dropping comments and
+// joining lines enclosed in parentheses.
+func scanEntries(r io.Reader) ([]entry, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	var entries []entry
+	var current *entry
+	depth := 0
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if current == nil {
+			current = &entry{
+				line:     lineNumber,
+				indented: len(line) > 0 && (line[0] == ' ' || line[0] == '\t'),
+			}
+		}
+		if err := tokenize(line, current, &depth); err != nil {
+			return nil, &ParseError{Line: lineNumber, Err: err}
+		}
+		if depth == 0 {
+			if len(current.tokens) > 0 {
+				entries = append(entries, *current)
+			}
+			current = nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if current != nil && depth > 0 {
+		return nil, &ParseError{Line: current.line, Err: fmt.Errorf("unbalanced parentheses")}
+	}
+	return entries, nil
+}
+
+func tokenize(line string, e *entry, depth *int) error {
+	for i := 0; i < len(line); {
+		c := line[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case c == ';':
+			return nil
+		case c == '(':
+			*depth++
+			i++
+		case c == ')':
+			if *depth == 0 {
+				return fmt.Errorf("unbalanced parentheses")
+			}
+			*depth--
+			i++
+		case c == '"':
+			end := i + 1
+			for end < len(line) && line[end] != '"' {
+				if line[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(line) {
+				return fmt.Errorf("unterminated quoted string")
+			}
+			text, err := unescape(line[i+1 : end])
+			if err != nil {
+				return err
+			}
+			e.tokens = append(e.tokens, token{text: text, quoted: true})
+			i = end + 1
+		default:
+			end := i
+			for end < len(line) && !strings.ContainsRune(" \t;()\"", rune(line[end])) {
+				if line[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end > len(line) {
+				end = len(line)
+			}
+			text, err := unescape(line[i:end])
+			if err != nil {
+				return err
+			}
+			e.tokens = append(e.tokens, token{text: text})
+			i = end
+		}
+	}
+	return nil
+}
+
+// unescape resolves the \X and \DDD escapes of RFC 1035 section 5.1.
+func unescape(s string) (string, error) {
+	if !strings.Contains(s, `\`) {
+		return s, nil
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			b.WriteByte(s[i])
+			continue
+		}
+		if i+3 < len(s) && isDigit(s[i+1]) && isDigit(s[i+2]) && isDigit(s[i+3]) {
+			value, _ := strconv.Atoi(s[i+1 : i+4])
+			if value > 255 {
+				return "", fmt.Errorf("invalid escape \\%s", s[i+1:i+4])
+			}
+			b.WriteByte(byte(value))
+			i += 3
+			continue
+		}
+		if i+1 >= len(s) {
+			return "", fmt.Errorf("dangling escape")
+		}
+		b.WriteByte(s[i+1])
+		i++
+	}
+	return b.String(), nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// qualifyName returns the canonical form of "name" within "origin".
+func qualifyName(name string, origin string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	switch {
+	case name == "":
+		return "", fmt.Errorf("empty name")
+	case name == ".":
+		return ".", nil
+	case name == "@":
+		if origin == "" {
+			return "", fmt.Errorf("@ used without an origin")
+		}
+		return origin, nil
+	case strings.HasSuffix(name, "."):
+		return strings.TrimSuffix(name, "."), nil
+	case origin == "":
+		return "", fmt.Errorf("relative name %s used without an origin", name)
+	case origin == ".":
+		return name, nil
+	}
+	return name + "." + origin, nil
+}
+
+// rdataNameFields lists the rdata fields that hold domain names.
+var rdataNameFields = map[string][]int{
+	TypeCname: {0},
+	TypeNs:    {0},
+	TypePtr:   {0},
+	TypeMx:    {1},
+	TypeSrv:   {3},
+	TypeSoa:   {0, 1},
+}
+
+func parseRdata(recordType string, tokens []token, origin string) ([]string, error) {
+	rdata := make([]string, len(tokens))
+	for i, t := range tokens {
+		rdata[i] = t.text
+	}
+
+	for _, field := range rdataNameFields[recordType] {
+		if field < len(tokens) {
+			name, err := qualifyName(tokens[field].text, origin)
+			if err != nil {
+				return nil, err
+			}
+			rdata[field] = name
+		}
+	}
+
+	if recordType == TypeDs && len(rdata) > 4 {
+		rdata = append(rdata[:3], strings.Join(rdata[3:], ""))
+	}
+	return rdata, nil
+}
+
+// Validate checks the record's name, TTL, type and rdata.
+func (record Record) Validate() error {
+	if err := validateName(record.Name); err != nil {
+		return err
+	}
+	if record.TTL < 0 || record.TTL > MaxTTL {
+		return fmt.Errorf("TTL %d is out of range", record.TTL)
+	}
+
+	rdata := record.Rdata
+	expectFields := func(count int) error {
+		if len(rdata) != count {
+			return fmt.Errorf("%s record for %s needs %d rdata fields, got %d", record.Type, record.Name, count, len(rdata))
+		}
+		return nil
+	}
+	switch record.Type {
+	case TypeA, TypeAaaa:
+		if err := expectFields(1); err != nil {
+			return err
+		}
+		ip := net.ParseIP(rdata[0])
+		if ip == nil || (ip.To4() != nil) != (record.Type == TypeA) || (record.Type == TypeA && strings.Contains(rdata[0], ":")) {
+			return fmt.Errorf("%s is not a valid %s record address", rdata[0], record.Type)
+		}
+	case TypeCname, TypeNs, TypePtr:
+		if err := expectFields(1); err != nil {
+			return err
+		}
+		return validateName(rdata[0])
+	case TypeMx:
+		if err := expectFields(2); err != nil {
+			return err
+		}
+		if err := validateUint(rdata[0], "MX preference", 16); err != nil {
+			return err
+		}
+		return validateName(rdata[1])
+	case TypeSrv:
+		if err := expectFields(4); err != nil {
+			return err
+		}
+		for i, field := range []string{"SRV priority", "SRV weight", "SRV port"} {
+			if err := validateUint(rdata[i], field, 16); err != nil {
+				return err
+			}
+		}
+		if _, _, _, err := splitServiceName(record.Name); err != nil {
+			return err
+		}
+		return validateName(rdata[3])
+	case TypeTxt:
+		if len(rdata) == 0 {
+			return fmt.Errorf("TXT record for %s has no text", record.Name)
+		}
+		for _, text := range rdata {
+			if len(text) > 255 {
+				return fmt.Errorf("TXT record for %s has a character string longer than 255 bytes", record.Name)
+			}
+		}
+	case TypeCaa:
+		if err := expectFields(3); err != nil {
+			return err
+		}
+		if err := validateUint(rdata[0], "CAA flags", 8); err != nil {
+			return err
+		}
+		if rdata[1] == "" || strings.IndexFunc(rdata[1], func(c rune) bool {
+			return !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9')
+		}) >= 0 {
+			return fmt.Errorf("invalid CAA tag %q", rdata[1])
+		}
+	case TypeDs:
+		if err := expectFields(4); err != nil {
+			return err
+		}
+		if err := validateUint(rdata[0], "DS key tag", 16); err != nil {
+			return err
+		}
+		if err := validateUint(rdata[1], "DS algorithm", 8); err != nil {
+			return err
+		}
+		if err := validateUint(rdata[2], "DS digest type", 8); err != nil {
+			return err
+		}
+		if _, err := hex.DecodeString(rdata[3]); err != nil || rdata[3] == "" {
+			return fmt.Errorf("DS digest %q is not hexadecimal", rdata[3])
+		}
+	case TypeLoc:
+		if _, err := parseLocation(rdata); err != nil {
+			return err
+		}
+	case TypeSoa:
+		if err := expectFields(7); err != nil {
+			return err
+		}
+		if err := validateName(rdata[0]); err != nil {
+			return err
+		}
+		if err := validateName(rdata[1]); err != nil {
+			return err
+		}
+		for _, field := range rdata[2:] {
+			if _, err := ParseTTL(field); err != nil {
+				return fmt.Errorf("invalid SOA field %q", field)
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported record type %q", record.Type)
+	}
+	return nil
+}
+
+func validateName(name string) error {
+	if name == "." {
+		return nil
+	}
+	if name == "" {
+		return fmt.Errorf("empty name")
+	}
+	if len(name) > 253 {
+		return fmt.Errorf("name %s is longer than 253 characters", name)
+	}
+	for _, label := range strings.Split(name, ".") {
+		if label == "" {
+			return fmt.Errorf("name %s has an empty label", name)
+		}
+		if len(label) > 63 {
+			return fmt.Errorf("name %s has a label longer than 63 characters", name)
+		}
+	}
+	return nil
+}
+
+func validateUint(s string, field string, bits int) error {
+	if _, err := strconv.ParseUint(s, 10, bits); err != nil {
+		return fmt.Errorf("invalid %s %q", field, s)
+	}
+	return nil
+}
+
+// splitServiceName splits an SRV owner name such as "_sip._udp.example.com"
+// into its service, protocol and host parts.
+func splitServiceName(name string) (service string, protocol string, host string, err error) {
+	parts := strings.SplitN(name, ".", 3)
+	if len(parts) < 3 || !strings.HasPrefix(parts[0], "_") || !strings.HasPrefix(parts[1], "_") {
+		err = fmt.Errorf("SRV record name %s is not of the form _service._proto.name", name)
+		return
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// WriteTo writes the zone in zone-file format. Owner names are written
+// relative to the zone's origin and names in rdata are fully qualified.
+func (zone *Zone) WriteTo(w io.Writer) (int64, error) {
+	var b bytes.Buffer
+	if zone.Origin != "" {
+		fmt.Fprintf(&b, "$ORIGIN %s\n", absoluteName(zone.Origin))
+	}
+	if zone.TTL > 0 {
+		fmt.Fprintf(&b, "$TTL %d\n", zone.TTL)
+	}
+	for _, record := range zone.Records {
+		b.WriteString(relativeName(record.Name, zone.Origin))
+		if record.TTL > 0 && record.TTL != zone.TTL {
+			fmt.Fprintf(&b, "\t%d", record.TTL)
+		}
+		fmt.Fprintf(&b, "\tIN\t%s", record.Type)
+		for i, field := range record.Rdata {
+			b.WriteByte(' ')
+			b.WriteString(formatRdataField(record.Type, i, field))
+		}
+		b.WriteByte('\n')
+	}
+	n, err := w.Write(b.Bytes())
+	return int64(n), err
+}
+
+// String returns the zone in zone-file format.
+func (zone *Zone) String() string {
+	var b strings.Builder
+	_, _ = zone.WriteTo(&b)
+	return b.String()
+}
+
+func formatRdataField(recordType string, index int, field string) string {
+	for _, nameField := range rdataNameFields[recordType] {
+		if nameField == index {
+			return absoluteName(field)
+		}
+	}
+	if recordType == TypeTxt || (recordType == TypeCaa && index == 2) {
+		return quote(field)
+	}
+	return field
+}
+
+func absoluteName(name string) string {
+	if name == "." {
+		return name
+	}
+	return name + "."
+}
+
+func relativeName(name string, origin string) string {
+	switch {
+	case origin == "":
+		return absoluteName(name)
+	case name == origin:
+		return "@"
+	case strings.HasSuffix(name, "."+origin):
+		return strings.TrimSuffix(name, "."+origin)
+	}
+	return absoluteName(name)
+}
+
+// quote returns "s" as a quoted character string, escaping quotes,
+// backslashes and non-printable bytes.
+func quote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '"' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c < ' ' || c > '~':
+			fmt.Fprintf(&b, "\\%03d", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// splitText splits "text" into character strings of at most 255 bytes.
+func splitText(text string) []string {
+	if text == "" {
+		return []string{""}
+	}
+	var texts []string
+	for len(text) > 255 {
+		texts = append(texts, text[:255])
+		text = text[255:]
+	}
+	return append(texts, text)
+}
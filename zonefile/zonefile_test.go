@@ -0,0 +1,161 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zonefile
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleZone = `$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1 hostmaster (
+		2026101801 ; serial
+		7200       ; refresh
+		3600 1209600 300 )
+	IN	NS	ns1.example.net.
+	IN	MX	10 mail
+www	300	IN	A	192.0.2.10
+www	IN	300	AAAA	2001:db8::10
+api		CNAME	www.example.com.
+txt	TXT	"v=spf1 include:_spf.example.net ~all" "second \"quoted\" string"
+_sip._udp	SRV	10 60 5060 sip
+@	CAA	0 issue "letsencrypt.org"
+secure	DS	12345 13 2 (
+		49FD46E6C4B45C55D4AC69CBD3CD34AC1AFE51DE
+		ABCDEF01 )
+office	LOC	52 22 23.000 N 4 53 32.000 E -2.00m 0.00m 10000m 10m
+
+$ORIGIN sub.example.com.
+host	A	192.0.2.30 ; comment
+`
+
+func TestParseZone(t *testing.T) {
+	zone, err := ParseString(exampleZone, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "example.com", zone.Origin)
+	assert.Equal(t, int64(3600), zone.TTL)
+	assert.Len(t, zone.Records, 12)
+
+	soa := zone.Records[0]
+	assert.Equal(t, "SOA", soa.Type)
+	assert.Equal(t, []string{"ns1.example.com", "hostmaster.example.com", "2026101801", "7200", "3600", "1209600", "300"}, soa.Rdata)
+	assert.Equal(t, 3, soa.Line)
+
+	ns := zone.Records[1]
+	assert.Equal(t, "example.com", ns.Name)
+	assert.Equal(t, []string{"ns1.example.net"}, ns.Rdata)
+
+	assert.Equal(t, []string{"10", "mail.example.com"}, zone.Records[2].Rdata)
+	assert.Equal(t, int64(300), zone.Records[3].TTL)
+	assert.Equal(t, int64(300), zone.Records[4].TTL)
+	assert.Equal(t, "AAAA", zone.Records[4].Type)
+	assert.Equal(t, int64(3600), zone.Records[5].TTL)
+	assert.Equal(t, []string{"v=spf1 include:_spf.example.net ~all", `second "quoted" string`}, zone.Records[6].Rdata)
+	assert.Equal(t, "_sip._udp.example.com", zone.Records[7].Name)
+	assert.Equal(t, []string{"0", "issue", "letsencrypt.org"}, zone.Records[8].Rdata)
+	assert.Equal(t, "49FD46E6C4B45C55D4AC69CBD3CD34AC1AFE51DEABCDEF01", zone.Records[9].Rdata[3])
+	assert.Equal(t, "host.sub.example.com", zone.Records[11].Name)
+}
+
+func TestWriteZoneRoundTrip(t *testing.T) {
+	zone, err := ParseString(exampleZone, "")
+	assert.Nil(t, err)
+
+	written := zone.String()
+	assert.True(t, strings.HasPrefix(written, "$ORIGIN example.com.\n$TTL 3600\n"))
+	assert.Contains(t, written, "www\t300\tIN\tA 192.0.2.10\n")
+	assert.Contains(t, written, "@\tIN\tMX 10 mail.example.com.\n")
+	assert.Contains(t, written, `txt	IN	TXT "v=spf1 include:_spf.example.net ~all" "second \"quoted\" string"`)
+	assert.Contains(t, written, "host.sub\tIN\tA 192.0.2.30\n")
+
+	reparsed, err := ParseString(written, "")
+	assert.Nil(t, err)
+	for i := range zone.Records {
+		zone.Records[i].Line, reparsed.Records[i].Line = 0, 0
+	}
+	assert.Equal(t, zone, reparsed)
+}
+
+func TestParseZoneUsesOriginArgument(t *testing.T) {
+	zone, err := ParseString("www A 192.0.2.1\n  TXT \"a\\059b\"\n", "example.org.")
+	assert.Nil(t, err)
+	assert.Equal(t, "example.org", zone.Origin)
+	assert.Equal(t, "www.example.org", zone.Records[1].Name)
+	assert.Equal(t, []string{"a;b"}, zone.Records[1].Rdata)
+	assert.Equal(t, int64(0), zone.Records[0].TTL)
+}
+
+func TestParseZoneErrors(t *testing.T) {
+	cases := map[string]string{
+		"$INCLUDE other.zone\n":                        "unsupported directive",
+		"www A 2001:db8::1\n":                          "not a valid A record address",
+		"www AAAA 192.0.2.1\n":                         "not a valid AAAA record address",
+		"www CH A 192.0.2.1\n":                         "unsupported class",
+		"www HINFO \"PC\" \"Linux\"\n":                 "unsupported record type",
+		"@ MX 70000 mail\n":                            "invalid MX preference",
+		"sip SRV 10 60 5060 sip\n":                     "is not of the form _service._proto.name",
+		"@ CAA 0 is-sue \"ca.example.net\"\n":          "invalid CAA tag",
+		"@ DS 1 13 2 XYZ\n":                            "not hexadecimal",
+		"www A ( 192.0.2.1\n":                          "unbalanced parentheses",
+		"www TXT \"unterminated\n":                     "unterminated quoted string",
+		"txt TXT \"" + strings.Repeat("x", 256) + "\"": "longer than 255 bytes",
+		"$TTL forever\n":                               "invalid TTL",
+	}
+	for input, message := range cases {
+		_, err := ParseString(input, "example.com")
+		if assert.NotNil(t, err, input) {
+			assert.Contains(t, err.Error(), message, input)
+			var parseErr *ParseError
+			assert.True(t, errors.As(err, &parseErr), input)
+		}
+	}
+
+	_, err := ParseString("www A 192.0.2.1\n", "")
+	assert.Equal(t, "zonefile: line 1: relative name www used without an origin", err.Error())
+
+	_, err = ParseString("@ A 192.0.2.1\n\nbad A 192.0.2.300\n", "example.com")
+	assert.Equal(t, "zonefile: line 3: 192.0.2.300 is not a valid A record address", err.Error())
+}
+
+func TestParseTTL(t *testing.T) {
+	for input, expected := range map[string]int64{"0": 0, "300": 300, "1h30m": 5400, "2D": 172800, "1w": 604800} {
+		ttl, err := ParseTTL(input)
+		assert.Nil(t, err, input)
+		assert.Equal(t, expected, ttl, input)
+	}
+	for _, input := range []string{"", "h", "1x", "10m5", "-1", "4294967296"} {
+		_, err := ParseTTL(input)
+		assert.NotNil(t, err, input)
+	}
+}
+
+func TestWriteZoneSplitsLongText(t *testing.T) {
+	zone := &Zone{
+		Origin: "example.com",
+		Records: []Record{
+			{Name: "dkim.example.com", TTL: 120, Type: TypeTxt, Rdata: splitText(strings.Repeat("k", 300))},
+			{Name: "other.example.net", Type: TypeCname, Rdata: []string{"example.com"}},
+		},
+	}
+	written := zone.String()
+	assert.Contains(t, written, "dkim\t120\tIN\tTXT \""+strings.Repeat("k", 255)+"\" \""+strings.Repeat("k", 45)+"\"\n")
+	assert.Contains(t, written, "other.example.net.\tIN\tCNAME example.com.\n")
+}
@@ -178,6 +178,12 @@ func (zoneFirewallAccessRules *ZoneFirewallAccessRulesV1) DisableRetries() {
 	zoneFirewallAccessRules.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (zoneFirewallAccessRules *ZoneFirewallAccessRulesV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(zoneFirewallAccessRules.Service, policy)
+}
+
 // ListAllZoneAccessRules : List all firewall access rules
 // List all firewall access rules for a zone.
 func (zoneFirewallAccessRules *ZoneFirewallAccessRulesV1) ListAllZoneAccessRules(listAllZoneAccessRulesOptions *ListAllZoneAccessRulesOptions) (result *ListZoneAccessRulesResp, response *core.DetailedResponse, err error) {
@@ -250,6 +256,7 @@ func (zoneFirewallAccessRules *ZoneFirewallAccessRulesV1) ListAllZoneAccessRules
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneFirewallAccessRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListZoneAccessRulesResp)
@@ -321,6 +328,7 @@ func (zoneFirewallAccessRules *ZoneFirewallAccessRulesV1) CreateZoneAccessRuleWi
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneFirewallAccessRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalZoneAccessRuleResp)
@@ -381,6 +389,7 @@ func (zoneFirewallAccessRules *ZoneFirewallAccessRulesV1) DeleteZoneAccessRuleWi
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneFirewallAccessRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDeleteZoneAccessRuleResp)
@@ -441,6 +450,7 @@ func (zoneFirewallAccessRules *ZoneFirewallAccessRulesV1) GetZoneAccessRuleWithC
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneFirewallAccessRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalZoneAccessRuleResp)
@@ -514,6 +524,7 @@ func (zoneFirewallAccessRules *ZoneFirewallAccessRulesV1) UpdateZoneAccessRuleWi
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneFirewallAccessRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalZoneAccessRuleResp)
@@ -1069,6 +1080,20 @@ func UnmarshalListZoneAccessRulesResp(m map[string]json.RawMessage, result inter
 	return
 }
 
+// Retrieve the value to be passed to a request to access the next page of results
+func (resp *ListZoneAccessRulesResp) GetNextPage() (*int64, error) {
+	if core.IsNil(resp.ResultInfo) || resp.ResultInfo.Page == nil || resp.ResultInfo.PerPage == nil || resp.ResultInfo.TotalCount == nil {
+		return nil, nil
+	}
+	if resp.ResultInfo.Count != nil && *resp.ResultInfo.Count == 0 {
+		return nil, nil
+	}
+	if *resp.ResultInfo.Page**resp.ResultInfo.PerPage >= *resp.ResultInfo.TotalCount {
+		return nil, nil
+	}
+	return core.Int64Ptr(*resp.ResultInfo.Page + 1), nil
+}
+
 // ZoneAccessRuleObject : access rule object.
 type ZoneAccessRuleObject struct {
 	// Identifier of the firewall access rule.
@@ -1189,3 +1214,82 @@ func UnmarshalZoneAccessRuleResp(m map[string]json.RawMessage, result interface{
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// ZoneAccessRulesPager can be used to simplify the use of the "ListAllZoneAccessRules" method.
+type ZoneAccessRulesPager struct {
+	hasNext     bool
+	options     *ListAllZoneAccessRulesOptions
+	client      *ZoneFirewallAccessRulesV1
+	pageContext struct {
+		next *int64
+	}
+}
+
+// NewZoneAccessRulesPager returns a new ZoneAccessRulesPager instance.
+func (zoneFirewallAccessRules *ZoneFirewallAccessRulesV1) NewZoneAccessRulesPager(options *ListAllZoneAccessRulesOptions) (pager *ZoneAccessRulesPager, err error) {
+	if options.Page != nil && *options.Page > 1 {
+		err = fmt.Errorf("the 'options.Page' field should not be set")
+		return
+	}
+
+	var optionsCopy ListAllZoneAccessRulesOptions = *options
+	pager = &ZoneAccessRulesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  zoneFirewallAccessRules,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ZoneAccessRulesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ZoneAccessRulesPager) GetNextWithContext(ctx context.Context) (page []ZoneAccessRuleObject, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Page = pager.pageContext.next
+
+	result, _, err := pager.client.ListAllZoneAccessRulesWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+
+	next, err := result.GetNextPage()
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Result
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ZoneAccessRulesPager) GetAllWithContext(ctx context.Context) (allItems []ZoneAccessRuleObject, err error) {
+	for pager.HasNext() {
+		var nextPage []ZoneAccessRuleObject
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ZoneAccessRulesPager) GetNext() (page []ZoneAccessRuleObject, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ZoneAccessRulesPager) GetAll() (allItems []ZoneAccessRuleObject, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
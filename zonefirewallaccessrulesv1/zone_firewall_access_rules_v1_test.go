@@ -402,6 +402,122 @@ var _ = Describe(`ZoneFirewallAccessRulesV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listAllZoneAccessRulesPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						Expect(req.URL.Query()["page"]).To(BeNil())
+						fmt.Fprintf(res, "%s", `{"success": true, "errors": [["Errors"]], "messages": [["Messages"]], "result": [{"id": "92f17202ed8bd63d69a66b86a49a8f6b", "notes": "This rule is set because of an event that occurred and caused X.", "allowed_modes": ["block"], "mode": "block", "scope": {"type": "account"}, "created_on": "2014-01-01T05:20:00.12345Z", "modified_on": "2014-01-01T05:20:00.12345Z", "configuration": {"target": "ip", "value": "ip example 198.51.100.4; ip_range example 198.51.100.4/16 ; asn example AS12345; country example AZ"}}], "result_info": {"page": 1, "per_page": 1, "count": 1, "total_count": 2}}`)
+					} else if requestNumber == 2 {
+						Expect(req.URL.Query()["page"]).To(Equal([]string{fmt.Sprint(int64(2))}))
+						fmt.Fprintf(res, "%s", `{"success": true, "errors": [["Errors"]], "messages": [["Messages"]], "result": [{"id": "92f17202ed8bd63d69a66b86a49a8f6b", "notes": "This rule is set because of an event that occurred and caused X.", "allowed_modes": ["block"], "mode": "block", "scope": {"type": "account"}, "created_on": "2014-01-01T05:20:00.12345Z", "modified_on": "2014-01-01T05:20:00.12345Z", "configuration": {"target": "ip", "value": "ip example 198.51.100.4; ip_range example 198.51.100.4/16 ; asn example AS12345; country example AZ"}}], "result_info": {"page": 2, "per_page": 1, "count": 1, "total_count": 2}}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use ZoneAccessRulesPager.GetNext successfully`, func() {
+				zoneFirewallAccessRulesService, serviceErr := zonefirewallaccessrulesv1.NewZoneFirewallAccessRulesV1(&zonefirewallaccessrulesv1.ZoneFirewallAccessRulesV1Options{
+					URL:            testServer.URL,
+					Authenticator:  &core.NoAuthAuthenticator{},
+					Crn:            core.StringPtr(crn),
+					ZoneIdentifier: core.StringPtr(zoneIdentifier),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(zoneFirewallAccessRulesService).ToNot(BeNil())
+
+				// Construct an instance of the ListAllZoneAccessRulesOptions model
+				listAllZoneAccessRulesOptionsModel := new(zonefirewallaccessrulesv1.ListAllZoneAccessRulesOptions)
+				listAllZoneAccessRulesOptionsModel.Notes = core.StringPtr("testString")
+				listAllZoneAccessRulesOptionsModel.Mode = core.StringPtr("block")
+				listAllZoneAccessRulesOptionsModel.ConfigurationTarget = core.StringPtr("ip")
+				listAllZoneAccessRulesOptionsModel.ConfigurationValue = core.StringPtr("1.2.3.4")
+				listAllZoneAccessRulesOptionsModel.Order = core.StringPtr("configuration.target")
+				listAllZoneAccessRulesOptionsModel.Direction = core.StringPtr("asc")
+				listAllZoneAccessRulesOptionsModel.Match = core.StringPtr("any")
+
+				pager, err := zoneFirewallAccessRulesService.NewZoneAccessRulesPager(listAllZoneAccessRulesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []zonefirewallaccessrulesv1.ZoneAccessRuleObject
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use ZoneAccessRulesPager.GetAll successfully`, func() {
+				zoneFirewallAccessRulesService, serviceErr := zonefirewallaccessrulesv1.NewZoneFirewallAccessRulesV1(&zonefirewallaccessrulesv1.ZoneFirewallAccessRulesV1Options{
+					URL:            testServer.URL,
+					Authenticator:  &core.NoAuthAuthenticator{},
+					Crn:            core.StringPtr(crn),
+					ZoneIdentifier: core.StringPtr(zoneIdentifier),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(zoneFirewallAccessRulesService).ToNot(BeNil())
+
+				// Construct an instance of the ListAllZoneAccessRulesOptions model
+				listAllZoneAccessRulesOptionsModel := new(zonefirewallaccessrulesv1.ListAllZoneAccessRulesOptions)
+				listAllZoneAccessRulesOptionsModel.Notes = core.StringPtr("testString")
+				listAllZoneAccessRulesOptionsModel.Mode = core.StringPtr("block")
+				listAllZoneAccessRulesOptionsModel.ConfigurationTarget = core.StringPtr("ip")
+				listAllZoneAccessRulesOptionsModel.ConfigurationValue = core.StringPtr("1.2.3.4")
+				listAllZoneAccessRulesOptionsModel.Order = core.StringPtr("configuration.target")
+				listAllZoneAccessRulesOptionsModel.Direction = core.StringPtr("asc")
+				listAllZoneAccessRulesOptionsModel.Match = core.StringPtr("any")
+
+				pager, err := zoneFirewallAccessRulesService.NewZoneAccessRulesPager(listAllZoneAccessRulesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Invoke NewZoneAccessRulesPager with a page already set`, func() {
+				zoneFirewallAccessRulesService, serviceErr := zonefirewallaccessrulesv1.NewZoneFirewallAccessRulesV1(&zonefirewallaccessrulesv1.ZoneFirewallAccessRulesV1Options{
+					URL:            testServer.URL,
+					Authenticator:  &core.NoAuthAuthenticator{},
+					Crn:            core.StringPtr(crn),
+					ZoneIdentifier: core.StringPtr(zoneIdentifier),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(zoneFirewallAccessRulesService).ToNot(BeNil())
+
+				// Construct an instance of the ListAllZoneAccessRulesOptions model
+				listAllZoneAccessRulesOptionsModel := new(zonefirewallaccessrulesv1.ListAllZoneAccessRulesOptions)
+				listAllZoneAccessRulesOptionsModel.Notes = core.StringPtr("testString")
+				listAllZoneAccessRulesOptionsModel.Mode = core.StringPtr("block")
+				listAllZoneAccessRulesOptionsModel.ConfigurationTarget = core.StringPtr("ip")
+				listAllZoneAccessRulesOptionsModel.ConfigurationValue = core.StringPtr("1.2.3.4")
+				listAllZoneAccessRulesOptionsModel.Order = core.StringPtr("configuration.target")
+				listAllZoneAccessRulesOptionsModel.Direction = core.StringPtr("asc")
+				listAllZoneAccessRulesOptionsModel.Match = core.StringPtr("any")
+				listAllZoneAccessRulesOptionsModel.Page = core.Int64Ptr(int64(3))
+
+				pager, err := zoneFirewallAccessRulesService.NewZoneAccessRulesPager(listAllZoneAccessRulesOptionsModel)
+				Expect(err).ToNot(BeNil())
+				Expect(pager).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
 	})
 	Describe(`CreateZoneAccessRule(createZoneAccessRuleOptions *CreateZoneAccessRuleOptions) - Operation response error`, func() {
 		crn := "testString"
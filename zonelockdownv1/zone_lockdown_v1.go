@@ -178,6 +178,12 @@ func (zoneLockdown *ZoneLockdownV1) DisableRetries() {
 	zoneLockdown.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (zoneLockdown *ZoneLockdownV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(zoneLockdown.Service, policy)
+}
+
 // ListAllZoneLockownRules : List all lockdown rules
 // List all lockdown rules for a zone.
 func (zoneLockdown *ZoneLockdownV1) ListAllZoneLockownRules(listAllZoneLockownRulesOptions *ListAllZoneLockownRulesOptions) (result *ListLockdownResp, response *core.DetailedResponse, err error) {
@@ -229,6 +235,7 @@ func (zoneLockdown *ZoneLockdownV1) ListAllZoneLockownRulesWithContext(ctx conte
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneLockdown.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListLockdownResp)
@@ -309,6 +316,7 @@ func (zoneLockdown *ZoneLockdownV1) CreateZoneLockdownRuleWithContext(ctx contex
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneLockdown.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLockdownResp)
@@ -369,6 +377,7 @@ func (zoneLockdown *ZoneLockdownV1) DeleteZoneLockdownRuleWithContext(ctx contex
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneLockdown.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDeleteLockdownResp)
@@ -429,6 +438,7 @@ func (zoneLockdown *ZoneLockdownV1) GetLockdownWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneLockdown.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLockdownResp)
@@ -514,6 +524,7 @@ func (zoneLockdown *ZoneLockdownV1) UpdateLockdownRuleWithContext(ctx context.Co
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneLockdown.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLockdownResp)
@@ -983,6 +994,20 @@ func UnmarshalListLockdownResp(m map[string]json.RawMessage, result interface{})
 	return
 }
 
+// Retrieve the value to be passed to a request to access the next page of results
+func (resp *ListLockdownResp) GetNextPage() (*int64, error) {
+	if core.IsNil(resp.ResultInfo) || resp.ResultInfo.Page == nil || resp.ResultInfo.PerPage == nil || resp.ResultInfo.TotalCount == nil {
+		return nil, nil
+	}
+	if resp.ResultInfo.Count != nil && *resp.ResultInfo.Count == 0 {
+		return nil, nil
+	}
+	if *resp.ResultInfo.Page**resp.ResultInfo.PerPage >= *resp.ResultInfo.TotalCount {
+		return nil, nil
+	}
+	return core.Int64Ptr(*resp.ResultInfo.Page + 1), nil
+}
+
 // LockdownObject : lockdown object.
 type LockdownObject struct {
 	// Lockdown rule identifier.
@@ -1074,3 +1099,82 @@ func UnmarshalLockdownResp(m map[string]json.RawMessage, result interface{}) (er
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// ZoneLockownRulesPager can be used to simplify the use of the "ListAllZoneLockownRules" method.
+type ZoneLockownRulesPager struct {
+	hasNext     bool
+	options     *ListAllZoneLockownRulesOptions
+	client      *ZoneLockdownV1
+	pageContext struct {
+		next *int64
+	}
+}
+
+// NewZoneLockownRulesPager returns a new ZoneLockownRulesPager instance.
+func (zoneLockdown *ZoneLockdownV1) NewZoneLockownRulesPager(options *ListAllZoneLockownRulesOptions) (pager *ZoneLockownRulesPager, err error) {
+	if options.Page != nil && *options.Page > 1 {
+		err = fmt.Errorf("the 'options.Page' field should not be set")
+		return
+	}
+
+	var optionsCopy ListAllZoneLockownRulesOptions = *options
+	pager = &ZoneLockownRulesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  zoneLockdown,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ZoneLockownRulesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ZoneLockownRulesPager) GetNextWithContext(ctx context.Context) (page []LockdownObject, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Page = pager.pageContext.next
+
+	result, _, err := pager.client.ListAllZoneLockownRulesWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+
+	next, err := result.GetNextPage()
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Result
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ZoneLockownRulesPager) GetAllWithContext(ctx context.Context) (allItems []LockdownObject, err error) {
+	for pager.HasNext() {
+		var nextPage []LockdownObject
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ZoneLockownRulesPager) GetNext() (page []LockdownObject, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ZoneLockownRulesPager) GetAll() (allItems []LockdownObject, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
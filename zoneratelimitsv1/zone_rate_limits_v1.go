@@ -178,6 +178,12 @@ func (zoneRateLimits *ZoneRateLimitsV1) DisableRetries() {
 	zoneRateLimits.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (zoneRateLimits *ZoneRateLimitsV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(zoneRateLimits.Service, policy)
+}
+
 // ListAllZoneRateLimits : List all rate limits
 // The details of Rate Limit for a given zone under a given service instance.
 func (zoneRateLimits *ZoneRateLimitsV1) ListAllZoneRateLimits(listAllZoneRateLimitsOptions *ListAllZoneRateLimitsOptions) (result *ListRatelimitResp, response *core.DetailedResponse, err error) {
@@ -229,6 +235,7 @@ func (zoneRateLimits *ZoneRateLimitsV1) ListAllZoneRateLimitsWithContext(ctx con
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneRateLimits.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListRatelimitResp)
@@ -315,6 +322,7 @@ func (zoneRateLimits *ZoneRateLimitsV1) CreateZoneRateLimitsWithContext(ctx cont
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneRateLimits.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalRatelimitResp)
@@ -375,6 +383,7 @@ func (zoneRateLimits *ZoneRateLimitsV1) DeleteZoneRateLimitWithContext(ctx conte
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneRateLimits.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDeleteRateLimitResp)
@@ -435,6 +444,7 @@ func (zoneRateLimits *ZoneRateLimitsV1) GetRateLimitWithContext(ctx context.Cont
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneRateLimits.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalRatelimitResp)
@@ -526,6 +536,7 @@ func (zoneRateLimits *ZoneRateLimitsV1) UpdateRateLimitWithContext(ctx context.C
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneRateLimits.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalRatelimitResp)
@@ -1571,6 +1582,20 @@ func UnmarshalListRatelimitResp(m map[string]json.RawMessage, result interface{}
 	return
 }
 
+// Retrieve the value to be passed to a request to access the next page of results
+func (resp *ListRatelimitResp) GetNextPage() (*int64, error) {
+	if core.IsNil(resp.ResultInfo) || resp.ResultInfo.Page == nil || resp.ResultInfo.PerPage == nil || resp.ResultInfo.TotalCount == nil {
+		return nil, nil
+	}
+	if resp.ResultInfo.Count != nil && *resp.ResultInfo.Count == 0 {
+		return nil, nil
+	}
+	if *resp.ResultInfo.Page**resp.ResultInfo.PerPage >= *resp.ResultInfo.TotalCount {
+		return nil, nil
+	}
+	return core.Int64Ptr(*resp.ResultInfo.Page + 1), nil
+}
+
 // RatelimitObject : rate limit object.
 type RatelimitObject struct {
 	// Identifier of the rate limit.
@@ -1684,3 +1709,82 @@ func UnmarshalRatelimitResp(m map[string]json.RawMessage, result interface{}) (e
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// ZoneRateLimitsPager can be used to simplify the use of the "ListAllZoneRateLimits" method.
+type ZoneRateLimitsPager struct {
+	hasNext     bool
+	options     *ListAllZoneRateLimitsOptions
+	client      *ZoneRateLimitsV1
+	pageContext struct {
+		next *int64
+	}
+}
+
+// NewZoneRateLimitsPager returns a new ZoneRateLimitsPager instance.
+func (zoneRateLimits *ZoneRateLimitsV1) NewZoneRateLimitsPager(options *ListAllZoneRateLimitsOptions) (pager *ZoneRateLimitsPager, err error) {
+	if options.Page != nil && *options.Page > 1 {
+		err = fmt.Errorf("the 'options.Page' field should not be set")
+		return
+	}
+
+	var optionsCopy ListAllZoneRateLimitsOptions = *options
+	pager = &ZoneRateLimitsPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  zoneRateLimits,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ZoneRateLimitsPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ZoneRateLimitsPager) GetNextWithContext(ctx context.Context) (page []RatelimitObject, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Page = pager.pageContext.next
+
+	result, _, err := pager.client.ListAllZoneRateLimitsWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+
+	next, err := result.GetNextPage()
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Result
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ZoneRateLimitsPager) GetAllWithContext(ctx context.Context) (allItems []RatelimitObject, err error) {
+	for pager.HasNext() {
+		var nextPage []RatelimitObject
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ZoneRateLimitsPager) GetNext() (page []RatelimitObject, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ZoneRateLimitsPager) GetAll() (allItems []RatelimitObject, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
@@ -353,6 +353,101 @@ var _ = Describe(`ZoneRateLimitsV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listAllZoneRateLimitsPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						Expect(req.URL.Query()["page"]).To(BeNil())
+						fmt.Fprintf(res, "%s", `{"success": true, "errors": [["[]"]], "messages": [["[]"]], "result": [{"id": "92f17202ed8bd63d69a66b86a49a8f6b", "disabled": false, "description": "Prevent multiple login failures to mitigate brute force attacks", "bypass": [{"name": "url", "value": "example.com/*"}], "threshold": 1000, "period": 60, "correlate": {"by": "nat"}, "action": {"mode": "simulate", "timeout": 60, "response": {"content_type": "text/plain", "body": "This request has been rate-limited."}}, "match": {"request": {"methods": ["_ALL_"], "schemes": ["_ALL_"], "url": "*.example.org/path*"}, "response": {"status": [403], "headers": [{"name": "Cf-Cache-Status", "op": "ne", "value": "HIT"}], "origin_traffic": false}}}], "result_info": {"page": 1, "per_page": 1, "count": 1, "total_count": 2}}`)
+					} else if requestNumber == 2 {
+						Expect(req.URL.Query()["page"]).To(Equal([]string{fmt.Sprint(int64(2))}))
+						fmt.Fprintf(res, "%s", `{"success": true, "errors": [["[]"]], "messages": [["[]"]], "result": [{"id": "92f17202ed8bd63d69a66b86a49a8f6b", "disabled": false, "description": "Prevent multiple login failures to mitigate brute force attacks", "bypass": [{"name": "url", "value": "example.com/*"}], "threshold": 1000, "period": 60, "correlate": {"by": "nat"}, "action": {"mode": "simulate", "timeout": 60, "response": {"content_type": "text/plain", "body": "This request has been rate-limited."}}, "match": {"request": {"methods": ["_ALL_"], "schemes": ["_ALL_"], "url": "*.example.org/path*"}, "response": {"status": [403], "headers": [{"name": "Cf-Cache-Status", "op": "ne", "value": "HIT"}], "origin_traffic": false}}}], "result_info": {"page": 2, "per_page": 1, "count": 1, "total_count": 2}}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use ZoneRateLimitsPager.GetNext successfully`, func() {
+				zoneRateLimitsService, serviceErr := zoneratelimitsv1.NewZoneRateLimitsV1(&zoneratelimitsv1.ZoneRateLimitsV1Options{
+					URL:            testServer.URL,
+					Authenticator:  &core.NoAuthAuthenticator{},
+					Crn:            core.StringPtr(crn),
+					ZoneIdentifier: core.StringPtr(zoneIdentifier),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(zoneRateLimitsService).ToNot(BeNil())
+
+				// Construct an instance of the ListAllZoneRateLimitsOptions model
+				listAllZoneRateLimitsOptionsModel := new(zoneratelimitsv1.ListAllZoneRateLimitsOptions)
+
+				pager, err := zoneRateLimitsService.NewZoneRateLimitsPager(listAllZoneRateLimitsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []zoneratelimitsv1.RatelimitObject
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use ZoneRateLimitsPager.GetAll successfully`, func() {
+				zoneRateLimitsService, serviceErr := zoneratelimitsv1.NewZoneRateLimitsV1(&zoneratelimitsv1.ZoneRateLimitsV1Options{
+					URL:            testServer.URL,
+					Authenticator:  &core.NoAuthAuthenticator{},
+					Crn:            core.StringPtr(crn),
+					ZoneIdentifier: core.StringPtr(zoneIdentifier),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(zoneRateLimitsService).ToNot(BeNil())
+
+				// Construct an instance of the ListAllZoneRateLimitsOptions model
+				listAllZoneRateLimitsOptionsModel := new(zoneratelimitsv1.ListAllZoneRateLimitsOptions)
+
+				pager, err := zoneRateLimitsService.NewZoneRateLimitsPager(listAllZoneRateLimitsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Invoke NewZoneRateLimitsPager with a page already set`, func() {
+				zoneRateLimitsService, serviceErr := zoneratelimitsv1.NewZoneRateLimitsV1(&zoneratelimitsv1.ZoneRateLimitsV1Options{
+					URL:            testServer.URL,
+					Authenticator:  &core.NoAuthAuthenticator{},
+					Crn:            core.StringPtr(crn),
+					ZoneIdentifier: core.StringPtr(zoneIdentifier),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(zoneRateLimitsService).ToNot(BeNil())
+
+				// Construct an instance of the ListAllZoneRateLimitsOptions model
+				listAllZoneRateLimitsOptionsModel := new(zoneratelimitsv1.ListAllZoneRateLimitsOptions)
+				listAllZoneRateLimitsOptionsModel.Page = core.Int64Ptr(int64(3))
+
+				pager, err := zoneRateLimitsService.NewZoneRateLimitsPager(listAllZoneRateLimitsOptionsModel)
+				Expect(err).ToNot(BeNil())
+				Expect(pager).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
 	})
 	Describe(`CreateZoneRateLimits(createZoneRateLimitsOptions *CreateZoneRateLimitsOptions) - Operation response error`, func() {
 		crn := "testString"
@@ -189,6 +189,12 @@ func (zonesSettings *ZonesSettingsV1) DisableRetries() {
 	zonesSettings.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (zonesSettings *ZonesSettingsV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(zonesSettings.Service, policy)
+}
+
 // GetZoneDnssec : Get zone DNSSEC
 // Get DNSSEC setting for a given zone.
 func (zonesSettings *ZonesSettingsV1) GetZoneDnssec(getZoneDnssecOptions *GetZoneDnssecOptions) (result *ZonesDnssecResp, response *core.DetailedResponse, err error) {
@@ -238,6 +244,7 @@ func (zonesSettings *ZonesSettingsV1) GetZoneDnssecWithContext(ctx context.Conte
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_zone_dnssec", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -314,6 +321,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateZoneDnssecWithContext(ctx context.Co
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_zone_dnssec", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -379,6 +387,7 @@ func (zonesSettings *ZonesSettingsV1) GetZoneCnameFlatteningWithContext(ctx cont
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_zone_cname_flattening", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -455,6 +464,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateZoneCnameFlatteningWithContext(ctx c
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_zone_cname_flattening", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -520,6 +530,7 @@ func (zonesSettings *ZonesSettingsV1) GetOpportunisticEncryptionWithContext(ctx
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_opportunistic_encryption", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -596,6 +607,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateOpportunisticEncryptionWithContext(c
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_opportunistic_encryption", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -661,6 +673,7 @@ func (zonesSettings *ZonesSettingsV1) GetOpportunisticOnionWithContext(ctx conte
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_opportunistic_onion", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -737,6 +750,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateOpportunisticOnionWithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_opportunistic_onion", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -802,6 +816,7 @@ func (zonesSettings *ZonesSettingsV1) GetChallengeTTLWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_challenge_ttl", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -878,6 +893,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateChallengeTTLWithContext(ctx context.
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_challenge_ttl", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -943,6 +959,7 @@ func (zonesSettings *ZonesSettingsV1) GetAutomaticHttpsRewritesWithContext(ctx c
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_automatic_https_rewrites", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1019,6 +1036,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateAutomaticHttpsRewritesWithContext(ct
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_automatic_https_rewrites", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1084,6 +1102,7 @@ func (zonesSettings *ZonesSettingsV1) GetTrueClientIpWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_true_client_ip", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1160,6 +1179,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateTrueClientIpWithContext(ctx context.
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_true_client_ip", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1225,6 +1245,7 @@ func (zonesSettings *ZonesSettingsV1) GetAlwaysUseHttpsWithContext(ctx context.C
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_always_use_https", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1301,6 +1322,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateAlwaysUseHttpsWithContext(ctx contex
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_always_use_https", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1366,6 +1388,7 @@ func (zonesSettings *ZonesSettingsV1) GetImageSizeOptimizationWithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_image_size_optimization", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1442,6 +1465,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateImageSizeOptimizationWithContext(ctx
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_image_size_optimization", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1507,6 +1531,7 @@ func (zonesSettings *ZonesSettingsV1) GetScriptLoadOptimizationWithContext(ctx c
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_script_load_optimization", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1583,6 +1608,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateScriptLoadOptimizationWithContext(ct
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_script_load_optimization", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1648,6 +1674,7 @@ func (zonesSettings *ZonesSettingsV1) GetImageLoadOptimizationWithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_image_load_optimization", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1724,6 +1751,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateImageLoadOptimizationWithContext(ctx
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_image_load_optimization", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1789,6 +1817,7 @@ func (zonesSettings *ZonesSettingsV1) GetMinifyWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_minify", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1865,6 +1894,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateMinifyWithContext(ctx context.Contex
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_minify", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -1930,6 +1960,7 @@ func (zonesSettings *ZonesSettingsV1) GetMinTlsVersionWithContext(ctx context.Co
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_min_tls_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2006,6 +2037,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateMinTlsVersionWithContext(ctx context
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_min_tls_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2071,6 +2103,7 @@ func (zonesSettings *ZonesSettingsV1) GetIpGeolocationWithContext(ctx context.Co
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_ip_geolocation", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2147,6 +2180,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateIpGeolocationWithContext(ctx context
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_ip_geolocation", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2212,6 +2246,7 @@ func (zonesSettings *ZonesSettingsV1) GetServerSideExcludeWithContext(ctx contex
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_server_side_exclude", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2288,6 +2323,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateServerSideExcludeWithContext(ctx con
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_server_side_exclude", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2353,6 +2389,7 @@ func (zonesSettings *ZonesSettingsV1) GetSecurityHeaderWithContext(ctx context.C
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_security_header", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2429,6 +2466,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateSecurityHeaderWithContext(ctx contex
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_security_header", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2494,6 +2532,7 @@ func (zonesSettings *ZonesSettingsV1) GetMobileRedirectWithContext(ctx context.C
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_mobile_redirect", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2570,6 +2609,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateMobileRedirectWithContext(ctx contex
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_mobile_redirect", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2635,6 +2675,7 @@ func (zonesSettings *ZonesSettingsV1) GetPrefetchPreloadWithContext(ctx context.
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_prefetch_preload", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2711,6 +2752,7 @@ func (zonesSettings *ZonesSettingsV1) UpdatePrefetchPreloadWithContext(ctx conte
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_prefetch_preload", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2776,6 +2818,7 @@ func (zonesSettings *ZonesSettingsV1) GetHttp2WithContext(ctx context.Context, g
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_http2", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2852,6 +2895,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateHttp2WithContext(ctx context.Context
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_http2", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2917,6 +2961,7 @@ func (zonesSettings *ZonesSettingsV1) GetHttp3WithContext(ctx context.Context, g
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_http3", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -2993,6 +3038,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateHttp3WithContext(ctx context.Context
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_http3", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3058,6 +3104,7 @@ func (zonesSettings *ZonesSettingsV1) GetIpv6WithContext(ctx context.Context, ge
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_ipv6", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3134,6 +3181,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateIpv6WithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_ipv6", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3199,6 +3247,7 @@ func (zonesSettings *ZonesSettingsV1) GetWebSocketsWithContext(ctx context.Conte
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_web_sockets", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3275,6 +3324,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateWebSocketsWithContext(ctx context.Co
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_web_sockets", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3340,6 +3390,7 @@ func (zonesSettings *ZonesSettingsV1) GetPseudoIpv4WithContext(ctx context.Conte
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_pseudo_ipv4", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3416,6 +3467,7 @@ func (zonesSettings *ZonesSettingsV1) UpdatePseudoIpv4WithContext(ctx context.Co
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_pseudo_ipv4", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3481,6 +3533,7 @@ func (zonesSettings *ZonesSettingsV1) GetResponseBufferingWithContext(ctx contex
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_response_buffering", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3557,6 +3610,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateResponseBufferingWithContext(ctx con
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_response_buffering", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3622,6 +3676,7 @@ func (zonesSettings *ZonesSettingsV1) GetHotlinkProtectionWithContext(ctx contex
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_hotlink_protection", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3698,6 +3753,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateHotlinkProtectionWithContext(ctx con
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_hotlink_protection", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3763,6 +3819,7 @@ func (zonesSettings *ZonesSettingsV1) GetMaxUploadWithContext(ctx context.Contex
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_max_upload", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3839,6 +3896,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateMaxUploadWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_max_upload", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3904,6 +3962,7 @@ func (zonesSettings *ZonesSettingsV1) GetTlsClientAuthWithContext(ctx context.Co
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_tls_client_auth", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -3980,6 +4039,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateTlsClientAuthWithContext(ctx context
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_tls_client_auth", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4045,6 +4105,7 @@ func (zonesSettings *ZonesSettingsV1) GetBrotliWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_brotli", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4121,6 +4182,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateBrotliWithContext(ctx context.Contex
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_brotli", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4186,6 +4248,7 @@ func (zonesSettings *ZonesSettingsV1) GetProxyReadTimeoutWithContext(ctx context
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_proxy_read_timeout", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4262,6 +4325,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateProxyReadTimeoutWithContext(ctx cont
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_proxy_read_timeout", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4327,6 +4391,7 @@ func (zonesSettings *ZonesSettingsV1) GetBrowserCheckWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_browser_check", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4403,6 +4468,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateBrowserCheckWithContext(ctx context.
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_browser_check", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4468,6 +4534,7 @@ func (zonesSettings *ZonesSettingsV1) GetEnableErrorPagesOnWithContext(ctx conte
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_enable_error_pages_on", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4544,6 +4611,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateEnableErrorPagesOnWithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_enable_error_pages_on", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4609,6 +4677,7 @@ func (zonesSettings *ZonesSettingsV1) GetWebApplicationFirewallWithContext(ctx c
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_web_application_firewall", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4685,6 +4754,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateWebApplicationFirewallWithContext(ct
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_web_application_firewall", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4750,6 +4820,7 @@ func (zonesSettings *ZonesSettingsV1) GetCiphersWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_ciphers", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4826,6 +4897,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateCiphersWithContext(ctx context.Conte
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_ciphers", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4891,6 +4963,7 @@ func (zonesSettings *ZonesSettingsV1) GetOriginMaxHttpVersionWithContext(ctx con
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_origin_max_http_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -4967,6 +5040,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateOriginMaxHttpVersionWithContext(ctx
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_origin_max_http_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -5032,6 +5106,7 @@ func (zonesSettings *ZonesSettingsV1) GetOriginPostQuantumEncryptionWithContext(
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_origin_post_quantum_encryption", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -5108,6 +5183,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateOriginPostQuantumEncryptionWithConte
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_origin_post_quantum_encryption", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -5179,6 +5255,7 @@ func (zonesSettings *ZonesSettingsV1) GetLogRetentionWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_log_retention", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -5260,6 +5337,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateLogRetentionWithContext(ctx context.
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_log_retention", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -5325,6 +5403,7 @@ func (zonesSettings *ZonesSettingsV1) GetBotManagementWithContext(ctx context.Co
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_bot_management", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -5410,6 +5489,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateBotManagementWithContext(ctx context
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_bot_management", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -5475,6 +5555,7 @@ func (zonesSettings *ZonesSettingsV1) GetReplaceInsecureJsWithContext(ctx contex
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_replace_insecure_js", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -5551,6 +5632,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateReplaceInsecureJsWithContext(ctx con
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_replace_insecure_js", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -5616,6 +5698,7 @@ func (zonesSettings *ZonesSettingsV1) GetEmailObfuscationWithContext(ctx context
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_email_obfuscation", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -5692,6 +5775,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateEmailObfuscationWithContext(ctx cont
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_email_obfuscation", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -5757,6 +5841,7 @@ func (zonesSettings *ZonesSettingsV1) GetSecurityLevelWithContext(ctx context.Co
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "get_security_level", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -5833,6 +5918,7 @@ func (zonesSettings *ZonesSettingsV1) UpdateSecurityLevelWithContext(ctx context
 	var rawResponse map[string]json.RawMessage
 	response, err = zonesSettings.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		core.EnrichHTTPProblem(err, "update_security_level", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 		return
@@ -171,6 +171,12 @@ func (zones *ZonesV1) DisableRetries() {
 	zones.Service.DisableRetries()
 }
 
+// SetRetryPolicy applies "policy" (retries, backoff and rate limiting) to requests invoked for this service instance.
+// Passing nil removes a previously applied policy.
+func (zones *ZonesV1) SetRetryPolicy(policy *common.RetryPolicy) {
+	common.WithRetryPolicy(zones.Service, policy)
+}
+
 // ListZones : List all zones
 // List all zones for a service instance.
 func (zones *ZonesV1) ListZones(listZonesOptions *ListZonesOptions) (result *ListZonesResp, response *core.DetailedResponse, err error) {
@@ -221,6 +227,7 @@ func (zones *ZonesV1) ListZonesWithContext(ctx context.Context, listZonesOptions
 	var rawResponse map[string]json.RawMessage
 	response, err = zones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -290,6 +297,7 @@ func (zones *ZonesV1) CreateZoneWithContext(ctx context.Context, createZoneOptio
 	var rawResponse map[string]json.RawMessage
 	response, err = zones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -351,6 +359,7 @@ func (zones *ZonesV1) DeleteZoneWithContext(ctx context.Context, deleteZoneOptio
 	var rawResponse map[string]json.RawMessage
 	response, err = zones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -412,6 +421,7 @@ func (zones *ZonesV1) GetZoneWithContext(ctx context.Context, getZoneOptions *Ge
 	var rawResponse map[string]json.RawMessage
 	response, err = zones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -483,6 +493,7 @@ func (zones *ZonesV1) UpdateZoneWithContext(ctx context.Context, updateZoneOptio
 	var rawResponse map[string]json.RawMessage
 	response, err = zones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -544,6 +555,7 @@ func (zones *ZonesV1) ZoneActivationCheckWithContext(ctx context.Context, zoneAc
 	var rawResponse map[string]json.RawMessage
 	response, err = zones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -872,6 +884,20 @@ func UnmarshalListZonesResp(m map[string]json.RawMessage, result interface{}) (e
 	return
 }
 
+// Retrieve the value to be passed to a request to access the next page of results
+func (resp *ListZonesResp) GetNextPage() (*int64, error) {
+	if core.IsNil(resp.ResultInfo) || resp.ResultInfo.Page == nil || resp.ResultInfo.PerPage == nil || resp.ResultInfo.TotalCount == nil {
+		return nil, nil
+	}
+	if resp.ResultInfo.Count != nil && *resp.ResultInfo.Count == 0 {
+		return nil, nil
+	}
+	if *resp.ResultInfo.Page**resp.ResultInfo.PerPage >= *resp.ResultInfo.TotalCount {
+		return nil, nil
+	}
+	return core.Int64Ptr(*resp.ResultInfo.Page + 1), nil
+}
+
 // ResultInfo : result information.
 type ResultInfo struct {
 	// page.
@@ -1086,3 +1112,82 @@ func UnmarshalZoneResp(m map[string]json.RawMessage, result interface{}) (err er
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// ZonesPager can be used to simplify the use of the "ListZones" method.
+type ZonesPager struct {
+	hasNext     bool
+	options     *ListZonesOptions
+	client      *ZonesV1
+	pageContext struct {
+		next *int64
+	}
+}
+
+// NewZonesPager returns a new ZonesPager instance.
+func (zones *ZonesV1) NewZonesPager(options *ListZonesOptions) (pager *ZonesPager, err error) {
+	if options.Page != nil && *options.Page > 1 {
+		err = fmt.Errorf("the 'options.Page' field should not be set")
+		return
+	}
+
+	var optionsCopy ListZonesOptions = *options
+	pager = &ZonesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  zones,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ZonesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ZonesPager) GetNextWithContext(ctx context.Context) (page []ZoneDetails, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Page = pager.pageContext.next
+
+	result, _, err := pager.client.ListZonesWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+
+	next, err := result.GetNextPage()
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Result
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ZonesPager) GetAllWithContext(ctx context.Context) (allItems []ZoneDetails, err error) {
+	for pager.HasNext() {
+		var nextPage []ZoneDetails
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ZonesPager) GetNext() (page []ZoneDetails, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ZonesPager) GetAll() (allItems []ZoneDetails, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
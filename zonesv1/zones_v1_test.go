@@ -400,6 +400,98 @@ var _ = Describe(`ZonesV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listZonesPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						Expect(req.URL.Query()["page"]).To(BeNil())
+						fmt.Fprintf(res, "%s", `{"success": true, "errors": [["Errors"]], "messages": [["Messages"]], "result": [{"id": "f1aba936b94213e5b8dca0c0dbf1f9cc", "created_on": "2014-01-01T05:20:00.12345Z", "modified_on": "2014-01-01T05:20:00.12345Z", "name": "test-example.com", "original_registrar": "GoDaddy", "original_dnshost": "NameCheap", "status": "active", "paused": false, "original_name_servers": ["ns1.originaldnshost.com"], "name_servers": ["ns001.name.cloud.ibm.com"], "type": "full", "verification_key": "476754457-428595283", "cname_suffix": "cdn.cloudflare.net"}], "result_info": {"page": 1, "per_page": 1, "count": 1, "total_count": 2}}`)
+					} else if requestNumber == 2 {
+						Expect(req.URL.Query()["page"]).To(Equal([]string{fmt.Sprint(int64(2))}))
+						fmt.Fprintf(res, "%s", `{"success": true, "errors": [["Errors"]], "messages": [["Messages"]], "result": [{"id": "f1aba936b94213e5b8dca0c0dbf1f9cc", "created_on": "2014-01-01T05:20:00.12345Z", "modified_on": "2014-01-01T05:20:00.12345Z", "name": "test-example.com", "original_registrar": "GoDaddy", "original_dnshost": "NameCheap", "status": "active", "paused": false, "original_name_servers": ["ns1.originaldnshost.com"], "name_servers": ["ns001.name.cloud.ibm.com"], "type": "full", "verification_key": "476754457-428595283", "cname_suffix": "cdn.cloudflare.net"}], "result_info": {"page": 2, "per_page": 1, "count": 1, "total_count": 2}}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use ZonesPager.GetNext successfully`, func() {
+				zonesService, serviceErr := zonesv1.NewZonesV1(&zonesv1.ZonesV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+					Crn:           core.StringPtr(crn),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(zonesService).ToNot(BeNil())
+
+				// Construct an instance of the ListZonesOptions model
+				listZonesOptionsModel := new(zonesv1.ListZonesOptions)
+
+				pager, err := zonesService.NewZonesPager(listZonesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []zonesv1.ZoneDetails
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use ZonesPager.GetAll successfully`, func() {
+				zonesService, serviceErr := zonesv1.NewZonesV1(&zonesv1.ZonesV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+					Crn:           core.StringPtr(crn),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(zonesService).ToNot(BeNil())
+
+				// Construct an instance of the ListZonesOptions model
+				listZonesOptionsModel := new(zonesv1.ListZonesOptions)
+
+				pager, err := zonesService.NewZonesPager(listZonesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Invoke NewZonesPager with a page already set`, func() {
+				zonesService, serviceErr := zonesv1.NewZonesV1(&zonesv1.ZonesV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+					Crn:           core.StringPtr(crn),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(zonesService).ToNot(BeNil())
+
+				// Construct an instance of the ListZonesOptions model
+				listZonesOptionsModel := new(zonesv1.ListZonesOptions)
+				listZonesOptionsModel.Page = core.Int64Ptr(int64(3))
+
+				pager, err := zonesService.NewZonesPager(listZonesOptionsModel)
+				Expect(err).ToNot(BeNil())
+				Expect(pager).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
 	})
 	Describe(`CreateZone(createZoneOptions *CreateZoneOptions) - Operation response error`, func() {
 		crn := "testString"